@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"inventory-service/grpc/handlers"
 	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/alert"
+	"inventory-service/internal/migration"
 	"inventory-service/internal/model"
 	"inventory-service/internal/service"
 	repo "inventory-service/repository"
@@ -40,14 +42,58 @@ func main() {
 
 	db := client.Database("inventory")
 	col := db.Collection("parts")
-	repo := repo.NewMongoRepo(col)
-	partService := service.NewPartService(repo)
+	partRepo := repo.NewMongoRepo(col)
+	categoryCol := db.Collection("categories")
+	categoryRepo := repo.NewMongoCategoryRepo(categoryCol)
+	partService := service.NewPartService(partRepo, service.WithCategories(categoryRepo))
+	categoryService := service.NewCategoryService(categoryRepo, partRepo)
+	purchaseRepo := repo.NewMongoPurchaseOrderRepo(db.Collection("purchase_orders"))
+	purchaseService := service.NewPurchaseOrderService(purchaseRepo, partRepo)
+	manufacturerCol := db.Collection("manufacturers")
+	manufacturerRepo := repo.NewMongoManufacturerRepo(manufacturerCol)
+	manufacturerService := service.NewManufacturerService(manufacturerRepo, partRepo)
 
 	err = seedData(ctx, col)
 	if err != nil {
 		log.Println("did not seed:", err)
 	}
 
+	if err := manufacturerRepo.EnsureIndexes(ctx); err != nil {
+		log.Fatal("manufacturer indexes:", err)
+	}
+	res, err := migration.DedupeManufacturers(ctx, col, manufacturerCol)
+	if err != nil {
+		log.Fatal("manufacturer migration:", err)
+	}
+	if res.Linked > 0 {
+		log.Printf("linked %d parts to the manufacturer registry, %d manufacturers created\n", res.Linked, res.Created)
+	}
+
+	if err := categoryRepo.EnsureIndexes(ctx); err != nil {
+		log.Fatal("category indexes:", err)
+	}
+	catRes, err := migration.SeedCategories(ctx, col, categoryCol)
+	if err != nil {
+		log.Fatal("category migration:", err)
+	}
+	if catRes.Linked > 0 {
+		log.Printf("put %d parts into the category tree, %d categories created\n", catRes.Linked, catRes.Created)
+	}
+
+	notifier, err := newNotifier(db)
+	if err != nil {
+		log.Fatal(err)
+	}
+	alertInterval := time.Minute
+	if v := os.Getenv("ALERT_INTERVAL"); v != "" {
+		if alertInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatal("invalid ALERT_INTERVAL:", err)
+		}
+	}
+	alertCtx, stopAlerts := context.WithCancel(context.Background())
+	defer stopAlerts()
+	go alert.NewEvaluator(partRepo, notifier).Run(alertCtx, alertInterval)
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Printf("failed to listen: %v\n", err)
@@ -55,7 +101,7 @@ func main() {
 	}
 
 	s := grpc.NewServer()
-	handler := handlers.NewInventoryHandler(partService)
+	handler := handlers.NewInventoryHandler(partService, purchaseService, manufacturerService, categoryService)
 	inventorypb.RegisterInventoryServiceServer(s, handler)
 	reflection.Register(s)
 
@@ -72,10 +118,31 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down gRPC server...")
+	stopAlerts()
 	s.GracefulStop()
 	log.Println("Server stopped")
 }
 
+// newNotifier picks where low-stock alerts go from ALERT_NOTIFIER: log (the
+// default), webhook (ALERT_WEBHOOK_URL) or outbox (the alert_outbox
+// collection).
+func newNotifier(db *mongo.Database) (alert.Notifier, error) {
+	switch kind := os.Getenv("ALERT_NOTIFIER"); kind {
+	case "", "log":
+		return alert.LogNotifier{}, nil
+	case "webhook":
+		url := os.Getenv("ALERT_WEBHOOK_URL")
+		if url == "" {
+			return nil, fmt.Errorf("ALERT_WEBHOOK_URL is required for the webhook notifier")
+		}
+		return alert.NewWebhookNotifier(url), nil
+	case "outbox":
+		return alert.NewOutboxNotifier(db.Collection("alert_outbox")), nil
+	default:
+		return nil, fmt.Errorf("unknown ALERT_NOTIFIER %q", kind)
+	}
+}
+
 func seedData(ctx context.Context, col *mongo.Collection) error {
 	count, err := col.CountDocuments(ctx, bson.M{})
 	if err != nil {
@@ -89,12 +156,14 @@ func seedData(ctx context.Context, col *mongo.Collection) error {
 
 	parts := []interface{}{
 		bson.M{
-			"uuid":           "engine-1",
-			"name":           "Main Engine",
-			"description":    "Primary propulsion engine",
-			"price":          1500000,
-			"stock_quantity": 10,
-			"category":       inventorypb.Category_CATEGORY_ENGINE,
+			"uuid":              "engine-1",
+			"name":              "Main Engine",
+			"description":       "Primary propulsion engine",
+			"price":             1500000,
+			"stock_quantity":    10,
+			"reorder_threshold": 2,
+			"target_level":      10,
+			"category":          inventorypb.Category_CATEGORY_ENGINE,
 			"dimensions": &inventorypb.Dimensions{
 				Length: 4,
 				Width:  2,
@@ -106,6 +175,12 @@ func seedData(ctx context.Context, col *mongo.Collection) error {
 				Country: "USA",
 				Website: "https://spacey.example",
 			},
+			"compatibility": []model.CompatibilityRule{
+				{
+					Type:           int32(inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH),
+					TargetPartUUID: "fuel-1",
+				},
+			},
 			"tags":       []string{"engine", "rocket"},
 			"created_at": time.Now(),
 			"updated_at": time.Now(),
@@ -125,6 +200,45 @@ func seedData(ctx context.Context, col *mongo.Collection) error {
 			"created_at": time.Now(),
 			"updated_at": time.Now(),
 		},
+		bson.M{
+			"uuid":           "fuel-1",
+			"name":           "Kerosene Tank",
+			"description":    "RP-1 fuel for the main engine",
+			"price":          40000,
+			"stock_quantity": 50,
+			"category":       inventorypb.Category_CATEGORY_FUEL,
+			"manufacter": model.Manufacter{
+				Name:    "SpaceY",
+				Country: "USA",
+				Website: "https://spacey.example",
+			},
+			"tags":       []string{"fuel"},
+			"created_at": time.Now(),
+			"updated_at": time.Now(),
+		},
+		bson.M{
+			"uuid":        "stage-1",
+			"name":        "First Stage Kit",
+			"description": "Main engine with a pair of wings",
+			"category":    inventorypb.Category_CATEGORY_ENGINE,
+			"dimensions": &inventorypb.Dimensions{
+				Length: 6,
+				Width:  8,
+				Height: 2,
+				Weight: 2100,
+			},
+			"kit": model.Kit{
+				Components: []model.KitComponent{
+					{PartUUID: "engine-1", Quantity: 1},
+					{PartUUID: "wing-1", Quantity: 2},
+				},
+				Pricing:         int32(inventorypb.KitPricing_KIT_PRICING_DERIVED),
+				DiscountPercent: 5,
+			},
+			"tags":       []string{"kit", "stage"},
+			"created_at": time.Now(),
+			"updated_at": time.Now(),
+		},
 	}
 
 	_, err = col.InsertMany(ctx, parts)
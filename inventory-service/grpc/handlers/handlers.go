@@ -4,21 +4,30 @@ import (
 	"context"
 	"errors"
 	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
 	"inventory-service/internal/service"
+	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type InventoryHandler struct {
 	inventorypb.UnimplementedInventoryServiceServer
-	service service.PartService
+	service       service.PartService
+	purchases     service.PurchaseOrderService
+	manufacturers service.ManufacturerService
+	categories    service.CategoryService
 }
 
-func NewInventoryHandler(s service.PartService) *InventoryHandler {
+func NewInventoryHandler(s service.PartService, purchases service.PurchaseOrderService, manufacturers service.ManufacturerService, categories service.CategoryService) *InventoryHandler {
 	return &InventoryHandler{
-		service: s,
+		service:       s,
+		purchases:     purchases,
+		manufacturers: manufacturers,
+		categories:    categories,
 	}
 }
 
@@ -26,7 +35,7 @@ func (h *InventoryHandler) GetPart(ctx context.Context, req *inventorypb.GetPart
 	if req == nil || req.Uuid == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "uuid is required")
 	}
-	part, err := h.service.Get(ctx, req.Uuid)
+	part, err := h.service.Get(ctx, req.Uuid, asOf(req.AsOf))
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, status.Errorf(codes.NotFound, "part not found")
@@ -39,7 +48,7 @@ func (h *InventoryHandler) GetPart(ctx context.Context, req *inventorypb.GetPart
 }
 
 func (h *InventoryHandler) ListParts(ctx context.Context, req *inventorypb.ListPartsRequest) (*inventorypb.ListPartsResponse, error) {
-	parts, err := h.service.List(ctx, req.GetFilter())
+	parts, err := h.service.List(ctx, req.GetFilter(), asOf(req.GetAsOf()))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
 	}
@@ -48,3 +57,340 @@ func (h *InventoryHandler) ListParts(ctx context.Context, req *inventorypb.ListP
 		Parts: parts,
 	}, nil
 }
+
+func (h *InventoryHandler) ReserveStock(ctx context.Context, req *inventorypb.ReserveStockRequest) (*inventorypb.ReserveStockResponse, error) {
+	if len(req.GetItems()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "items are required")
+	}
+	if err := h.service.Reserve(ctx, req.GetItems()); err != nil {
+		return nil, stockError(err)
+	}
+	return &inventorypb.ReserveStockResponse{}, nil
+}
+
+func (h *InventoryHandler) ReleaseStock(ctx context.Context, req *inventorypb.ReleaseStockRequest) (*inventorypb.ReleaseStockResponse, error) {
+	if len(req.GetItems()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "items are required")
+	}
+	if err := h.service.Release(ctx, req.GetItems()); err != nil {
+		return nil, stockError(err)
+	}
+	return &inventorypb.ReleaseStockResponse{}, nil
+}
+
+func (h *InventoryHandler) ValidateConfiguration(ctx context.Context, req *inventorypb.ValidateConfigurationRequest) (*inventorypb.ValidateConfigurationResponse, error) {
+	if len(req.GetItems()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "items are required")
+	}
+	violations, err := h.service.ValidateConfiguration(ctx, req.GetItems())
+	if err != nil {
+		return nil, stockError(err)
+	}
+	return &inventorypb.ValidateConfigurationResponse{
+		Valid:      len(violations) == 0,
+		Violations: violations,
+	}, nil
+}
+
+func (h *InventoryHandler) SchedulePriceChange(ctx context.Context, req *inventorypb.SchedulePriceChangeRequest) (*inventorypb.SchedulePriceChangeResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part_uuid is required")
+	}
+	change, err := h.service.SchedulePriceChange(ctx, req.PartUuid, req.Price, asOf(req.EffectiveFrom))
+	if err != nil {
+		return nil, priceError(err)
+	}
+	return &inventorypb.SchedulePriceChangeResponse{Change: change}, nil
+}
+
+func (h *InventoryHandler) GetPriceHistory(ctx context.Context, req *inventorypb.GetPriceHistoryRequest) (*inventorypb.GetPriceHistoryResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part_uuid is required")
+	}
+	changes, err := h.service.GetPriceHistory(ctx, req.PartUuid)
+	if err != nil {
+		return nil, priceError(err)
+	}
+	return &inventorypb.GetPriceHistoryResponse{Changes: changes}, nil
+}
+
+func (h *InventoryHandler) SetReorderLevels(ctx context.Context, req *inventorypb.SetReorderLevelsRequest) (*inventorypb.SetReorderLevelsResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part_uuid is required")
+	}
+	err := h.service.SetReorderLevels(ctx, req.PartUuid, req.ReorderThreshold, req.TargetLevel)
+	switch {
+	case err == nil:
+		return &inventorypb.SetReorderLevelsResponse{}, nil
+	case errors.Is(err, service.ErrInvalidReorderLevels):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, status.Errorf(codes.NotFound, "part not found")
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func (h *InventoryHandler) ListLowStock(ctx context.Context, req *inventorypb.ListLowStockRequest) (*inventorypb.ListLowStockResponse, error) {
+	items, err := h.service.ListLowStock(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+	return &inventorypb.ListLowStockResponse{Items: items}, nil
+}
+
+func (h *InventoryHandler) CreatePurchaseOrder(ctx context.Context, req *inventorypb.CreatePurchaseOrderRequest) (*inventorypb.CreatePurchaseOrderResponse, error) {
+	po, err := h.purchases.Create(ctx, req)
+	if err != nil {
+		return nil, purchaseOrderError(err)
+	}
+	return &inventorypb.CreatePurchaseOrderResponse{PurchaseOrder: po}, nil
+}
+
+func (h *InventoryHandler) GetPurchaseOrder(ctx context.Context, req *inventorypb.GetPurchaseOrderRequest) (*inventorypb.GetPurchaseOrderResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "uuid is required")
+	}
+	po, err := h.purchases.Get(ctx, req.Uuid)
+	if err != nil {
+		return nil, purchaseOrderError(err)
+	}
+	return &inventorypb.GetPurchaseOrderResponse{PurchaseOrder: po}, nil
+}
+
+func (h *InventoryHandler) ReceivePurchaseOrder(ctx context.Context, req *inventorypb.ReceivePurchaseOrderRequest) (*inventorypb.ReceivePurchaseOrderResponse, error) {
+	switch {
+	case req.GetUuid() == "":
+		return nil, status.Errorf(codes.InvalidArgument, "uuid is required")
+	case len(req.GetItems()) == 0:
+		return nil, status.Errorf(codes.InvalidArgument, "items are required")
+	case req.GetReceivedBy() == "":
+		return nil, status.Errorf(codes.InvalidArgument, "received_by is required")
+	}
+	po, err := h.purchases.Receive(ctx, req.Uuid, req.Items, req.ReceivedBy)
+	if err != nil {
+		return nil, purchaseOrderError(err)
+	}
+	return &inventorypb.ReceivePurchaseOrderResponse{PurchaseOrder: po}, nil
+}
+
+func (h *InventoryHandler) CancelPurchaseOrderLines(ctx context.Context, req *inventorypb.CancelPurchaseOrderLinesRequest) (*inventorypb.CancelPurchaseOrderLinesResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "uuid is required")
+	}
+	po, err := h.purchases.CancelLines(ctx, req.Uuid, req.PartUuids)
+	if err != nil {
+		return nil, purchaseOrderError(err)
+	}
+	return &inventorypb.CancelPurchaseOrderLinesResponse{PurchaseOrder: po}, nil
+}
+
+func (h *InventoryHandler) ListOpenPurchaseOrders(ctx context.Context, req *inventorypb.ListOpenPurchaseOrdersRequest) (*inventorypb.ListOpenPurchaseOrdersResponse, error) {
+	parts, err := h.purchases.ListOpen(ctx, req.GetPartUuids())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+	return &inventorypb.ListOpenPurchaseOrdersResponse{Parts: parts}, nil
+}
+
+func (h *InventoryHandler) CreateManufacturer(ctx context.Context, req *inventorypb.CreateManufacturerRequest) (*inventorypb.CreateManufacturerResponse, error) {
+	if req.GetManufacturer() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "manufacturer is required")
+	}
+	m, err := h.manufacturers.Create(ctx, req.Manufacturer)
+	if err != nil {
+		return nil, manufacturerError(err)
+	}
+	return &inventorypb.CreateManufacturerResponse{Manufacturer: m}, nil
+}
+
+func (h *InventoryHandler) GetManufacturer(ctx context.Context, req *inventorypb.GetManufacturerRequest) (*inventorypb.GetManufacturerResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	m, err := h.manufacturers.Get(ctx, req.Id)
+	if err != nil {
+		return nil, manufacturerError(err)
+	}
+	return &inventorypb.GetManufacturerResponse{Manufacturer: m}, nil
+}
+
+func (h *InventoryHandler) UpdateManufacturer(ctx context.Context, req *inventorypb.UpdateManufacturerRequest) (*inventorypb.UpdateManufacturerResponse, error) {
+	if req.GetManufacturer().GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "manufacturer.id is required")
+	}
+	m, err := h.manufacturers.Update(ctx, req.Manufacturer)
+	if err != nil {
+		return nil, manufacturerError(err)
+	}
+	return &inventorypb.UpdateManufacturerResponse{Manufacturer: m}, nil
+}
+
+func (h *InventoryHandler) DeleteManufacturer(ctx context.Context, req *inventorypb.DeleteManufacturerRequest) (*inventorypb.DeleteManufacturerResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := h.manufacturers.Delete(ctx, req.Id); err != nil {
+		return nil, manufacturerError(err)
+	}
+	return &inventorypb.DeleteManufacturerResponse{}, nil
+}
+
+func (h *InventoryHandler) ListManufacturers(ctx context.Context, req *inventorypb.ListManufacturersRequest) (*inventorypb.ListManufacturersResponse, error) {
+	manufacturers, err := h.manufacturers.List(ctx, req.GetCountries(), req.GetCertificationStatuses())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+	return &inventorypb.ListManufacturersResponse{Manufacturers: manufacturers}, nil
+}
+
+func (h *InventoryHandler) SetPartManufacturer(ctx context.Context, req *inventorypb.SetPartManufacturerRequest) (*inventorypb.SetPartManufacturerResponse, error) {
+	if req.GetPartUuid() == "" || req.GetManufacturerId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part_uuid and manufacturer_id are required")
+	}
+	if err := h.manufacturers.SetPartManufacturer(ctx, req.PartUuid, req.ManufacturerId); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+	return &inventorypb.SetPartManufacturerResponse{}, nil
+}
+
+func (h *InventoryHandler) CreateCategory(ctx context.Context, req *inventorypb.CreateCategoryRequest) (*inventorypb.CreateCategoryResponse, error) {
+	if req.GetCategory() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "category is required")
+	}
+	c, err := h.categories.Create(ctx, req.Category)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.CreateCategoryResponse{Category: c}, nil
+}
+
+func (h *InventoryHandler) GetCategory(ctx context.Context, req *inventorypb.GetCategoryRequest) (*inventorypb.GetCategoryResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	c, attrs, err := h.categories.Get(ctx, req.Id)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.GetCategoryResponse{Category: c, EffectiveAttributes: attrs}, nil
+}
+
+func (h *InventoryHandler) UpdateCategory(ctx context.Context, req *inventorypb.UpdateCategoryRequest) (*inventorypb.UpdateCategoryResponse, error) {
+	if req.GetCategory().GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "category.id is required")
+	}
+	c, err := h.categories.Update(ctx, req.Category)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.UpdateCategoryResponse{Category: c}, nil
+}
+
+func (h *InventoryHandler) DeleteCategory(ctx context.Context, req *inventorypb.DeleteCategoryRequest) (*inventorypb.DeleteCategoryResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+	if err := h.categories.Delete(ctx, req.Id); err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.DeleteCategoryResponse{}, nil
+}
+
+func (h *InventoryHandler) ListCategoryTree(ctx context.Context, req *inventorypb.ListCategoryTreeRequest) (*inventorypb.ListCategoryTreeResponse, error) {
+	roots, err := h.categories.Tree(ctx, req.GetRootId())
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.ListCategoryTreeResponse{Roots: roots}, nil
+}
+
+func (h *InventoryHandler) SetPartCategory(ctx context.Context, req *inventorypb.SetPartCategoryRequest) (*inventorypb.SetPartCategoryResponse, error) {
+	if req.GetPartUuid() == "" || req.GetCategoryId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "part_uuid and category_id are required")
+	}
+	if err := h.categories.SetPartCategory(ctx, req.PartUuid, req.CategoryId, req.Metadata); err != nil {
+		return nil, categoryError(err)
+	}
+	return &inventorypb.SetPartCategoryResponse{}, nil
+}
+
+// asOf converts an optional timestamp, the zero time meaning now.
+func asOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func priceError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPrice), errors.Is(err, service.ErrPriceChangeInPast):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Errorf(codes.NotFound, "part not found")
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func stockError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrNotEnoughStock), errors.Is(err, model.ErrInvalidKit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func purchaseOrderError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPurchaseOrder), errors.Is(err, service.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPurchaseOrderClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrPurchaseOrderChanged):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Errorf(codes.NotFound, "purchase order not found")
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func manufacturerError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidManufacturer):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrManufacturerExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrManufacturerInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Errorf(codes.NotFound, "manufacturer not found")
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+func categoryError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCategory), errors.Is(err, service.ErrInvalidMetadata):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrCategoryExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrCategoryInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
@@ -22,6 +22,8 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Category is the legacy fixed set of categories. Parts keep it populated
+// from their place in the category tree, see PartCategory.
 type Category int32
 
 const (
@@ -77,6 +79,320 @@ func (Category) EnumDescriptor() ([]byte, []int) {
 	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
 }
 
+type CertificationStatus int32
+
+const (
+	CertificationStatus_CERTIFICATION_UNKNOWN   CertificationStatus = 0
+	CertificationStatus_CERTIFICATION_PENDING   CertificationStatus = 1
+	CertificationStatus_CERTIFICATION_CERTIFIED CertificationStatus = 2
+	CertificationStatus_CERTIFICATION_SUSPENDED CertificationStatus = 3
+	CertificationStatus_CERTIFICATION_REVOKED   CertificationStatus = 4
+)
+
+// Enum value maps for CertificationStatus.
+var (
+	CertificationStatus_name = map[int32]string{
+		0: "CERTIFICATION_UNKNOWN",
+		1: "CERTIFICATION_PENDING",
+		2: "CERTIFICATION_CERTIFIED",
+		3: "CERTIFICATION_SUSPENDED",
+		4: "CERTIFICATION_REVOKED",
+	}
+	CertificationStatus_value = map[string]int32{
+		"CERTIFICATION_UNKNOWN":   0,
+		"CERTIFICATION_PENDING":   1,
+		"CERTIFICATION_CERTIFIED": 2,
+		"CERTIFICATION_SUSPENDED": 3,
+		"CERTIFICATION_REVOKED":   4,
+	}
+)
+
+func (x CertificationStatus) Enum() *CertificationStatus {
+	p := new(CertificationStatus)
+	*p = x
+	return p
+}
+
+func (x CertificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CertificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (CertificationStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[1]
+}
+
+func (x CertificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CertificationStatus.Descriptor instead.
+func (CertificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_STRING AttributeType = 0
+	AttributeType_ATTRIBUTE_INT    AttributeType = 1
+	AttributeType_ATTRIBUTE_DOUBLE AttributeType = 2
+	AttributeType_ATTRIBUTE_BOOL   AttributeType = 3
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_STRING",
+		1: "ATTRIBUTE_INT",
+		2: "ATTRIBUTE_DOUBLE",
+		3: "ATTRIBUTE_BOOL",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_STRING": 0,
+		"ATTRIBUTE_INT":    1,
+		"ATTRIBUTE_DOUBLE": 2,
+		"ATTRIBUTE_BOOL":   3,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[2]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+type KitPricing int32
+
+const (
+	// The kit is sold at the price stored on the kit part.
+	KitPricing_KIT_PRICING_FIXED KitPricing = 0
+	// The kit price is the sum of its components minus discount_percent.
+	KitPricing_KIT_PRICING_DERIVED KitPricing = 1
+)
+
+// Enum value maps for KitPricing.
+var (
+	KitPricing_name = map[int32]string{
+		0: "KIT_PRICING_FIXED",
+		1: "KIT_PRICING_DERIVED",
+	}
+	KitPricing_value = map[string]int32{
+		"KIT_PRICING_FIXED":   0,
+		"KIT_PRICING_DERIVED": 1,
+	}
+)
+
+func (x KitPricing) Enum() *KitPricing {
+	p := new(KitPricing)
+	*p = x
+	return p
+}
+
+func (x KitPricing) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KitPricing) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (KitPricing) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[3]
+}
+
+func (x KitPricing) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KitPricing.Descriptor instead.
+func (KitPricing) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+type CompatibilityRuleType int32
+
+const (
+	CompatibilityRuleType_COMPATIBILITY_RULE_UNKNOWN CompatibilityRuleType = 0
+	// The part needs the target in the same configuration.
+	CompatibilityRuleType_COMPATIBILITY_RULE_REQUIRES CompatibilityRuleType = 1
+	// The part cannot be combined with the target.
+	CompatibilityRuleType_COMPATIBILITY_RULE_EXCLUDES CompatibilityRuleType = 2
+	// Whitelist: parts of the target's category in the same configuration must
+	// be matched by one of the COMPATIBLE_WITH rules of the part.
+	CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH CompatibilityRuleType = 3
+)
+
+// Enum value maps for CompatibilityRuleType.
+var (
+	CompatibilityRuleType_name = map[int32]string{
+		0: "COMPATIBILITY_RULE_UNKNOWN",
+		1: "COMPATIBILITY_RULE_REQUIRES",
+		2: "COMPATIBILITY_RULE_EXCLUDES",
+		3: "COMPATIBILITY_RULE_COMPATIBLE_WITH",
+	}
+	CompatibilityRuleType_value = map[string]int32{
+		"COMPATIBILITY_RULE_UNKNOWN":         0,
+		"COMPATIBILITY_RULE_REQUIRES":        1,
+		"COMPATIBILITY_RULE_EXCLUDES":        2,
+		"COMPATIBILITY_RULE_COMPATIBLE_WITH": 3,
+	}
+)
+
+func (x CompatibilityRuleType) Enum() *CompatibilityRuleType {
+	p := new(CompatibilityRuleType)
+	*p = x
+	return p
+}
+
+func (x CompatibilityRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompatibilityRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (CompatibilityRuleType) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[4]
+}
+
+func (x CompatibilityRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompatibilityRuleType.Descriptor instead.
+func (CompatibilityRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+type StockLevel int32
+
+const (
+	StockLevel_STOCK_LEVEL_OK  StockLevel = 0
+	StockLevel_STOCK_LEVEL_LOW StockLevel = 1
+	StockLevel_STOCK_LEVEL_OUT StockLevel = 2
+)
+
+// Enum value maps for StockLevel.
+var (
+	StockLevel_name = map[int32]string{
+		0: "STOCK_LEVEL_OK",
+		1: "STOCK_LEVEL_LOW",
+		2: "STOCK_LEVEL_OUT",
+	}
+	StockLevel_value = map[string]int32{
+		"STOCK_LEVEL_OK":  0,
+		"STOCK_LEVEL_LOW": 1,
+		"STOCK_LEVEL_OUT": 2,
+	}
+)
+
+func (x StockLevel) Enum() *StockLevel {
+	p := new(StockLevel)
+	*p = x
+	return p
+}
+
+func (x StockLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[5].Descriptor()
+}
+
+func (StockLevel) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[5]
+}
+
+func (x StockLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockLevel.Descriptor instead.
+func (StockLevel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+type PurchaseOrderStatus int32
+
+const (
+	PurchaseOrderStatus_PURCHASE_ORDER_OPEN               PurchaseOrderStatus = 0
+	PurchaseOrderStatus_PURCHASE_ORDER_PARTIALLY_RECEIVED PurchaseOrderStatus = 1
+	// Every line is received or its remainder cancelled.
+	PurchaseOrderStatus_PURCHASE_ORDER_RECEIVED PurchaseOrderStatus = 2
+	// Cancelled before anything was received.
+	PurchaseOrderStatus_PURCHASE_ORDER_CANCELLED PurchaseOrderStatus = 3
+)
+
+// Enum value maps for PurchaseOrderStatus.
+var (
+	PurchaseOrderStatus_name = map[int32]string{
+		0: "PURCHASE_ORDER_OPEN",
+		1: "PURCHASE_ORDER_PARTIALLY_RECEIVED",
+		2: "PURCHASE_ORDER_RECEIVED",
+		3: "PURCHASE_ORDER_CANCELLED",
+	}
+	PurchaseOrderStatus_value = map[string]int32{
+		"PURCHASE_ORDER_OPEN":               0,
+		"PURCHASE_ORDER_PARTIALLY_RECEIVED": 1,
+		"PURCHASE_ORDER_RECEIVED":           2,
+		"PURCHASE_ORDER_CANCELLED":          3,
+	}
+)
+
+func (x PurchaseOrderStatus) Enum() *PurchaseOrderStatus {
+	p := new(PurchaseOrderStatus)
+	*p = x
+	return p
+}
+
+func (x PurchaseOrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PurchaseOrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[6].Descriptor()
+}
+
+func (PurchaseOrderStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[6]
+}
+
+func (x PurchaseOrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PurchaseOrderStatus.Descriptor instead.
+func (PurchaseOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+}
+
 type Dimensions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
@@ -145,6 +461,8 @@ func (x *Dimensions) GetWeight() float64 {
 	return 0
 }
 
+// Manufacter is the view of a manufacturer embedded in parts and purchase
+// orders.
 type Manufacter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -205,33 +523,29 @@ func (x *Manufacter) GetWebsite() string {
 	return ""
 }
 
-type Value struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Kind:
-	//
-	//	*Value_StringValue
-	//	*Value_Int64Value
-	//	*Value_DoubleValue
-	//	*Value_BoolValue
-	Kind          isValue_Kind `protobuf_oneof:"kind"`
+type ManufacturerContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
+func (x *ManufacturerContact) Reset() {
+	*x = ManufacturerContact{}
 	mi := &file_proto_inventory_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Value) String() string {
+func (x *ManufacturerContact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*ManufacturerContact) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
+func (x *ManufacturerContact) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_inventory_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,115 +557,3583 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
+// Deprecated: Use ManufacturerContact.ProtoReflect.Descriptor instead.
+func (*ManufacturerContact) Descriptor() ([]byte, []int) {
 	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Value) GetKind() isValue_Kind {
+func (x *ManufacturerContact) GetName() string {
 	if x != nil {
-		return x.Kind
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Value) GetStringValue() string {
+func (x *ManufacturerContact) GetEmail() string {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_StringValue); ok {
-			return x.StringValue
-		}
+		return x.Email
 	}
 	return ""
 }
 
-func (x *Value) GetInt64Value() int64 {
+func (x *ManufacturerContact) GetPhone() string {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_Int64Value); ok {
-			return x.Int64Value
-		}
+		return x.Phone
 	}
-	return 0
+	return ""
 }
 
-func (x *Value) GetDoubleValue() float64 {
+// Manufacturer is an entry of the manufacturer registry. Names are unique,
+// ignoring case, spaces and punctuation.
+type Manufacturer struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country             string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Website             string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	Contact             *ManufacturerContact   `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	CertificationStatus CertificationStatus    `protobuf:"varint,6,opt,name=certification_status,json=certificationStatus,proto3,enum=inventory.v1.CertificationStatus" json:"certification_status,omitempty"`
+	// Required for CERTIFICATION_CERTIFIED.
+	CertificateNumber string                 `protobuf:"bytes,7,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	CertifiedUntil    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=certified_until,json=certifiedUntil,proto3" json:"certified_until,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Manufacturer) Reset() {
+	*x = Manufacturer{}
+	mi := &file_proto_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Manufacturer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manufacturer) ProtoMessage() {}
+
+func (x *Manufacturer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[3]
 	if x != nil {
-		if x, ok := x.Kind.(*Value_DoubleValue); ok {
-			return x.DoubleValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Value) GetBoolValue() bool {
+// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
+func (*Manufacturer) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Manufacturer) GetId() string {
 	if x != nil {
-		if x, ok := x.Kind.(*Value_BoolValue); ok {
-			return x.BoolValue
-		}
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type isValue_Kind interface {
-	isValue_Kind()
+func (x *Manufacturer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type Value_StringValue struct {
-	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+func (x *Manufacturer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
 }
 
-type Value_Int64Value struct {
-	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+func (x *Manufacturer) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
 }
 
-type Value_DoubleValue struct {
-	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+func (x *Manufacturer) GetContact() *ManufacturerContact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
 }
 
-type Value_BoolValue struct {
-	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+func (x *Manufacturer) GetCertificationStatus() CertificationStatus {
+	if x != nil {
+		return x.CertificationStatus
+	}
+	return CertificationStatus_CERTIFICATION_UNKNOWN
 }
 
-func (*Value_StringValue) isValue_Kind() {}
+func (x *Manufacturer) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
 
-func (*Value_Int64Value) isValue_Kind() {}
+func (x *Manufacturer) GetCertifiedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertifiedUntil
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AttributeSchema describes a metadata key of the parts in a category.
+type AttributeSchema struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     AttributeType          `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.AttributeType" json:"type,omitempty"`
+	Required bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// Any value of the type if empty.
+	AllowedValues []string `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	Description   string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeSchema) Reset() {
+	*x = AttributeSchema{}
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeSchema) ProtoMessage() {}
+
+func (x *AttributeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSchema.ProtoReflect.Descriptor instead.
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttributeSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeSchema) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_STRING
+}
+
+func (x *AttributeSchema) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeSchema) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *AttributeSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// PartCategory is a node of the category tree. Parts in a category must
+// have metadata matching the attributes of the category and of all its
+// ancestors; a child attribute overrides the ancestor one of the same name.
+type PartCategory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty for root categories.
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Legacy category of the parts in this category and its descendants,
+	// inherited from the parent if unknown.
+	LegacyCategory Category               `protobuf:"varint,4,opt,name=legacy_category,json=legacyCategory,proto3,enum=inventory.v1.Category" json:"legacy_category,omitempty"`
+	Attributes     []*AttributeSchema     `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PartCategory) Reset() {
+	*x = PartCategory{}
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartCategory) ProtoMessage() {}
+
+func (x *PartCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartCategory.ProtoReflect.Descriptor instead.
+func (*PartCategory) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PartCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PartCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartCategory) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *PartCategory) GetLegacyCategory() Category {
+	if x != nil {
+		return x.LegacyCategory
+	}
+	return Category_CATEGORY_UNKNOWN
+}
+
+func (x *PartCategory) GetAttributes() []*AttributeSchema {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PartCategory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PartCategory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CategoryTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *PartCategory          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CategoryTreeNode) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Value_StringValue
+	//	*Value_Int64Value
+	//	*Value_DoubleValue
+	//	*Value_BoolValue
+	Kind          isValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Value) GetKind() isValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetInt64Value() int64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_Int64Value); ok {
+			return x.Int64Value
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+type isValue_Kind interface {
+	isValue_Kind()
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type Value_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*Value_StringValue) isValue_Kind() {}
+
+func (*Value_Int64Value) isValue_Kind() {}
 
 func (*Value_DoubleValue) isValue_Kind() {}
 
-func (*Value_BoolValue) isValue_Kind() {}
+func (*Value_BoolValue) isValue_Kind() {}
+
+type KitComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitComponent) Reset() {
+	*x = KitComponent{}
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitComponent) ProtoMessage() {}
+
+func (x *KitComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitComponent.ProtoReflect.Descriptor instead.
+func (*KitComponent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KitComponent) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *KitComponent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Kit turns a part into a bundle of other parts. The stock of a kit is not
+// stored: it is the number of complete kits that can be assembled from the
+// component stock.
+type Kit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Components      []*KitComponent        `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Pricing         KitPricing             `protobuf:"varint,2,opt,name=pricing,proto3,enum=inventory.v1.KitPricing" json:"pricing,omitempty"`
+	DiscountPercent float64                `protobuf:"fixed64,3,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Kit) Reset() {
+	*x = Kit{}
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kit) ProtoMessage() {}
+
+func (x *Kit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kit.ProtoReflect.Descriptor instead.
+func (*Kit) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Kit) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Kit) GetPricing() KitPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return KitPricing_KIT_PRICING_FIXED
+}
+
+func (x *Kit) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+// CompatibilityRule links a part to another part or to a whole category.
+// Exactly one of target_part_uuid and target_category is set.
+type CompatibilityRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           CompatibilityRuleType  `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.CompatibilityRuleType" json:"type,omitempty"`
+	TargetPartUuid string                 `protobuf:"bytes,2,opt,name=target_part_uuid,json=targetPartUuid,proto3" json:"target_part_uuid,omitempty"`
+	TargetCategory Category               `protobuf:"varint,3,opt,name=target_category,json=targetCategory,proto3,enum=inventory.v1.Category" json:"target_category,omitempty"`
+	// For REQUIRES: units of the target needed per unit of the part, 1 if
+	// not set.
+	Quantity      int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityRule) Reset() {
+	*x = CompatibilityRule{}
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityRule) ProtoMessage() {}
+
+func (x *CompatibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityRule.ProtoReflect.Descriptor instead.
+func (*CompatibilityRule) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompatibilityRule) GetType() CompatibilityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_UNKNOWN
+}
+
+func (x *CompatibilityRule) GetTargetPartUuid() string {
+	if x != nil {
+		return x.TargetPartUuid
+	}
+	return ""
+}
+
+func (x *CompatibilityRule) GetTargetCategory() Category {
+	if x != nil {
+		return x.TargetCategory
+	}
+	return Category_CATEGORY_UNKNOWN
+}
+
+func (x *CompatibilityRule) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Part struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	StockQuantity int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	Category      Category               `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	Dimensions    *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Manufacter    *Manufacter            `protobuf:"bytes,8,opt,name=manufacter,proto3" json:"manufacter,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Kit           *Kit                   `protobuf:"bytes,13,opt,name=kit,proto3" json:"kit,omitempty"`
+	Compatibility []*CompatibilityRule   `protobuf:"bytes,14,rep,name=compatibility,proto3" json:"compatibility,omitempty"`
+	// Stock at or below the threshold is reported as low; 0 disables the
+	// low-stock alert, out-of-stock is always reported.
+	ReorderThreshold int64 `protobuf:"varint,15,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// Stock to reorder up to.
+	TargetLevel int64 `protobuf:"varint,16,opt,name=target_level,json=targetLevel,proto3" json:"target_level,omitempty"`
+	// Registry entry the manufacter view is taken from, empty for parts
+	// that are not linked yet.
+	ManufacturerId string `protobuf:"bytes,17,opt,name=manufacturer_id,json=manufacturerId,proto3" json:"manufacturer_id,omitempty"`
+	// Node of the category tree; category holds its legacy category.
+	CategoryId    string `protobuf:"bytes,18,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Part) Reset() {
+	*x = Part{}
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Part) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Part) ProtoMessage() {}
+
+func (x *Part) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Part.ProtoReflect.Descriptor instead.
+func (*Part) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Part) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Part) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Part) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Part) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Part) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *Part) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNKNOWN
+}
+
+func (x *Part) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *Part) GetManufacter() *Manufacter {
+	if x != nil {
+		return x.Manufacter
+	}
+	return nil
+}
+
+func (x *Part) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Part) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Part) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Part) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+func (x *Part) GetCompatibility() []*CompatibilityRule {
+	if x != nil {
+		return x.Compatibility
+	}
+	return nil
+}
+
+func (x *Part) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Part) GetTargetLevel() int64 {
+	if x != nil {
+		return x.TargetLevel
+	}
+	return 0
+}
+
+func (x *Part) GetManufacturerId() string {
+	if x != nil {
+		return x.ManufacturerId
+	}
+	return ""
+}
+
+func (x *Part) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type PartsFilter struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uuids                 []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Names                 []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
+	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	ManufacturerIds       []string               `protobuf:"bytes,6,rep,name=manufacturer_ids,json=manufacturerIds,proto3" json:"manufacturer_ids,omitempty"`
+	// Matches the parts in the given categories and all their descendants.
+	CategoryIds   []string `protobuf:"bytes,7,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsFilter) Reset() {
+	*x = PartsFilter{}
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsFilter) ProtoMessage() {}
+
+func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
+func (*PartsFilter) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PartsFilter) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerCountries() []string {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerIds() []string {
+	if x != nil {
+		return x.ManufacturerIds
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type GetPartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Price the part as of this moment instead of now. Stock is always
+	// current.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartRequest) Reset() {
+	*x = GetPartRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartRequest) ProtoMessage() {}
+
+func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPartRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetPartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+type ListPartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPartsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type ListPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type StockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItem) Reset() {
+	*x = StockItem{}
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItem) ProtoMessage() {}
+
+func (x *StockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
+func (*StockItem) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StockItem) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *StockItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReserveStockRequest) GetItems() []*StockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseStockRequest) GetItems() []*StockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+// PriceChange sets the price of a part from effective_from on. The first
+// entry of a history is the price the part was created with.
+type PriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PriceChange) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceChange) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SchedulePriceChangeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Price    float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Now if not set; must not be in the past.
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SchedulePriceChangeRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type SchedulePriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *PriceChange           `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SchedulePriceChangeResponse) GetChange() *PriceChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPriceHistoryRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+type GetPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by effective_from, including changes scheduled for the future.
+	Changes       []*PriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPriceHistoryResponse) GetChanges() []*PriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type LowStockItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid         string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StockQuantity    int64                  `protobuf:"varint,3,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	ReorderThreshold int64                  `protobuf:"varint,4,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	TargetLevel      int64                  `protobuf:"varint,5,opt,name=target_level,json=targetLevel,proto3" json:"target_level,omitempty"`
+	Level            StockLevel             `protobuf:"varint,6,opt,name=level,proto3,enum=inventory.v1.StockLevel" json:"level,omitempty"`
+	// Quantity needed to get back to target_level.
+	ReorderQuantity int64 `protobuf:"varint,7,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	// When an alert for the current level was sent, unset if not yet.
+	AlertedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=alerted_at,json=alertedAt,proto3" json:"alerted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowStockItem) Reset() {
+	*x = LowStockItem{}
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockItem) ProtoMessage() {}
+
+func (x *LowStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockItem.ProtoReflect.Descriptor instead.
+func (*LowStockItem) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LowStockItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetTargetLevel() int64 {
+	if x != nil {
+		return x.TargetLevel
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetLevel() StockLevel {
+	if x != nil {
+		return x.Level
+	}
+	return StockLevel_STOCK_LEVEL_OK
+}
+
+func (x *LowStockItem) GetReorderQuantity() int64 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetAlertedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlertedAt
+	}
+	return nil
+}
+
+type ListLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockRequest) Reset() {
+	*x = ListLowStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockRequest) ProtoMessage() {}
+
+func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+type ListLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LowStockItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockResponse) Reset() {
+	*x = ListLowStockResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockResponse) ProtoMessage() {}
+
+func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListLowStockResponse) GetItems() []*LowStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SetReorderLevelsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid         string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	ReorderThreshold int64                  `protobuf:"varint,2,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	TargetLevel      int64                  `protobuf:"varint,3,opt,name=target_level,json=targetLevel,proto3" json:"target_level,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetReorderLevelsRequest) Reset() {
+	*x = SetReorderLevelsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderLevelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderLevelsRequest) ProtoMessage() {}
+
+func (x *SetReorderLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderLevelsRequest.ProtoReflect.Descriptor instead.
+func (*SetReorderLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetReorderLevelsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SetReorderLevelsRequest) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *SetReorderLevelsRequest) GetTargetLevel() int64 {
+	if x != nil {
+		return x.TargetLevel
+	}
+	return 0
+}
+
+type SetReorderLevelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReorderLevelsResponse) Reset() {
+	*x = SetReorderLevelsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderLevelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderLevelsResponse) ProtoMessage() {}
+
+func (x *SetReorderLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderLevelsResponse.ProtoReflect.Descriptor instead.
+func (*SetReorderLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+type GoodsReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantity      int64                  `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReceivedBy    string                 `protobuf:"bytes,2,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsReceipt) Reset() {
+	*x = GoodsReceipt{}
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReceipt) ProtoMessage() {}
+
+func (x *GoodsReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReceipt.ProtoReflect.Descriptor instead.
+func (*GoodsReceipt) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GoodsReceipt) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetReceivedBy() string {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type PurchaseOrderLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid          string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	Quantity          int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitCost          float64                `protobuf:"fixed64,3,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	ExpectedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	ReceivedQuantity  int64                  `protobuf:"varint,5,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	CancelledQuantity int64                  `protobuf:"varint,6,opt,name=cancelled_quantity,json=cancelledQuantity,proto3" json:"cancelled_quantity,omitempty"`
+	Receipts          []*GoodsReceipt        `protobuf:"bytes,7,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PurchaseOrderLine) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() int64 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetCancelledQuantity() int64 {
+	if x != nil {
+		return x.CancelledQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReceipts() []*GoodsReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+// PurchaseOrder restocks parts from a manufacturer. Received goods are added
+// to the part stock.
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Manufacturer  *Manufacter            `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Status        PurchaseOrderStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.v1.PurchaseOrderStatus" json:"status,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PurchaseOrder) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetManufacturer() *Manufacter {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetStatus() PurchaseOrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PurchaseOrderStatus_PURCHASE_ORDER_OPEN
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePurchaseOrderRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer *Manufacter            `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// Only part_uuid, quantity, unit_cost and expected_at are used.
+	Lines         []*PurchaseOrderLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedBy     string               `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreatePurchaseOrderRequest) GetManufacturer() *Manufacter {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreatePurchaseOrderRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreatePurchaseOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderResponse) Reset() {
+	*x = CreatePurchaseOrderResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderResponse) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreatePurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type GetPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetPurchaseOrderRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPurchaseOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderResponse) Reset() {
+	*x = GetPurchaseOrderResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderResponse) ProtoMessage() {}
+
+func (x *GetPurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type ReceivePurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Items         []*StockItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ReceivedBy    string                 `protobuf:"bytes,3,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderRequest) GetItems() []*StockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReceivePurchaseOrderRequest) GetReceivedBy() string {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return ""
+}
+
+type ReceivePurchaseOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderResponse) Reset() {
+	*x = ReceivePurchaseOrderResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderResponse) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReceivePurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type CancelPurchaseOrderLinesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Lines to cancel the open remainder of; all lines if empty.
+	PartUuids     []string `protobuf:"bytes,2,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPurchaseOrderLinesRequest) Reset() {
+	*x = CancelPurchaseOrderLinesRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPurchaseOrderLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPurchaseOrderLinesRequest) ProtoMessage() {}
+
+func (x *CancelPurchaseOrderLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPurchaseOrderLinesRequest.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderLinesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelPurchaseOrderLinesRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CancelPurchaseOrderLinesRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+type CancelPurchaseOrderLinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPurchaseOrderLinesResponse) Reset() {
+	*x = CancelPurchaseOrderLinesResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPurchaseOrderLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPurchaseOrderLinesResponse) ProtoMessage() {}
+
+func (x *CancelPurchaseOrderLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPurchaseOrderLinesResponse.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderLinesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelPurchaseOrderLinesResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
+	}
+	return nil
+}
+
+type ListOpenPurchaseOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All parts if empty.
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenPurchaseOrdersRequest) Reset() {
+	*x = ListOpenPurchaseOrdersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListOpenPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListOpenPurchaseOrdersRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+type OpenPurchaseLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderUuid string                 `protobuf:"bytes,1,opt,name=purchase_order_uuid,json=purchaseOrderUuid,proto3" json:"purchase_order_uuid,omitempty"`
+	Manufacturer      *Manufacter            `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	OpenQuantity      int64                  `protobuf:"varint,3,opt,name=open_quantity,json=openQuantity,proto3" json:"open_quantity,omitempty"`
+	ExpectedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OpenPurchaseLine) Reset() {
+	*x = OpenPurchaseLine{}
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenPurchaseLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPurchaseLine) ProtoMessage() {}
+
+func (x *OpenPurchaseLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPurchaseLine.ProtoReflect.Descriptor instead.
+func (*OpenPurchaseLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OpenPurchaseLine) GetPurchaseOrderUuid() string {
+	if x != nil {
+		return x.PurchaseOrderUuid
+	}
+	return ""
+}
+
+func (x *OpenPurchaseLine) GetManufacturer() *Manufacter {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *OpenPurchaseLine) GetOpenQuantity() int64 {
+	if x != nil {
+		return x.OpenQuantity
+	}
+	return 0
+}
+
+func (x *OpenPurchaseLine) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+type PartOpenPurchases struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid      string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	OpenQuantity  int64                  `protobuf:"varint,2,opt,name=open_quantity,json=openQuantity,proto3" json:"open_quantity,omitempty"`
+	Lines         []*OpenPurchaseLine    `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartOpenPurchases) Reset() {
+	*x = PartOpenPurchases{}
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartOpenPurchases) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartOpenPurchases) ProtoMessage() {}
+
+func (x *PartOpenPurchases) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartOpenPurchases.ProtoReflect.Descriptor instead.
+func (*PartOpenPurchases) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PartOpenPurchases) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartOpenPurchases) GetOpenQuantity() int64 {
+	if x != nil {
+		return x.OpenQuantity
+	}
+	return 0
+}
+
+func (x *PartOpenPurchases) GetLines() []*OpenPurchaseLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ListOpenPurchaseOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*PartOpenPurchases   `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenPurchaseOrdersResponse) Reset() {
+	*x = ListOpenPurchaseOrdersResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListOpenPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListOpenPurchaseOrdersResponse) GetParts() []*PartOpenPurchases {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type CreateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id, created_at and updated_at are ignored.
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerRequest) Reset() {
+	*x = CreateManufacturerRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerRequest) ProtoMessage() {}
+
+func (x *CreateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+type CreateManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer  *Manufacturer          `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateManufacturerResponse) Reset() {
+	*x = CreateManufacturerResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateManufacturerResponse) ProtoMessage() {}
+
+func (x *CreateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*CreateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+type GetManufacturerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturerRequest) Reset() {
+	*x = GetManufacturerRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerRequest) ProtoMessage() {}
+
+func (x *GetManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetManufacturerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer  *Manufacturer          `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManufacturerResponse) Reset() {
+	*x = GetManufacturerResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerResponse) ProtoMessage() {}
+
+func (x *GetManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*GetManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+type UpdateManufacturerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Replaces everything but the timestamps of the manufacturer with this id.
+	Manufacturer  *Manufacturer `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerRequest) Reset() {
+	*x = UpdateManufacturerRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerRequest) ProtoMessage() {}
+
+func (x *UpdateManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateManufacturerRequest) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+type UpdateManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer  *Manufacturer          `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateManufacturerResponse) Reset() {
+	*x = UpdateManufacturerResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateManufacturerResponse) ProtoMessage() {}
+
+func (x *UpdateManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateManufacturerResponse) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+type DeleteManufacturerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerRequest) Reset() {
+	*x = DeleteManufacturerRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerRequest) ProtoMessage() {}
+
+func (x *DeleteManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteManufacturerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteManufacturerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteManufacturerResponse) Reset() {
+	*x = DeleteManufacturerResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteManufacturerResponse) ProtoMessage() {}
+
+func (x *DeleteManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+type ListManufacturersRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Countries             []string               `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	CertificationStatuses []CertificationStatus  `protobuf:"varint,2,rep,packed,name=certification_statuses,json=certificationStatuses,proto3,enum=inventory.v1.CertificationStatus" json:"certification_statuses,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ListManufacturersRequest) Reset() {
+	*x = ListManufacturersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersRequest) ProtoMessage() {}
+
+func (x *ListManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*ListManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListManufacturersRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ListManufacturersRequest) GetCertificationStatuses() []CertificationStatus {
+	if x != nil {
+		return x.CertificationStatuses
+	}
+	return nil
+}
+
+type ListManufacturersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturers []*Manufacturer        `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListManufacturersResponse) Reset() {
+	*x = ListManufacturersResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersResponse) ProtoMessage() {}
+
+func (x *ListManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*ListManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+type SetPartManufacturerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid       string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	ManufacturerId string                 `protobuf:"bytes,2,opt,name=manufacturer_id,json=manufacturerId,proto3" json:"manufacturer_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetPartManufacturerRequest) Reset() {
+	*x = SetPartManufacturerRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-type Part struct {
+func (x *SetPartManufacturerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartManufacturerRequest) ProtoMessage() {}
+
+func (x *SetPartManufacturerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPartManufacturerRequest.ProtoReflect.Descriptor instead.
+func (*SetPartManufacturerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetPartManufacturerRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SetPartManufacturerRequest) GetManufacturerId() string {
+	if x != nil {
+		return x.ManufacturerId
+	}
+	return ""
+}
+
+type SetPartManufacturerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	StockQuantity int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	Category      Category               `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
-	Dimensions    *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
-	Manufacter    *Manufacter            `protobuf:"bytes,8,opt,name=manufacter,proto3" json:"manufacter,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Part) Reset() {
-	*x = Part{}
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *SetPartManufacturerResponse) Reset() {
+	*x = SetPartManufacturerResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPartManufacturerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartManufacturerResponse) ProtoMessage() {}
+
+func (x *SetPartManufacturerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPartManufacturerResponse.ProtoReflect.Descriptor instead.
+func (*SetPartManufacturerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id, created_at and updated_at are ignored.
+	Category      *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateCategoryRequest) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *PartCategory          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *PartCategory          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Attributes of the category merged with the ones of its ancestors.
+	EffectiveAttributes []*AttributeSchema `protobuf:"bytes,2,rep,name=effective_attributes,json=effectiveAttributes,proto3" json:"effective_attributes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCategoryResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetCategoryResponse) GetEffectiveAttributes() []*AttributeSchema {
+	if x != nil {
+		return x.EffectiveAttributes
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Replaces everything but the timestamps of the category with this id.
+	Category      *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateCategoryRequest) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *PartCategory          `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Part) String() string {
+func (x *DeleteCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Part) ProtoMessage() {}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,121 +4144,121 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Part.ProtoReflect.Descriptor instead.
-func (*Part) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Part) GetUuid() string {
+func (x *DeleteCategoryRequest) GetId() string {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Part) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Part) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Part) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Part) GetStockQuantity() int64 {
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[66]
 	if x != nil {
-		return x.StockQuantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Part) GetCategory() Category {
-	if x != nil {
-		return x.Category
-	}
-	return Category_CATEGORY_UNKNOWN
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Part) GetDimensions() *Dimensions {
-	if x != nil {
-		return x.Dimensions
-	}
-	return nil
+type ListCategoryTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Subtree of this category; the whole tree if empty.
+	RootId        string `protobuf:"bytes,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Part) GetManufacter() *Manufacter {
-	if x != nil {
-		return x.Manufacter
-	}
-	return nil
+func (x *ListCategoryTreeRequest) Reset() {
+	*x = ListCategoryTreeRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Part) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *ListCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Part) GetMetadata() map[string]*Value {
+func (*ListCategoryTreeRequest) ProtoMessage() {}
+
+func (x *ListCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[67]
 	if x != nil {
-		return x.Metadata
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Part) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ListCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListCategoryTreeRequest) GetRootId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RootId
 	}
-	return nil
+	return ""
 }
 
-type PartsFilter struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Uuids                 []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	Names                 []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
-	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
-	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type ListCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartsFilter) Reset() {
-	*x = PartsFilter{}
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *ListCategoryTreeResponse) Reset() {
+	*x = ListCategoryTreeResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) String() string {
+func (x *ListCategoryTreeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartsFilter) ProtoMessage() {}
+func (*ListCategoryTreeResponse) ProtoMessage() {}
 
-func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *ListCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,68 +4269,101 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
-func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *PartsFilter) GetUuids() []string {
+func (x *ListCategoryTreeResponse) GetRoots() []*CategoryTreeNode {
 	if x != nil {
-		return x.Uuids
+		return x.Roots
 	}
 	return nil
 }
 
-func (x *PartsFilter) GetNames() []string {
+type SetPartCategoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid   string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	CategoryId string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// Replaces the part metadata if set; either way the metadata has to
+	// match the category attributes.
+	Metadata      map[string]*Value `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPartCategoryRequest) Reset() {
+	*x = SetPartCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPartCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartCategoryRequest) ProtoMessage() {}
+
+func (x *SetPartCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[69]
 	if x != nil {
-		return x.Names
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PartsFilter) GetCategories() []Category {
+// Deprecated: Use SetPartCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SetPartCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetPartCategoryRequest) GetPartUuid() string {
 	if x != nil {
-		return x.Categories
+		return x.PartUuid
 	}
-	return nil
+	return ""
 }
 
-func (x *PartsFilter) GetManufacturerCountries() []string {
+func (x *SetPartCategoryRequest) GetCategoryId() string {
 	if x != nil {
-		return x.ManufacturerCountries
+		return x.CategoryId
 	}
-	return nil
+	return ""
 }
 
-func (x *PartsFilter) GetTags() []string {
+func (x *SetPartCategoryRequest) GetMetadata() map[string]*Value {
 	if x != nil {
-		return x.Tags
+		return x.Metadata
 	}
 	return nil
 }
 
-type GetPartRequest struct {
+type SetPartCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartRequest) Reset() {
-	*x = GetPartRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *SetPartCategoryResponse) Reset() {
+	*x = SetPartCategoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartRequest) String() string {
+func (x *SetPartCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartRequest) ProtoMessage() {}
+func (*SetPartCategoryResponse) ProtoMessage() {}
 
-func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *SetPartCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,40 +4374,33 @@ func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetPartRequest) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+// Deprecated: Use SetPartCategoryResponse.ProtoReflect.Descriptor instead.
+func (*SetPartCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{70}
 }
 
-type GetPartResponse struct {
+type ValidateConfigurationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Part          *Part                  `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	Items         []*StockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *ValidateConfigurationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*ValidateConfigurationRequest) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,40 +4411,45 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *ValidateConfigurationRequest) GetItems() []*StockItem {
 	if x != nil {
-		return x.Part
+		return x.Items
 	}
 	return nil
 }
 
-type ListPartsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ConfigurationViolation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// COMPATIBILITY_RULE_UNKNOWN when the part itself does not exist.
+	Rule           CompatibilityRuleType `protobuf:"varint,2,opt,name=rule,proto3,enum=inventory.v1.CompatibilityRuleType" json:"rule,omitempty"`
+	TargetPartUuid string                `protobuf:"bytes,3,opt,name=target_part_uuid,json=targetPartUuid,proto3" json:"target_part_uuid,omitempty"`
+	TargetCategory Category              `protobuf:"varint,4,opt,name=target_category,json=targetCategory,proto3,enum=inventory.v1.Category" json:"target_category,omitempty"`
+	Message        string                `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[7]
+func (x *ConfigurationViolation) Reset() {
+	*x = ConfigurationViolation{}
+	mi := &file_proto_inventory_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *ConfigurationViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*ConfigurationViolation) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[7]
+func (x *ConfigurationViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,40 +4460,69 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ConfigurationViolation.ProtoReflect.Descriptor instead.
+func (*ConfigurationViolation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *ConfigurationViolation) GetPartUuid() string {
 	if x != nil {
-		return x.Filter
+		return x.PartUuid
 	}
-	return nil
+	return ""
 }
 
-type ListPartsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Parts         []*Part                `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+func (x *ConfigurationViolation) GetRule() CompatibilityRuleType {
+	if x != nil {
+		return x.Rule
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_UNKNOWN
+}
+
+func (x *ConfigurationViolation) GetTargetPartUuid() string {
+	if x != nil {
+		return x.TargetPartUuid
+	}
+	return ""
+}
+
+func (x *ConfigurationViolation) GetTargetCategory() Category {
+	if x != nil {
+		return x.TargetCategory
+	}
+	return Category_CATEGORY_UNKNOWN
+}
+
+func (x *ConfigurationViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidateConfigurationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Valid         bool                      `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations    []*ConfigurationViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[8]
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *ValidateConfigurationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*ValidateConfigurationResponse) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[8]
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,14 +4533,21 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *ValidateConfigurationResponse) GetValid() bool {
 	if x != nil {
-		return x.Parts
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateConfigurationResponse) GetViolations() []*ConfigurationViolation {
+	if x != nil {
+		return x.Violations
 	}
 	return nil
 }
@@ -718,7 +4567,46 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"Manufacter\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
-	"\awebsite\x18\x03 \x01(\tR\awebsite\"\x9d\x01\n" +
+	"\awebsite\x18\x03 \x01(\tR\awebsite\"U\n" +
+	"\x13ManufacturerContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\"\xe3\x03\n" +
+	"\fManufacturer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x18\n" +
+	"\awebsite\x18\x04 \x01(\tR\awebsite\x12;\n" +
+	"\acontact\x18\x05 \x01(\v2!.inventory.v1.ManufacturerContactR\acontact\x12T\n" +
+	"\x14certification_status\x18\x06 \x01(\x0e2!.inventory.v1.CertificationStatusR\x13certificationStatus\x12-\n" +
+	"\x12certificate_number\x18\a \x01(\tR\x11certificateNumber\x12C\n" +
+	"\x0fcertified_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0ecertifiedUntil\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbb\x01\n" +
+	"\x0fAttributeSchema\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.inventory.v1.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xc5\x02\n" +
+	"\fPartCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12?\n" +
+	"\x0flegacy_category\x18\x04 \x01(\x0e2\x16.inventory.v1.CategoryR\x0elegacyCategory\x12=\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2\x1d.inventory.v1.AttributeSchemaR\n" +
+	"attributes\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x01\n" +
+	"\x10CategoryTreeNode\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\x12:\n" +
+	"\bchildren\x18\x02 \x03(\v2\x1e.inventory.v1.CategoryTreeNodeR\bchildren\"\x9d\x01\n" +
 	"\x05Value\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
 	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
@@ -726,7 +4614,21 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\x06\n" +
-	"\x04kind\"\xcf\x04\n" +
+	"\x04kind\"G\n" +
+	"\fKitComponent\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xa0\x01\n" +
+	"\x03Kit\x12:\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x1a.inventory.v1.KitComponentR\n" +
+	"components\x122\n" +
+	"\apricing\x18\x02 \x01(\x0e2\x18.inventory.v1.KitPricingR\apricing\x12)\n" +
+	"\x10discount_percent\x18\x03 \x01(\x01R\x0fdiscountPercent\"\xd3\x01\n" +
+	"\x11CompatibilityRule\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04type\x12(\n" +
+	"\x10target_part_uuid\x18\x02 \x01(\tR\x0etargetPartUuid\x12?\n" +
+	"\x0ftarget_category\x18\x03 \x01(\x0e2\x16.inventory.v1.CategoryR\x0etargetCategory\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xd5\x06\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -746,10 +4648,17 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\x03kit\x18\r \x01(\v2\x11.inventory.v1.KitR\x03kit\x12E\n" +
+	"\rcompatibility\x18\x0e \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\rcompatibility\x12+\n" +
+	"\x11reorder_threshold\x18\x0f \x01(\x03R\x10reorderThreshold\x12!\n" +
+	"\ftarget_level\x18\x10 \x01(\x03R\vtargetLevel\x12'\n" +
+	"\x0fmanufacturer_id\x18\x11 \x01(\tR\x0emanufacturerId\x12\x1f\n" +
+	"\vcategory_id\x18\x12 \x01(\tR\n" +
+	"categoryId\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\x8a\x02\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -757,24 +4666,255 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"$\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12)\n" +
+	"\x10manufacturer_ids\x18\x06 \x03(\tR\x0fmanufacturerIds\x12!\n" +
+	"\fcategory_ids\x18\a \x03(\tR\vcategoryIds\"U\n" +
 	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"v\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"=\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts*r\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\";\n" +
+	"\tStockItem\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"D\n" +
+	"\x13ReserveStockRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.inventory.v1.StockItemR\x05items\"\x16\n" +
+	"\x14ReserveStockResponse\"D\n" +
+	"\x13ReleaseStockRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.inventory.v1.StockItemR\x05items\"\x16\n" +
+	"\x14ReleaseStockResponse\"\xa1\x01\n" +
+	"\vPriceChange\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"P\n" +
+	"\x1bSchedulePriceChangeResponse\x121\n" +
+	"\x06change\x18\x01 \x01(\v2\x19.inventory.v1.PriceChangeR\x06change\"5\n" +
+	"\x16GetPriceHistoryRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\"N\n" +
+	"\x17GetPriceHistoryResponse\x123\n" +
+	"\achanges\x18\x01 \x03(\v2\x19.inventory.v1.PriceChangeR\achanges\"\xcc\x02\n" +
+	"\fLowStockItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0estock_quantity\x18\x03 \x01(\x03R\rstockQuantity\x12+\n" +
+	"\x11reorder_threshold\x18\x04 \x01(\x03R\x10reorderThreshold\x12!\n" +
+	"\ftarget_level\x18\x05 \x01(\x03R\vtargetLevel\x12.\n" +
+	"\x05level\x18\x06 \x01(\x0e2\x18.inventory.v1.StockLevelR\x05level\x12)\n" +
+	"\x10reorder_quantity\x18\a \x01(\x03R\x0freorderQuantity\x129\n" +
+	"\n" +
+	"alerted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\talertedAt\"\x15\n" +
+	"\x13ListLowStockRequest\"H\n" +
+	"\x14ListLowStockResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.inventory.v1.LowStockItemR\x05items\"\x86\x01\n" +
+	"\x17SetReorderLevelsRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12+\n" +
+	"\x11reorder_threshold\x18\x02 \x01(\x03R\x10reorderThreshold\x12!\n" +
+	"\ftarget_level\x18\x03 \x01(\x03R\vtargetLevel\"\x1a\n" +
+	"\x18SetReorderLevelsResponse\"\x88\x01\n" +
+	"\fGoodsReceipt\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vreceived_by\x18\x02 \x01(\tR\n" +
+	"receivedBy\x12;\n" +
+	"\vreceived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\"\xba\x02\n" +
+	"\x11PurchaseOrderLine\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1b\n" +
+	"\tunit_cost\x18\x03 \x01(\x01R\bunitCost\x12;\n" +
+	"\vexpected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x12+\n" +
+	"\x11received_quantity\x18\x05 \x01(\x03R\x10receivedQuantity\x12-\n" +
+	"\x12cancelled_quantity\x18\x06 \x01(\x03R\x11cancelledQuantity\x126\n" +
+	"\breceipts\x18\a \x03(\v2\x1a.inventory.v1.GoodsReceiptR\breceipts\"\xe8\x02\n" +
+	"\rPurchaseOrder\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12<\n" +
+	"\fmanufacturer\x18\x02 \x01(\v2\x18.inventory.v1.ManufacterR\fmanufacturer\x129\n" +
+	"\x06status\x18\x03 \x01(\x0e2!.inventory.v1.PurchaseOrderStatusR\x06status\x125\n" +
+	"\x05lines\x18\x04 \x03(\v2\x1f.inventory.v1.PurchaseOrderLineR\x05lines\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x01\n" +
+	"\x1aCreatePurchaseOrderRequest\x12<\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x18.inventory.v1.ManufacterR\fmanufacturer\x125\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1f.inventory.v1.PurchaseOrderLineR\x05lines\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\"a\n" +
+	"\x1bCreatePurchaseOrderResponse\x12B\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1b.inventory.v1.PurchaseOrderR\rpurchaseOrder\"-\n" +
+	"\x17GetPurchaseOrderRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"^\n" +
+	"\x18GetPurchaseOrderResponse\x12B\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1b.inventory.v1.PurchaseOrderR\rpurchaseOrder\"\x81\x01\n" +
+	"\x1bReceivePurchaseOrderRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.inventory.v1.StockItemR\x05items\x12\x1f\n" +
+	"\vreceived_by\x18\x03 \x01(\tR\n" +
+	"receivedBy\"b\n" +
+	"\x1cReceivePurchaseOrderResponse\x12B\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1b.inventory.v1.PurchaseOrderR\rpurchaseOrder\"T\n" +
+	"\x1fCancelPurchaseOrderLinesRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x02 \x03(\tR\tpartUuids\"f\n" +
+	" CancelPurchaseOrderLinesResponse\x12B\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x1b.inventory.v1.PurchaseOrderR\rpurchaseOrder\">\n" +
+	"\x1dListOpenPurchaseOrdersRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\"\xe2\x01\n" +
+	"\x10OpenPurchaseLine\x12.\n" +
+	"\x13purchase_order_uuid\x18\x01 \x01(\tR\x11purchaseOrderUuid\x12<\n" +
+	"\fmanufacturer\x18\x02 \x01(\v2\x18.inventory.v1.ManufacterR\fmanufacturer\x12#\n" +
+	"\ropen_quantity\x18\x03 \x01(\x03R\fopenQuantity\x12;\n" +
+	"\vexpected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\"\x8b\x01\n" +
+	"\x11PartOpenPurchases\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12#\n" +
+	"\ropen_quantity\x18\x02 \x01(\x03R\fopenQuantity\x124\n" +
+	"\x05lines\x18\x03 \x03(\v2\x1e.inventory.v1.OpenPurchaseLineR\x05lines\"W\n" +
+	"\x1eListOpenPurchaseOrdersResponse\x125\n" +
+	"\x05parts\x18\x01 \x03(\v2\x1f.inventory.v1.PartOpenPurchasesR\x05parts\"[\n" +
+	"\x19CreateManufacturerRequest\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"\\\n" +
+	"\x1aCreateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"(\n" +
+	"\x16GetManufacturerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Y\n" +
+	"\x17GetManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"[\n" +
+	"\x19UpdateManufacturerRequest\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"\\\n" +
+	"\x1aUpdateManufacturerResponse\x12>\n" +
+	"\fmanufacturer\x18\x01 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\"+\n" +
+	"\x19DeleteManufacturerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteManufacturerResponse\"\x92\x01\n" +
+	"\x18ListManufacturersRequest\x12\x1c\n" +
+	"\tcountries\x18\x01 \x03(\tR\tcountries\x12X\n" +
+	"\x16certification_statuses\x18\x02 \x03(\x0e2!.inventory.v1.CertificationStatusR\x15certificationStatuses\"]\n" +
+	"\x19ListManufacturersResponse\x12@\n" +
+	"\rmanufacturers\x18\x01 \x03(\v2\x1a.inventory.v1.ManufacturerR\rmanufacturers\"b\n" +
+	"\x1aSetPartManufacturerRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12'\n" +
+	"\x0fmanufacturer_id\x18\x02 \x01(\tR\x0emanufacturerId\"\x1d\n" +
+	"\x1bSetPartManufacturerResponse\"O\n" +
+	"\x15CreateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"P\n" +
+	"\x16CreateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9f\x01\n" +
+	"\x13GetCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\x12P\n" +
+	"\x14effective_attributes\x18\x02 \x03(\v2\x1d.inventory.v1.AttributeSchemaR\x13effectiveAttributes\"O\n" +
+	"\x15UpdateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"P\n" +
+	"\x16UpdateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteCategoryResponse\"2\n" +
+	"\x17ListCategoryTreeRequest\x12\x17\n" +
+	"\aroot_id\x18\x01 \x01(\tR\x06rootId\"P\n" +
+	"\x18ListCategoryTreeResponse\x124\n" +
+	"\x05roots\x18\x01 \x03(\v2\x1e.inventory.v1.CategoryTreeNodeR\x05roots\"\xf8\x01\n" +
+	"\x16SetPartCategoryRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12N\n" +
+	"\bmetadata\x18\x03 \x03(\v22.inventory.v1.SetPartCategoryRequest.MetadataEntryR\bmetadata\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\x19\n" +
+	"\x17SetPartCategoryResponse\"M\n" +
+	"\x1cValidateConfigurationRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.inventory.v1.StockItemR\x05items\"\xf3\x01\n" +
+	"\x16ConfigurationViolation\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x127\n" +
+	"\x04rule\x18\x02 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04rule\x12(\n" +
+	"\x10target_part_uuid\x18\x03 \x01(\tR\x0etargetPartUuid\x12?\n" +
+	"\x0ftarget_category\x18\x04 \x01(\x0e2\x16.inventory.v1.CategoryR\x0etargetCategory\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"{\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12D\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2$.inventory.v1.ConfigurationViolationR\n" +
+	"violations*r\n" +
 	"\bCategory\x12\x14\n" +
 	"\x10CATEGORY_UNKNOWN\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x04*\xa0\x01\n" +
+	"\x13CertificationStatus\x12\x19\n" +
+	"\x15CERTIFICATION_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15CERTIFICATION_PENDING\x10\x01\x12\x1b\n" +
+	"\x17CERTIFICATION_CERTIFIED\x10\x02\x12\x1b\n" +
+	"\x17CERTIFICATION_SUSPENDED\x10\x03\x12\x19\n" +
+	"\x15CERTIFICATION_REVOKED\x10\x04*b\n" +
+	"\rAttributeType\x12\x14\n" +
+	"\x10ATTRIBUTE_STRING\x10\x00\x12\x11\n" +
+	"\rATTRIBUTE_INT\x10\x01\x12\x14\n" +
+	"\x10ATTRIBUTE_DOUBLE\x10\x02\x12\x12\n" +
+	"\x0eATTRIBUTE_BOOL\x10\x03*<\n" +
+	"\n" +
+	"KitPricing\x12\x15\n" +
+	"\x11KIT_PRICING_FIXED\x10\x00\x12\x17\n" +
+	"\x13KIT_PRICING_DERIVED\x10\x01*\xa1\x01\n" +
+	"\x15CompatibilityRuleType\x12\x1e\n" +
+	"\x1aCOMPATIBILITY_RULE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bCOMPATIBILITY_RULE_REQUIRES\x10\x01\x12\x1f\n" +
+	"\x1bCOMPATIBILITY_RULE_EXCLUDES\x10\x02\x12&\n" +
+	"\"COMPATIBILITY_RULE_COMPATIBLE_WITH\x10\x03*J\n" +
+	"\n" +
+	"StockLevel\x12\x12\n" +
+	"\x0eSTOCK_LEVEL_OK\x10\x00\x12\x13\n" +
+	"\x0fSTOCK_LEVEL_LOW\x10\x01\x12\x13\n" +
+	"\x0fSTOCK_LEVEL_OUT\x10\x02*\x90\x01\n" +
+	"\x13PurchaseOrderStatus\x12\x17\n" +
+	"\x13PURCHASE_ORDER_OPEN\x10\x00\x12%\n" +
+	"!PURCHASE_ORDER_PARTIALLY_RECEIVED\x10\x01\x12\x1b\n" +
+	"\x17PURCHASE_ORDER_RECEIVED\x10\x02\x12\x1c\n" +
+	"\x18PURCHASE_ORDER_CANCELLED\x10\x032\x97\x14\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseB9Z7inventory-service/internal/grpc/inventorypb;inventorypbb\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12U\n" +
+	"\fReserveStock\x12!.inventory.v1.ReserveStockRequest\x1a\".inventory.v1.ReserveStockResponse\x12U\n" +
+	"\fReleaseStock\x12!.inventory.v1.ReleaseStockRequest\x1a\".inventory.v1.ReleaseStockResponse\x12p\n" +
+	"\x15ValidateConfiguration\x12*.inventory.v1.ValidateConfigurationRequest\x1a+.inventory.v1.ValidateConfigurationResponse\x12j\n" +
+	"\x13SchedulePriceChange\x12(.inventory.v1.SchedulePriceChangeRequest\x1a).inventory.v1.SchedulePriceChangeResponse\x12^\n" +
+	"\x0fGetPriceHistory\x12$.inventory.v1.GetPriceHistoryRequest\x1a%.inventory.v1.GetPriceHistoryResponse\x12a\n" +
+	"\x10SetReorderLevels\x12%.inventory.v1.SetReorderLevelsRequest\x1a&.inventory.v1.SetReorderLevelsResponse\x12U\n" +
+	"\fListLowStock\x12!.inventory.v1.ListLowStockRequest\x1a\".inventory.v1.ListLowStockResponse\x12j\n" +
+	"\x13CreatePurchaseOrder\x12(.inventory.v1.CreatePurchaseOrderRequest\x1a).inventory.v1.CreatePurchaseOrderResponse\x12a\n" +
+	"\x10GetPurchaseOrder\x12%.inventory.v1.GetPurchaseOrderRequest\x1a&.inventory.v1.GetPurchaseOrderResponse\x12m\n" +
+	"\x14ReceivePurchaseOrder\x12).inventory.v1.ReceivePurchaseOrderRequest\x1a*.inventory.v1.ReceivePurchaseOrderResponse\x12y\n" +
+	"\x18CancelPurchaseOrderLines\x12-.inventory.v1.CancelPurchaseOrderLinesRequest\x1a..inventory.v1.CancelPurchaseOrderLinesResponse\x12s\n" +
+	"\x16ListOpenPurchaseOrders\x12+.inventory.v1.ListOpenPurchaseOrdersRequest\x1a,.inventory.v1.ListOpenPurchaseOrdersResponse\x12g\n" +
+	"\x12CreateManufacturer\x12'.inventory.v1.CreateManufacturerRequest\x1a(.inventory.v1.CreateManufacturerResponse\x12^\n" +
+	"\x0fGetManufacturer\x12$.inventory.v1.GetManufacturerRequest\x1a%.inventory.v1.GetManufacturerResponse\x12g\n" +
+	"\x12UpdateManufacturer\x12'.inventory.v1.UpdateManufacturerRequest\x1a(.inventory.v1.UpdateManufacturerResponse\x12g\n" +
+	"\x12DeleteManufacturer\x12'.inventory.v1.DeleteManufacturerRequest\x1a(.inventory.v1.DeleteManufacturerResponse\x12d\n" +
+	"\x11ListManufacturers\x12&.inventory.v1.ListManufacturersRequest\x1a'.inventory.v1.ListManufacturersResponse\x12j\n" +
+	"\x13SetPartManufacturer\x12(.inventory.v1.SetPartManufacturerRequest\x1a).inventory.v1.SetPartManufacturerResponse\x12[\n" +
+	"\x0eCreateCategory\x12#.inventory.v1.CreateCategoryRequest\x1a$.inventory.v1.CreateCategoryResponse\x12R\n" +
+	"\vGetCategory\x12 .inventory.v1.GetCategoryRequest\x1a!.inventory.v1.GetCategoryResponse\x12[\n" +
+	"\x0eUpdateCategory\x12#.inventory.v1.UpdateCategoryRequest\x1a$.inventory.v1.UpdateCategoryResponse\x12[\n" +
+	"\x0eDeleteCategory\x12#.inventory.v1.DeleteCategoryRequest\x1a$.inventory.v1.DeleteCategoryResponse\x12a\n" +
+	"\x10ListCategoryTree\x12%.inventory.v1.ListCategoryTreeRequest\x1a&.inventory.v1.ListCategoryTreeResponse\x12^\n" +
+	"\x0fSetPartCategory\x12$.inventory.v1.SetPartCategoryRequest\x1a%.inventory.v1.SetPartCategoryResponseB0Z.inventory-service/grpc/inventorypb;inventorypbb\x06proto3"
 
 var (
 	file_proto_inventory_proto_rawDescOnce sync.Once
@@ -788,43 +4928,232 @@ func file_proto_inventory_proto_rawDescGZIP() []byte {
 	return file_proto_inventory_proto_rawDescData
 }
 
-var file_proto_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_proto_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*Dimensions)(nil),            // 1: inventory.v1.Dimensions
-	(*Manufacter)(nil),            // 2: inventory.v1.Manufacter
-	(*Value)(nil),                 // 3: inventory.v1.Value
-	(*Part)(nil),                  // 4: inventory.v1.Part
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*GetPartRequest)(nil),        // 6: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 7: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 8: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 9: inventory.v1.ListPartsResponse
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(Category)(0),                            // 0: inventory.v1.Category
+	(CertificationStatus)(0),                 // 1: inventory.v1.CertificationStatus
+	(AttributeType)(0),                       // 2: inventory.v1.AttributeType
+	(KitPricing)(0),                          // 3: inventory.v1.KitPricing
+	(CompatibilityRuleType)(0),               // 4: inventory.v1.CompatibilityRuleType
+	(StockLevel)(0),                          // 5: inventory.v1.StockLevel
+	(PurchaseOrderStatus)(0),                 // 6: inventory.v1.PurchaseOrderStatus
+	(*Dimensions)(nil),                       // 7: inventory.v1.Dimensions
+	(*Manufacter)(nil),                       // 8: inventory.v1.Manufacter
+	(*ManufacturerContact)(nil),              // 9: inventory.v1.ManufacturerContact
+	(*Manufacturer)(nil),                     // 10: inventory.v1.Manufacturer
+	(*AttributeSchema)(nil),                  // 11: inventory.v1.AttributeSchema
+	(*PartCategory)(nil),                     // 12: inventory.v1.PartCategory
+	(*CategoryTreeNode)(nil),                 // 13: inventory.v1.CategoryTreeNode
+	(*Value)(nil),                            // 14: inventory.v1.Value
+	(*KitComponent)(nil),                     // 15: inventory.v1.KitComponent
+	(*Kit)(nil),                              // 16: inventory.v1.Kit
+	(*CompatibilityRule)(nil),                // 17: inventory.v1.CompatibilityRule
+	(*Part)(nil),                             // 18: inventory.v1.Part
+	(*PartsFilter)(nil),                      // 19: inventory.v1.PartsFilter
+	(*GetPartRequest)(nil),                   // 20: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                  // 21: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),                 // 22: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),                // 23: inventory.v1.ListPartsResponse
+	(*StockItem)(nil),                        // 24: inventory.v1.StockItem
+	(*ReserveStockRequest)(nil),              // 25: inventory.v1.ReserveStockRequest
+	(*ReserveStockResponse)(nil),             // 26: inventory.v1.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),              // 27: inventory.v1.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),             // 28: inventory.v1.ReleaseStockResponse
+	(*PriceChange)(nil),                      // 29: inventory.v1.PriceChange
+	(*SchedulePriceChangeRequest)(nil),       // 30: inventory.v1.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),      // 31: inventory.v1.SchedulePriceChangeResponse
+	(*GetPriceHistoryRequest)(nil),           // 32: inventory.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),          // 33: inventory.v1.GetPriceHistoryResponse
+	(*LowStockItem)(nil),                     // 34: inventory.v1.LowStockItem
+	(*ListLowStockRequest)(nil),              // 35: inventory.v1.ListLowStockRequest
+	(*ListLowStockResponse)(nil),             // 36: inventory.v1.ListLowStockResponse
+	(*SetReorderLevelsRequest)(nil),          // 37: inventory.v1.SetReorderLevelsRequest
+	(*SetReorderLevelsResponse)(nil),         // 38: inventory.v1.SetReorderLevelsResponse
+	(*GoodsReceipt)(nil),                     // 39: inventory.v1.GoodsReceipt
+	(*PurchaseOrderLine)(nil),                // 40: inventory.v1.PurchaseOrderLine
+	(*PurchaseOrder)(nil),                    // 41: inventory.v1.PurchaseOrder
+	(*CreatePurchaseOrderRequest)(nil),       // 42: inventory.v1.CreatePurchaseOrderRequest
+	(*CreatePurchaseOrderResponse)(nil),      // 43: inventory.v1.CreatePurchaseOrderResponse
+	(*GetPurchaseOrderRequest)(nil),          // 44: inventory.v1.GetPurchaseOrderRequest
+	(*GetPurchaseOrderResponse)(nil),         // 45: inventory.v1.GetPurchaseOrderResponse
+	(*ReceivePurchaseOrderRequest)(nil),      // 46: inventory.v1.ReceivePurchaseOrderRequest
+	(*ReceivePurchaseOrderResponse)(nil),     // 47: inventory.v1.ReceivePurchaseOrderResponse
+	(*CancelPurchaseOrderLinesRequest)(nil),  // 48: inventory.v1.CancelPurchaseOrderLinesRequest
+	(*CancelPurchaseOrderLinesResponse)(nil), // 49: inventory.v1.CancelPurchaseOrderLinesResponse
+	(*ListOpenPurchaseOrdersRequest)(nil),    // 50: inventory.v1.ListOpenPurchaseOrdersRequest
+	(*OpenPurchaseLine)(nil),                 // 51: inventory.v1.OpenPurchaseLine
+	(*PartOpenPurchases)(nil),                // 52: inventory.v1.PartOpenPurchases
+	(*ListOpenPurchaseOrdersResponse)(nil),   // 53: inventory.v1.ListOpenPurchaseOrdersResponse
+	(*CreateManufacturerRequest)(nil),        // 54: inventory.v1.CreateManufacturerRequest
+	(*CreateManufacturerResponse)(nil),       // 55: inventory.v1.CreateManufacturerResponse
+	(*GetManufacturerRequest)(nil),           // 56: inventory.v1.GetManufacturerRequest
+	(*GetManufacturerResponse)(nil),          // 57: inventory.v1.GetManufacturerResponse
+	(*UpdateManufacturerRequest)(nil),        // 58: inventory.v1.UpdateManufacturerRequest
+	(*UpdateManufacturerResponse)(nil),       // 59: inventory.v1.UpdateManufacturerResponse
+	(*DeleteManufacturerRequest)(nil),        // 60: inventory.v1.DeleteManufacturerRequest
+	(*DeleteManufacturerResponse)(nil),       // 61: inventory.v1.DeleteManufacturerResponse
+	(*ListManufacturersRequest)(nil),         // 62: inventory.v1.ListManufacturersRequest
+	(*ListManufacturersResponse)(nil),        // 63: inventory.v1.ListManufacturersResponse
+	(*SetPartManufacturerRequest)(nil),       // 64: inventory.v1.SetPartManufacturerRequest
+	(*SetPartManufacturerResponse)(nil),      // 65: inventory.v1.SetPartManufacturerResponse
+	(*CreateCategoryRequest)(nil),            // 66: inventory.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),           // 67: inventory.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),               // 68: inventory.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),              // 69: inventory.v1.GetCategoryResponse
+	(*UpdateCategoryRequest)(nil),            // 70: inventory.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),           // 71: inventory.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),            // 72: inventory.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),           // 73: inventory.v1.DeleteCategoryResponse
+	(*ListCategoryTreeRequest)(nil),          // 74: inventory.v1.ListCategoryTreeRequest
+	(*ListCategoryTreeResponse)(nil),         // 75: inventory.v1.ListCategoryTreeResponse
+	(*SetPartCategoryRequest)(nil),           // 76: inventory.v1.SetPartCategoryRequest
+	(*SetPartCategoryResponse)(nil),          // 77: inventory.v1.SetPartCategoryResponse
+	(*ValidateConfigurationRequest)(nil),     // 78: inventory.v1.ValidateConfigurationRequest
+	(*ConfigurationViolation)(nil),           // 79: inventory.v1.ConfigurationViolation
+	(*ValidateConfigurationResponse)(nil),    // 80: inventory.v1.ValidateConfigurationResponse
+	nil,                                      // 81: inventory.v1.Part.MetadataEntry
+	nil,                                      // 82: inventory.v1.SetPartCategoryRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),            // 83: google.protobuf.Timestamp
 }
 var file_proto_inventory_proto_depIdxs = []int32{
-	0,  // 0: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	1,  // 1: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	2,  // 2: inventory.v1.Part.manufacter:type_name -> inventory.v1.Manufacter
-	10, // 3: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 4: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 5: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	4,  // 7: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 8: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	4,  // 9: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	3,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	6,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	8,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	7,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	9,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	9,   // 0: inventory.v1.Manufacturer.contact:type_name -> inventory.v1.ManufacturerContact
+	1,   // 1: inventory.v1.Manufacturer.certification_status:type_name -> inventory.v1.CertificationStatus
+	83,  // 2: inventory.v1.Manufacturer.certified_until:type_name -> google.protobuf.Timestamp
+	83,  // 3: inventory.v1.Manufacturer.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 4: inventory.v1.Manufacturer.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 5: inventory.v1.AttributeSchema.type:type_name -> inventory.v1.AttributeType
+	0,   // 6: inventory.v1.PartCategory.legacy_category:type_name -> inventory.v1.Category
+	11,  // 7: inventory.v1.PartCategory.attributes:type_name -> inventory.v1.AttributeSchema
+	83,  // 8: inventory.v1.PartCategory.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 9: inventory.v1.PartCategory.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 10: inventory.v1.CategoryTreeNode.category:type_name -> inventory.v1.PartCategory
+	13,  // 11: inventory.v1.CategoryTreeNode.children:type_name -> inventory.v1.CategoryTreeNode
+	15,  // 12: inventory.v1.Kit.components:type_name -> inventory.v1.KitComponent
+	3,   // 13: inventory.v1.Kit.pricing:type_name -> inventory.v1.KitPricing
+	4,   // 14: inventory.v1.CompatibilityRule.type:type_name -> inventory.v1.CompatibilityRuleType
+	0,   // 15: inventory.v1.CompatibilityRule.target_category:type_name -> inventory.v1.Category
+	0,   // 16: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	7,   // 17: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	8,   // 18: inventory.v1.Part.manufacter:type_name -> inventory.v1.Manufacter
+	81,  // 19: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	83,  // 20: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 21: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 22: inventory.v1.Part.kit:type_name -> inventory.v1.Kit
+	17,  // 23: inventory.v1.Part.compatibility:type_name -> inventory.v1.CompatibilityRule
+	0,   // 24: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	83,  // 25: inventory.v1.GetPartRequest.as_of:type_name -> google.protobuf.Timestamp
+	18,  // 26: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	19,  // 27: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	83,  // 28: inventory.v1.ListPartsRequest.as_of:type_name -> google.protobuf.Timestamp
+	18,  // 29: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	24,  // 30: inventory.v1.ReserveStockRequest.items:type_name -> inventory.v1.StockItem
+	24,  // 31: inventory.v1.ReleaseStockRequest.items:type_name -> inventory.v1.StockItem
+	83,  // 32: inventory.v1.PriceChange.effective_from:type_name -> google.protobuf.Timestamp
+	83,  // 33: inventory.v1.PriceChange.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 34: inventory.v1.SchedulePriceChangeRequest.effective_from:type_name -> google.protobuf.Timestamp
+	29,  // 35: inventory.v1.SchedulePriceChangeResponse.change:type_name -> inventory.v1.PriceChange
+	29,  // 36: inventory.v1.GetPriceHistoryResponse.changes:type_name -> inventory.v1.PriceChange
+	5,   // 37: inventory.v1.LowStockItem.level:type_name -> inventory.v1.StockLevel
+	83,  // 38: inventory.v1.LowStockItem.alerted_at:type_name -> google.protobuf.Timestamp
+	34,  // 39: inventory.v1.ListLowStockResponse.items:type_name -> inventory.v1.LowStockItem
+	83,  // 40: inventory.v1.GoodsReceipt.received_at:type_name -> google.protobuf.Timestamp
+	83,  // 41: inventory.v1.PurchaseOrderLine.expected_at:type_name -> google.protobuf.Timestamp
+	39,  // 42: inventory.v1.PurchaseOrderLine.receipts:type_name -> inventory.v1.GoodsReceipt
+	8,   // 43: inventory.v1.PurchaseOrder.manufacturer:type_name -> inventory.v1.Manufacter
+	6,   // 44: inventory.v1.PurchaseOrder.status:type_name -> inventory.v1.PurchaseOrderStatus
+	40,  // 45: inventory.v1.PurchaseOrder.lines:type_name -> inventory.v1.PurchaseOrderLine
+	83,  // 46: inventory.v1.PurchaseOrder.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 47: inventory.v1.PurchaseOrder.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 48: inventory.v1.CreatePurchaseOrderRequest.manufacturer:type_name -> inventory.v1.Manufacter
+	40,  // 49: inventory.v1.CreatePurchaseOrderRequest.lines:type_name -> inventory.v1.PurchaseOrderLine
+	41,  // 50: inventory.v1.CreatePurchaseOrderResponse.purchase_order:type_name -> inventory.v1.PurchaseOrder
+	41,  // 51: inventory.v1.GetPurchaseOrderResponse.purchase_order:type_name -> inventory.v1.PurchaseOrder
+	24,  // 52: inventory.v1.ReceivePurchaseOrderRequest.items:type_name -> inventory.v1.StockItem
+	41,  // 53: inventory.v1.ReceivePurchaseOrderResponse.purchase_order:type_name -> inventory.v1.PurchaseOrder
+	41,  // 54: inventory.v1.CancelPurchaseOrderLinesResponse.purchase_order:type_name -> inventory.v1.PurchaseOrder
+	8,   // 55: inventory.v1.OpenPurchaseLine.manufacturer:type_name -> inventory.v1.Manufacter
+	83,  // 56: inventory.v1.OpenPurchaseLine.expected_at:type_name -> google.protobuf.Timestamp
+	51,  // 57: inventory.v1.PartOpenPurchases.lines:type_name -> inventory.v1.OpenPurchaseLine
+	52,  // 58: inventory.v1.ListOpenPurchaseOrdersResponse.parts:type_name -> inventory.v1.PartOpenPurchases
+	10,  // 59: inventory.v1.CreateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	10,  // 60: inventory.v1.CreateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	10,  // 61: inventory.v1.GetManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	10,  // 62: inventory.v1.UpdateManufacturerRequest.manufacturer:type_name -> inventory.v1.Manufacturer
+	10,  // 63: inventory.v1.UpdateManufacturerResponse.manufacturer:type_name -> inventory.v1.Manufacturer
+	1,   // 64: inventory.v1.ListManufacturersRequest.certification_statuses:type_name -> inventory.v1.CertificationStatus
+	10,  // 65: inventory.v1.ListManufacturersResponse.manufacturers:type_name -> inventory.v1.Manufacturer
+	12,  // 66: inventory.v1.CreateCategoryRequest.category:type_name -> inventory.v1.PartCategory
+	12,  // 67: inventory.v1.CreateCategoryResponse.category:type_name -> inventory.v1.PartCategory
+	12,  // 68: inventory.v1.GetCategoryResponse.category:type_name -> inventory.v1.PartCategory
+	11,  // 69: inventory.v1.GetCategoryResponse.effective_attributes:type_name -> inventory.v1.AttributeSchema
+	12,  // 70: inventory.v1.UpdateCategoryRequest.category:type_name -> inventory.v1.PartCategory
+	12,  // 71: inventory.v1.UpdateCategoryResponse.category:type_name -> inventory.v1.PartCategory
+	13,  // 72: inventory.v1.ListCategoryTreeResponse.roots:type_name -> inventory.v1.CategoryTreeNode
+	82,  // 73: inventory.v1.SetPartCategoryRequest.metadata:type_name -> inventory.v1.SetPartCategoryRequest.MetadataEntry
+	24,  // 74: inventory.v1.ValidateConfigurationRequest.items:type_name -> inventory.v1.StockItem
+	4,   // 75: inventory.v1.ConfigurationViolation.rule:type_name -> inventory.v1.CompatibilityRuleType
+	0,   // 76: inventory.v1.ConfigurationViolation.target_category:type_name -> inventory.v1.Category
+	79,  // 77: inventory.v1.ValidateConfigurationResponse.violations:type_name -> inventory.v1.ConfigurationViolation
+	14,  // 78: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	14,  // 79: inventory.v1.SetPartCategoryRequest.MetadataEntry.value:type_name -> inventory.v1.Value
+	20,  // 80: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	22,  // 81: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	25,  // 82: inventory.v1.InventoryService.ReserveStock:input_type -> inventory.v1.ReserveStockRequest
+	27,  // 83: inventory.v1.InventoryService.ReleaseStock:input_type -> inventory.v1.ReleaseStockRequest
+	78,  // 84: inventory.v1.InventoryService.ValidateConfiguration:input_type -> inventory.v1.ValidateConfigurationRequest
+	30,  // 85: inventory.v1.InventoryService.SchedulePriceChange:input_type -> inventory.v1.SchedulePriceChangeRequest
+	32,  // 86: inventory.v1.InventoryService.GetPriceHistory:input_type -> inventory.v1.GetPriceHistoryRequest
+	37,  // 87: inventory.v1.InventoryService.SetReorderLevels:input_type -> inventory.v1.SetReorderLevelsRequest
+	35,  // 88: inventory.v1.InventoryService.ListLowStock:input_type -> inventory.v1.ListLowStockRequest
+	42,  // 89: inventory.v1.InventoryService.CreatePurchaseOrder:input_type -> inventory.v1.CreatePurchaseOrderRequest
+	44,  // 90: inventory.v1.InventoryService.GetPurchaseOrder:input_type -> inventory.v1.GetPurchaseOrderRequest
+	46,  // 91: inventory.v1.InventoryService.ReceivePurchaseOrder:input_type -> inventory.v1.ReceivePurchaseOrderRequest
+	48,  // 92: inventory.v1.InventoryService.CancelPurchaseOrderLines:input_type -> inventory.v1.CancelPurchaseOrderLinesRequest
+	50,  // 93: inventory.v1.InventoryService.ListOpenPurchaseOrders:input_type -> inventory.v1.ListOpenPurchaseOrdersRequest
+	54,  // 94: inventory.v1.InventoryService.CreateManufacturer:input_type -> inventory.v1.CreateManufacturerRequest
+	56,  // 95: inventory.v1.InventoryService.GetManufacturer:input_type -> inventory.v1.GetManufacturerRequest
+	58,  // 96: inventory.v1.InventoryService.UpdateManufacturer:input_type -> inventory.v1.UpdateManufacturerRequest
+	60,  // 97: inventory.v1.InventoryService.DeleteManufacturer:input_type -> inventory.v1.DeleteManufacturerRequest
+	62,  // 98: inventory.v1.InventoryService.ListManufacturers:input_type -> inventory.v1.ListManufacturersRequest
+	64,  // 99: inventory.v1.InventoryService.SetPartManufacturer:input_type -> inventory.v1.SetPartManufacturerRequest
+	66,  // 100: inventory.v1.InventoryService.CreateCategory:input_type -> inventory.v1.CreateCategoryRequest
+	68,  // 101: inventory.v1.InventoryService.GetCategory:input_type -> inventory.v1.GetCategoryRequest
+	70,  // 102: inventory.v1.InventoryService.UpdateCategory:input_type -> inventory.v1.UpdateCategoryRequest
+	72,  // 103: inventory.v1.InventoryService.DeleteCategory:input_type -> inventory.v1.DeleteCategoryRequest
+	74,  // 104: inventory.v1.InventoryService.ListCategoryTree:input_type -> inventory.v1.ListCategoryTreeRequest
+	76,  // 105: inventory.v1.InventoryService.SetPartCategory:input_type -> inventory.v1.SetPartCategoryRequest
+	21,  // 106: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	23,  // 107: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	26,  // 108: inventory.v1.InventoryService.ReserveStock:output_type -> inventory.v1.ReserveStockResponse
+	28,  // 109: inventory.v1.InventoryService.ReleaseStock:output_type -> inventory.v1.ReleaseStockResponse
+	80,  // 110: inventory.v1.InventoryService.ValidateConfiguration:output_type -> inventory.v1.ValidateConfigurationResponse
+	31,  // 111: inventory.v1.InventoryService.SchedulePriceChange:output_type -> inventory.v1.SchedulePriceChangeResponse
+	33,  // 112: inventory.v1.InventoryService.GetPriceHistory:output_type -> inventory.v1.GetPriceHistoryResponse
+	38,  // 113: inventory.v1.InventoryService.SetReorderLevels:output_type -> inventory.v1.SetReorderLevelsResponse
+	36,  // 114: inventory.v1.InventoryService.ListLowStock:output_type -> inventory.v1.ListLowStockResponse
+	43,  // 115: inventory.v1.InventoryService.CreatePurchaseOrder:output_type -> inventory.v1.CreatePurchaseOrderResponse
+	45,  // 116: inventory.v1.InventoryService.GetPurchaseOrder:output_type -> inventory.v1.GetPurchaseOrderResponse
+	47,  // 117: inventory.v1.InventoryService.ReceivePurchaseOrder:output_type -> inventory.v1.ReceivePurchaseOrderResponse
+	49,  // 118: inventory.v1.InventoryService.CancelPurchaseOrderLines:output_type -> inventory.v1.CancelPurchaseOrderLinesResponse
+	53,  // 119: inventory.v1.InventoryService.ListOpenPurchaseOrders:output_type -> inventory.v1.ListOpenPurchaseOrdersResponse
+	55,  // 120: inventory.v1.InventoryService.CreateManufacturer:output_type -> inventory.v1.CreateManufacturerResponse
+	57,  // 121: inventory.v1.InventoryService.GetManufacturer:output_type -> inventory.v1.GetManufacturerResponse
+	59,  // 122: inventory.v1.InventoryService.UpdateManufacturer:output_type -> inventory.v1.UpdateManufacturerResponse
+	61,  // 123: inventory.v1.InventoryService.DeleteManufacturer:output_type -> inventory.v1.DeleteManufacturerResponse
+	63,  // 124: inventory.v1.InventoryService.ListManufacturers:output_type -> inventory.v1.ListManufacturersResponse
+	65,  // 125: inventory.v1.InventoryService.SetPartManufacturer:output_type -> inventory.v1.SetPartManufacturerResponse
+	67,  // 126: inventory.v1.InventoryService.CreateCategory:output_type -> inventory.v1.CreateCategoryResponse
+	69,  // 127: inventory.v1.InventoryService.GetCategory:output_type -> inventory.v1.GetCategoryResponse
+	71,  // 128: inventory.v1.InventoryService.UpdateCategory:output_type -> inventory.v1.UpdateCategoryResponse
+	73,  // 129: inventory.v1.InventoryService.DeleteCategory:output_type -> inventory.v1.DeleteCategoryResponse
+	75,  // 130: inventory.v1.InventoryService.ListCategoryTree:output_type -> inventory.v1.ListCategoryTreeResponse
+	77,  // 131: inventory.v1.InventoryService.SetPartCategory:output_type -> inventory.v1.SetPartCategoryResponse
+	106, // [106:132] is the sub-list for method output_type
+	80,  // [80:106] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_proto_init() }
@@ -832,7 +5161,7 @@ func file_proto_inventory_proto_init() {
 	if File_proto_inventory_proto != nil {
 		return
 	}
-	file_proto_inventory_proto_msgTypes[2].OneofWrappers = []any{
+	file_proto_inventory_proto_msgTypes[7].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -843,8 +5172,8 @@ func file_proto_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_proto_rawDesc), len(file_proto_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      7,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
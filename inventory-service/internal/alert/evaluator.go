@@ -0,0 +1,113 @@
+// Package alert watches part stock and reports parts that run low or out of
+// stock.
+package alert
+
+import (
+	"context"
+	"inventory-service/internal/model"
+	repo "inventory-service/repository"
+	"log"
+	"time"
+)
+
+type Level string
+
+const (
+	LevelLowStock   Level = "LOW_STOCK"
+	LevelOutOfStock Level = "OUT_OF_STOCK"
+)
+
+// Alert is what a Notifier gets for a part that reached a new stock level.
+type Alert struct {
+	PartUUID         string    `json:"part_uuid" bson:"part_uuid"`
+	Name             string    `json:"name" bson:"name"`
+	Level            Level     `json:"level" bson:"level"`
+	StockQuantity    int64     `json:"stock_quantity" bson:"stock_quantity"`
+	ReorderThreshold int64     `json:"reorder_threshold" bson:"reorder_threshold"`
+	TargetLevel      int64     `json:"target_level" bson:"target_level"`
+	ReorderQuantity  int64     `json:"reorder_quantity" bson:"reorder_quantity"`
+	At               time.Time `json:"at" bson:"at"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// Evaluator periodically checks the stock and sends one alert per part and
+// level: a part that stays low is not reported again, a part that goes from
+// low to out of stock is, and a part that was restocked is reported again
+// the next time it runs low.
+type Evaluator struct {
+	repo     repo.PartRepo
+	notifier Notifier
+	now      func() time.Time
+}
+
+func NewEvaluator(r repo.PartRepo, n Notifier) *Evaluator {
+	return &Evaluator{repo: r, notifier: n, now: time.Now}
+}
+
+// Run evaluates the stock every interval until ctx is done.
+func (e *Evaluator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := e.Evaluate(ctx); err != nil {
+			log.Printf("low-stock evaluation failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate sends the alerts that are due. An alert that could not be sent is
+// retried on the next run.
+func (e *Evaluator) Evaluate(ctx context.Context) error {
+	parts, err := e.repo.ListLowStock(ctx)
+	if err != nil {
+		return err
+	}
+	keep := make([]string, len(parts))
+	for i, p := range parts {
+		keep[i] = p.UUID
+	}
+	if err := e.repo.ClearStockAlerts(ctx, keep); err != nil {
+		return err
+	}
+
+	for _, p := range parts {
+		level := p.StockLevel()
+		if p.StockAlert != nil && p.StockAlert.Level == level {
+			continue
+		}
+		alert := newAlert(p, e.now())
+		if err := e.notifier.Notify(ctx, alert); err != nil {
+			log.Printf("low-stock alert for %s not sent: %v", p.UUID, err)
+			continue
+		}
+		if err := e.repo.SetStockAlert(ctx, p.UUID, model.StockAlert{Level: level, At: alert.At}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newAlert(p model.Part, at time.Time) Alert {
+	level := LevelLowStock
+	if p.StockLevel() == model.StockLevelOut {
+		level = LevelOutOfStock
+	}
+	return Alert{
+		PartUUID:         p.UUID,
+		Name:             p.Name,
+		Level:            level,
+		StockQuantity:    p.StockQuantity,
+		ReorderThreshold: p.ReorderThreshold,
+		TargetLevel:      p.TargetLevel,
+		ReorderQuantity:  p.ReorderQuantity(),
+		At:               at,
+	}
+}
@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"inventory-service/internal/model"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeNotifier struct {
+	alerts []Alert
+	err    error
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, a Alert) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.alerts = append(n.alerts, a)
+	return nil
+}
+
+type EvaluatorTest struct {
+	suite.Suite
+
+	repo      *mocks.PartRepo
+	notifier  *fakeNotifier
+	evaluator *Evaluator
+	now       time.Time
+}
+
+func (s *EvaluatorTest) SetupTest() {
+	s.repo = mocks.NewPartRepo(s.T())
+	s.notifier = &fakeNotifier{}
+	s.evaluator = NewEvaluator(s.repo, s.notifier)
+	s.now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	s.evaluator.now = func() time.Time { return s.now }
+}
+
+func TestEvaluatorTest(t *testing.T) {
+	suite.Run(t, new(EvaluatorTest))
+}
+
+func (s *EvaluatorTest) TestEvaluate_SendsNewLevelsOnly() {
+	ctx := context.Background()
+	s.repo.On("ListLowStock", ctx).Return([]model.Part{
+		{UUID: "engine-1", Name: "Engine", StockQuantity: 0, ReorderThreshold: 2, TargetLevel: 10},
+		{UUID: "wing-1", Name: "Wing", StockQuantity: 1, ReorderThreshold: 2, TargetLevel: 6,
+			StockAlert: &model.StockAlert{Level: model.StockLevelLow}},
+		{UUID: "fuel-1", Name: "Fuel", StockQuantity: 3, ReorderThreshold: 5, TargetLevel: 20,
+			StockAlert: &model.StockAlert{Level: model.StockLevelOut}},
+	}, nil)
+	s.repo.On("ClearStockAlerts", ctx, []string{"engine-1", "wing-1", "fuel-1"}).Return(nil)
+	s.repo.On("SetStockAlert", ctx, "engine-1", model.StockAlert{Level: model.StockLevelOut, At: s.now}).Return(nil)
+	s.repo.On("SetStockAlert", ctx, "fuel-1", model.StockAlert{Level: model.StockLevelLow, At: s.now}).Return(nil)
+
+	s.Require().NoError(s.evaluator.Evaluate(ctx))
+	s.Equal([]Alert{
+		{PartUUID: "engine-1", Name: "Engine", Level: LevelOutOfStock, ReorderThreshold: 2, TargetLevel: 10, ReorderQuantity: 10, At: s.now},
+		{PartUUID: "fuel-1", Name: "Fuel", Level: LevelLowStock, StockQuantity: 3, ReorderThreshold: 5, TargetLevel: 20, ReorderQuantity: 17, At: s.now},
+	}, s.notifier.alerts)
+}
+
+func (s *EvaluatorTest) TestEvaluate_RetriesFailedAlerts() {
+	ctx := context.Background()
+	s.notifier.err = errors.New("webhook down")
+	s.repo.On("ListLowStock", ctx).Return([]model.Part{{UUID: "engine-1", StockQuantity: 0}}, nil)
+	s.repo.On("ClearStockAlerts", ctx, []string{"engine-1"}).Return(nil)
+
+	s.Require().NoError(s.evaluator.Evaluate(ctx))
+	s.repo.AssertNotCalled(s.T(), "SetStockAlert", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *EvaluatorTest) TestWebhookNotifier() {
+	var got Alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+		s.NoError(json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	alert := Alert{PartUUID: "engine-1", Level: LevelOutOfStock, At: s.now}
+	s.Require().NoError(NewWebhookNotifier(srv.URL).Notify(context.Background(), alert))
+	s.Equal(alert, got)
+}
+
+func (s *EvaluatorTest) TestWebhookNotifier_ErrorStatus() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	err := NewWebhookNotifier(srv.URL).Notify(context.Background(), Alert{PartUUID: "engine-1"})
+	s.ErrorContains(err, "503")
+}
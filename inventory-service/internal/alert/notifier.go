@@ -0,0 +1,75 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// LogNotifier writes alerts to the service log.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, a Alert) error {
+	log.Printf("%s: part %s (%s) stock %d, threshold %d, reorder %d",
+		a.Level, a.PartUUID, a.Name, a.StockQuantity, a.ReorderThreshold, a.ReorderQuantity)
+	return nil
+}
+
+// WebhookNotifier posts alerts as JSON to a URL. Any non-2xx response is an
+// error, so the alert is retried.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// OutboxNotifier stores alerts in a collection for another process to
+// deliver. Delivered entries are expected to get sent_at set.
+type OutboxNotifier struct {
+	col *mongo.Collection
+}
+
+func NewOutboxNotifier(col *mongo.Collection) *OutboxNotifier {
+	return &OutboxNotifier{col: col}
+}
+
+type outboxEntry struct {
+	Alert     Alert      `bson:"alert"`
+	CreatedAt time.Time  `bson:"created_at"`
+	SentAt    *time.Time `bson:"sent_at"`
+}
+
+func (n *OutboxNotifier) Notify(ctx context.Context, a Alert) error {
+	_, err := n.col.InsertOne(ctx, outboxEntry{Alert: a, CreatedAt: time.Now()})
+	return err
+}
@@ -3,6 +3,8 @@ package converter
 import (
 	"inventory-service/grpc/inventorypb"
 	"inventory-service/internal/model"
+	"sort"
+	"strconv"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,24 +23,210 @@ func ToProto(p model.Part) *inventorypb.Part {
 
 	var manuf *inventorypb.Manufacter
 	if p.Manufacter != nil {
-		manuf = &inventorypb.Manufacter{
-			Name:    p.Manufacter.Name,
-			Country: p.Manufacter.Country,
-			Website: p.Manufacter.Website,
+		manuf = ManufacterToProto(*p.Manufacter)
+	}
+
+	var kit *inventorypb.Kit
+	if p.Kit != nil {
+		kit = &inventorypb.Kit{
+			Pricing:         inventorypb.KitPricing(p.Kit.Pricing),
+			DiscountPercent: p.Kit.DiscountPercent,
+		}
+		for _, c := range p.Kit.Components {
+			kit.Components = append(kit.Components, &inventorypb.KitComponent{
+				PartUuid: c.PartUUID,
+				Quantity: c.Quantity,
+			})
 		}
 	}
 
+	var rules []*inventorypb.CompatibilityRule
+	for _, r := range p.Compatibility {
+		rules = append(rules, &inventorypb.CompatibilityRule{
+			Type:           inventorypb.CompatibilityRuleType(r.Type),
+			TargetPartUuid: r.TargetPartUUID,
+			TargetCategory: inventorypb.Category(r.TargetCategory),
+			Quantity:       r.Quantity,
+		})
+	}
+
 	return &inventorypb.Part{
-		Uuid:          p.UUID,
-		Name:          p.Name,
-		Description:   p.Description,
-		Category:      inventorypb.Category(p.Category),
-		Price:         p.Price,
-		StockQuantity: p.StockQuantity,
-		Dimensions:    dimensions,
-		Manufacter:    manuf,
-		Tags:          p.Tags,
-		CreatedAt:     timestamppb.New(p.CreatedAt),
-		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+		Uuid:             p.UUID,
+		Name:             p.Name,
+		Description:      p.Description,
+		Category:         inventorypb.Category(p.Category),
+		Price:            p.Price,
+		StockQuantity:    p.StockQuantity,
+		Dimensions:       dimensions,
+		Manufacter:       manuf,
+		Tags:             p.Tags,
+		Kit:              kit,
+		Compatibility:    rules,
+		ReorderThreshold: p.ReorderThreshold,
+		TargetLevel:      p.TargetLevel,
+		ManufacturerId:   p.ManufacturerID,
+		CategoryId:       p.CategoryID,
+		Metadata:         MetadataToProto(p.Metadata),
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+		UpdatedAt:        timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// PriceHistoryToProto converts price changes ordered by effective_from; changes
+// effective at the same moment keep the order they were recorded in.
+func PriceHistoryToProto(changes []model.PriceChange) []*inventorypb.PriceChange {
+	sorted := make([]model.PriceChange, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveFrom.Before(sorted[j].EffectiveFrom)
+	})
+	res := make([]*inventorypb.PriceChange, len(sorted))
+	for i, c := range sorted {
+		res[i] = PriceChangeToProto(c)
+	}
+	return res
+}
+
+func PriceChangeToProto(c model.PriceChange) *inventorypb.PriceChange {
+	return &inventorypb.PriceChange{
+		Price:         c.Price,
+		EffectiveFrom: timestamppb.New(c.EffectiveFrom),
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+	}
+}
+
+func LowStockToProto(p model.Part) *inventorypb.LowStockItem {
+	item := &inventorypb.LowStockItem{
+		PartUuid:         p.UUID,
+		Name:             p.Name,
+		StockQuantity:    p.StockQuantity,
+		ReorderThreshold: p.ReorderThreshold,
+		TargetLevel:      p.TargetLevel,
+		Level:            inventorypb.StockLevel(p.StockLevel()),
+		ReorderQuantity:  p.ReorderQuantity(),
+	}
+	if p.StockAlert != nil && p.StockAlert.Level == p.StockLevel() {
+		item.AlertedAt = timestamppb.New(p.StockAlert.At)
+	}
+	return item
+}
+
+func ManufacterToProto(m model.Manufacter) *inventorypb.Manufacter {
+	return &inventorypb.Manufacter{
+		Name:    m.Name,
+		Country: m.Country,
+		Website: m.Website,
+	}
+}
+
+func PurchaseOrderToProto(po model.PurchaseOrder) *inventorypb.PurchaseOrder {
+	res := &inventorypb.PurchaseOrder{
+		Uuid:         po.UUID,
+		Manufacturer: ManufacterToProto(po.Manufacturer),
+		Status:       inventorypb.PurchaseOrderStatus(po.Status),
+		CreatedBy:    po.CreatedBy,
+		CreatedAt:    timestamppb.New(po.CreatedAt),
+		UpdatedAt:    timestamppb.New(po.UpdatedAt),
+	}
+	for _, l := range po.Lines {
+		line := &inventorypb.PurchaseOrderLine{
+			PartUuid:          l.PartUUID,
+			Quantity:          l.Quantity,
+			UnitCost:          l.UnitCost,
+			ReceivedQuantity:  l.ReceivedQuantity,
+			CancelledQuantity: l.CancelledQuantity,
+		}
+		if l.ExpectedAt != nil {
+			line.ExpectedAt = timestamppb.New(*l.ExpectedAt)
+		}
+		for _, r := range l.Receipts {
+			line.Receipts = append(line.Receipts, &inventorypb.GoodsReceipt{
+				Quantity:   r.Quantity,
+				ReceivedBy: r.ReceivedBy,
+				ReceivedAt: timestamppb.New(r.ReceivedAt),
+			})
+		}
+		res.Lines = append(res.Lines, line)
+	}
+	return res
+}
+
+func ManufacturerToProto(m model.Manufacturer) *inventorypb.Manufacturer {
+	res := &inventorypb.Manufacturer{
+		Id:      m.ID,
+		Name:    m.Name,
+		Country: m.Country,
+		Website: m.Website,
+		Contact: &inventorypb.ManufacturerContact{
+			Name:  m.Contact.Name,
+			Email: m.Contact.Email,
+			Phone: m.Contact.Phone,
+		},
+		CertificationStatus: inventorypb.CertificationStatus(m.CertificationStatus),
+		CertificateNumber:   m.CertificateNumber,
+		CreatedAt:           timestamppb.New(m.CreatedAt),
+		UpdatedAt:           timestamppb.New(m.UpdatedAt),
+	}
+	if m.CertifiedUntil != nil {
+		res.CertifiedUntil = timestamppb.New(*m.CertifiedUntil)
+	}
+	return res
+}
+
+// MetadataToProto returns the metadata as string values, the way it is
+// stored; the category attributes tell how to read them.
+func MetadataToProto(metadata map[string]string) map[string]*inventorypb.Value {
+	if len(metadata) == 0 {
+		return nil
+	}
+	res := make(map[string]*inventorypb.Value, len(metadata))
+	for k, v := range metadata {
+		res[k] = &inventorypb.Value{Kind: &inventorypb.Value_StringValue{StringValue: v}}
+	}
+	return res
+}
+
+// MetadataFromProto formats the values the way ParseInt, ParseFloat and
+// ParseBool read them back.
+func MetadataFromProto(metadata map[string]*inventorypb.Value) map[string]string {
+	res := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		switch kind := v.GetKind().(type) {
+		case *inventorypb.Value_StringValue:
+			res[k] = kind.StringValue
+		case *inventorypb.Value_Int64Value:
+			res[k] = strconv.FormatInt(kind.Int64Value, 10)
+		case *inventorypb.Value_DoubleValue:
+			res[k] = strconv.FormatFloat(kind.DoubleValue, 'g', -1, 64)
+		case *inventorypb.Value_BoolValue:
+			res[k] = strconv.FormatBool(kind.BoolValue)
+		}
+	}
+	return res
+}
+
+func CategoryToProto(c model.PartCategory) *inventorypb.PartCategory {
+	return &inventorypb.PartCategory{
+		Id:             c.ID,
+		Name:           c.Name,
+		ParentId:       c.ParentID,
+		LegacyCategory: inventorypb.Category(c.LegacyCategory),
+		Attributes:     AttributesToProto(c.Attributes),
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func AttributesToProto(attrs []model.AttributeSchema) []*inventorypb.AttributeSchema {
+	var res []*inventorypb.AttributeSchema
+	for _, a := range attrs {
+		res = append(res, &inventorypb.AttributeSchema{
+			Name:          a.Name,
+			Type:          inventorypb.AttributeType(a.Type),
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+			Description:   a.Description,
+		})
 	}
+	return res
 }
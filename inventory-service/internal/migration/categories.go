@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CategoriesResult struct {
+	Created int
+	Linked  int
+}
+
+// SeedCategories creates a root category for every value of the legacy
+// Category enum that has no category mapped to it yet, and puts the parts
+// that are not in the tree into the category of their legacy value.
+func SeedCategories(ctx context.Context, parts, categories *mongo.Collection) (CategoriesResult, error) {
+	var res CategoriesResult
+	values := make([]int32, 0, len(inventorypb.Category_name))
+	for v := range inventorypb.Category_name {
+		if v != int32(inventorypb.Category_CATEGORY_UNKNOWN) {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	for _, v := range values {
+		var c model.PartCategory
+		err := categories.FindOne(ctx, bson.M{"legacy_category": v}).Decode(&c)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			now := time.Now()
+			c = model.PartCategory{
+				ID:             uuid.New().String(),
+				Name:           legacyCategoryName(v),
+				LegacyCategory: v,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			}
+			if _, err := categories.InsertOne(ctx, c); err != nil {
+				return res, err
+			}
+			res.Created++
+		} else if err != nil {
+			return res, err
+		}
+
+		upd, err := parts.UpdateMany(ctx,
+			bson.M{"category": v, "category_id": bson.M{"$in": bson.A{nil, ""}}},
+			bson.M{"$set": bson.M{"category_id": c.ID}},
+		)
+		if err != nil {
+			return res, err
+		}
+		res.Linked += int(upd.ModifiedCount)
+	}
+	return res, nil
+}
+
+// legacyCategoryName turns CATEGORY_PORTHOLE into Porthole.
+func legacyCategoryName(v int32) string {
+	name := strings.ToLower(strings.TrimPrefix(inventorypb.Category_name[v], "CATEGORY_"))
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,124 @@
+// Package migration holds the data migrations run by inventory-service on
+// start. They are idempotent.
+package migration
+
+import (
+	"context"
+	"errors"
+	"inventory-service/internal/model"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ManufacturersResult struct {
+	Created int
+	Linked  int
+}
+
+type manufacturerGroup struct {
+	spellings map[string]int
+	first     string
+	country   string
+	website   string
+	parts     []string
+}
+
+// name is the most common spelling, the first one seen on a tie.
+func (g *manufacturerGroup) name() string {
+	best := g.first
+	for spelling, n := range g.spellings {
+		if n > g.spellings[best] {
+			best = spelling
+		}
+	}
+	return best
+}
+
+// DedupeManufacturers moves the manufacturers embedded in parts into the
+// registry. Parts whose manufacturer names only differ in case, spaces or
+// punctuation are linked to one entry, named after the most common
+// spelling, and their embedded copy is rewritten to match it. Parts that
+// are already linked are left alone.
+func DedupeManufacturers(ctx context.Context, parts, manufacturers *mongo.Collection) (ManufacturersResult, error) {
+	var res ManufacturersResult
+	cur, err := parts.Find(ctx, bson.M{
+		"manufacturer_id": bson.M{"$in": bson.A{nil, ""}},
+		"manufacter.name": bson.M{"$nin": bson.A{nil, ""}},
+	}, options.Find().SetSort(bson.M{"uuid": 1}))
+	if err != nil {
+		return res, err
+	}
+	defer cur.Close(ctx)
+
+	groups := make(map[string]*manufacturerGroup)
+	for cur.Next(ctx) {
+		var p model.Part
+		if err := cur.Decode(&p); err != nil {
+			return res, err
+		}
+		key := model.NormalizeManufacturerName(p.Manufacter.Name)
+		if key == "" {
+			continue
+		}
+		g, ok := groups[key]
+		if !ok {
+			g = &manufacturerGroup{spellings: make(map[string]int), first: p.Manufacter.Name}
+			groups[key] = g
+		}
+		g.spellings[p.Manufacter.Name]++
+		if g.country == "" {
+			g.country = p.Manufacter.Country
+		}
+		if g.website == "" {
+			g.website = p.Manufacter.Website
+		}
+		g.parts = append(g.parts, p.UUID)
+	}
+	if err := cur.Err(); err != nil {
+		return res, err
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		g := groups[key]
+		var m model.Manufacturer
+		err := manufacturers.FindOne(ctx, bson.M{"normalized_name": key}).Decode(&m)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			now := time.Now()
+			m = model.Manufacturer{
+				ID:             uuid.New().String(),
+				Name:           g.name(),
+				NormalizedName: key,
+				Country:        g.country,
+				Website:        g.website,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			}
+			if _, err := manufacturers.InsertOne(ctx, m); err != nil {
+				return res, err
+			}
+			res.Created++
+		} else if err != nil {
+			return res, err
+		}
+
+		upd, err := parts.UpdateMany(ctx,
+			bson.M{"uuid": bson.M{"$in": g.parts}},
+			bson.M{"$set": bson.M{"manufacturer_id": m.ID, "manufacter": m.View()}},
+		)
+		if err != nil {
+			return res, err
+		}
+		res.Linked += int(upd.ModifiedCount)
+	}
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrCategoryExists = errors.New("category with this name already exists under the parent")
+	ErrCategoryInUse  = errors.New("category has children or parts")
+)
+
+// Attribute types, the same values as inventorypb.AttributeType.
+const (
+	AttributeString int32 = 0
+	AttributeInt    int32 = 1
+	AttributeDouble int32 = 2
+	AttributeBool   int32 = 3
+)
+
+// PartCategory is a node of the category tree. LegacyCategory is one of the
+// inventorypb.Category values, 0 meaning inherited from the parent.
+type PartCategory struct {
+	ID             string            `bson:"id"`
+	Name           string            `bson:"name"`
+	ParentID       string            `bson:"parent_id"`
+	LegacyCategory int32             `bson:"legacy_category"`
+	Attributes     []AttributeSchema `bson:"attributes,omitempty"`
+	CreatedAt      time.Time         `bson:"created_at"`
+	UpdatedAt      time.Time         `bson:"updated_at"`
+}
+
+// AttributeSchema describes a metadata key. Type is one of the
+// inventorypb.AttributeType values.
+type AttributeSchema struct {
+	Name          string   `bson:"name"`
+	Type          int32    `bson:"type"`
+	Required      bool     `bson:"required"`
+	AllowedValues []string `bson:"allowed_values,omitempty"`
+	Description   string   `bson:"description,omitempty"`
+}
+
+// Check reports whether value, as stored in part metadata, is of the
+// attribute type and one of the allowed values.
+func (a AttributeSchema) Check(value string) error {
+	var err error
+	switch a.Type {
+	case AttributeInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case AttributeDouble:
+		_, err = strconv.ParseFloat(value, 64)
+	case AttributeBool:
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", value, attributeTypeName(a.Type))
+	}
+	if len(a.AllowedValues) > 0 && !slices.Contains(a.AllowedValues, value) {
+		return fmt.Errorf("%q is not one of %v", value, a.AllowedValues)
+	}
+	return nil
+}
+
+func attributeTypeName(t int32) string {
+	switch t {
+	case AttributeInt:
+		return "integer"
+	case AttributeDouble:
+		return "number"
+	case AttributeBool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
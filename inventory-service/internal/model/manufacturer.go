@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	ErrManufacturerExists = errors.New("manufacturer with this name already exists")
+	ErrManufacturerInUse  = errors.New("manufacturer is referenced by parts")
+)
+
+// Certification statuses, the same values as inventorypb.CertificationStatus.
+const (
+	CertificationUnknown   int32 = 0
+	CertificationPending   int32 = 1
+	CertificationCertified int32 = 2
+	CertificationSuspended int32 = 3
+	CertificationRevoked   int32 = 4
+)
+
+// Manufacturer is an entry of the manufacturer registry. Parts reference it
+// by ID and keep a copy of its Manufacter view.
+type Manufacturer struct {
+	ID                  string              `bson:"id"`
+	Name                string              `bson:"name"`
+	NormalizedName      string              `bson:"normalized_name"`
+	Country             string              `bson:"country"`
+	Website             string              `bson:"website"`
+	Contact             ManufacturerContact `bson:"contact"`
+	CertificationStatus int32               `bson:"certification_status"`
+	CertificateNumber   string              `bson:"certificate_number,omitempty"`
+	CertifiedUntil      *time.Time          `bson:"certified_until,omitempty"`
+	CreatedAt           time.Time           `bson:"created_at"`
+	UpdatedAt           time.Time           `bson:"updated_at"`
+}
+
+type ManufacturerContact struct {
+	Name  string `bson:"name,omitempty"`
+	Email string `bson:"email,omitempty"`
+	Phone string `bson:"phone,omitempty"`
+}
+
+// View is what parts embed of the manufacturer.
+func (m Manufacturer) View() Manufacter {
+	return Manufacter{
+		Name:    m.Name,
+		Country: m.Country,
+		Website: m.Website,
+	}
+}
+
+// NormalizeManufacturerName keeps only the lowercased letters and digits of
+// name, so "SpaceY", "Space Y" and "space-y" are the same manufacturer.
+func NormalizeManufacturerName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
@@ -1,20 +1,55 @@
 package model
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotEnoughStock = errors.New("not enough stock")
+	ErrInvalidKit     = errors.New("invalid kit")
+)
 
 type Part struct {
-	UUID          string            `bson:"uuid"`
-	Name          string            `bson:"name"`
-	Description   string            `bson:"description"`
-	Price         float64           `bson:"price"`
-	StockQuantity int64             `bson:"stock_quantity"`
-	Category      int32             `bson:"category"`
-	Dimensions    *Dimensions       `bson:"dimensions"`
-	Manufacter    *Manufacter       `bson:"manufacter"`
-	Tags          []string          `bson:"tags"`
-	Metadata      map[string]string `bson:"metadata"`
-	CreatedAt     time.Time         `bson:"created_at"`
-	UpdatedAt     time.Time         `bson:"updated_at"`
+	UUID          string  `bson:"uuid"`
+	Name          string  `bson:"name"`
+	Description   string  `bson:"description"`
+	Price         float64 `bson:"price"`
+	StockQuantity int64   `bson:"stock_quantity"`
+	Category      int32   `bson:"category"`
+	// CategoryID is the node of the category tree; Category is the legacy
+	// category derived from it.
+	CategoryID string      `bson:"category_id,omitempty"`
+	Dimensions *Dimensions `bson:"dimensions"`
+	Manufacter *Manufacter `bson:"manufacter"`
+	// ManufacturerID links the part to the registry; Manufacter is kept as a
+	// copy of the registry entry.
+	ManufacturerID string              `bson:"manufacturer_id,omitempty"`
+	Tags           []string            `bson:"tags"`
+	Metadata       map[string]string   `bson:"metadata"`
+	Kit            *Kit                `bson:"kit,omitempty"`
+	Compatibility  []CompatibilityRule `bson:"compatibility,omitempty"`
+	PriceHistory   []PriceChange       `bson:"price_history,omitempty"`
+	// ReorderThreshold and TargetLevel drive low-stock alerts; StockAlert is
+	// the last alert sent for the part.
+	ReorderThreshold int64       `bson:"reorder_threshold,omitempty"`
+	TargetLevel      int64       `bson:"target_level,omitempty"`
+	StockAlert       *StockAlert `bson:"stock_alert,omitempty"`
+	CreatedAt        time.Time   `bson:"created_at"`
+	UpdatedAt        time.Time   `bson:"updated_at"`
+}
+
+// Kit marks a part as a bundle of other parts. Pricing is one of the
+// inventorypb.KitPricing values.
+type Kit struct {
+	Components      []KitComponent `bson:"components"`
+	Pricing         int32          `bson:"pricing"`
+	DiscountPercent float64        `bson:"discount_percent"`
+}
+
+type KitComponent struct {
+	PartUUID string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
 }
 
 type Dimensions struct {
@@ -29,3 +64,51 @@ type Manufacter struct {
 	Country string `bson:"country"`
 	Website string `bson:"website"`
 }
+
+// CompatibilityRule is stored on the part it applies to. Type is one of the
+// inventorypb.CompatibilityRuleType values.
+type CompatibilityRule struct {
+	Type           int32  `bson:"type"`
+	TargetPartUUID string `bson:"target_part_uuid,omitempty"`
+	TargetCategory int32  `bson:"target_category,omitempty"`
+	Quantity       int64  `bson:"quantity,omitempty"`
+}
+
+// PriceChange is a price of a part effective from the given moment. Price on
+// the part itself is the price it was created with and stays untouched.
+type PriceChange struct {
+	Price         float64   `bson:"price"`
+	EffectiveFrom time.Time `bson:"effective_from"`
+	CreatedAt     time.Time `bson:"created_at"`
+}
+
+// Stock levels, the same values as inventorypb.StockLevel.
+const (
+	StockLevelOK  int32 = 0
+	StockLevelLow int32 = 1
+	StockLevelOut int32 = 2
+)
+
+type StockAlert struct {
+	Level int32     `bson:"level"`
+	At    time.Time `bson:"at"`
+}
+
+// StockLevel reports whether the part is out of stock or at or below its
+// reorder threshold.
+func (p Part) StockLevel() int32 {
+	switch {
+	case p.StockQuantity <= 0:
+		return StockLevelOut
+	case p.StockQuantity <= p.ReorderThreshold:
+		return StockLevelLow
+	default:
+		return StockLevelOK
+	}
+}
+
+// ReorderQuantity is the quantity needed to get the stock back to the
+// target level.
+func (p Part) ReorderQuantity() int64 {
+	return max(p.TargetLevel-p.StockQuantity, 0)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPurchaseOrderClosed  = errors.New("purchase order is closed")
+	ErrPurchaseOrderChanged = errors.New("purchase order was changed concurrently")
+)
+
+// Purchase order statuses, the same values as inventorypb.PurchaseOrderStatus.
+const (
+	PurchaseOrderOpen              int32 = 0
+	PurchaseOrderPartiallyReceived int32 = 1
+	PurchaseOrderReceived          int32 = 2
+	PurchaseOrderCancelled         int32 = 3
+)
+
+// PurchaseOrder restocks parts from a manufacturer. Version is bumped on every
+// update and guards against concurrent receipts.
+type PurchaseOrder struct {
+	UUID         string              `bson:"uuid"`
+	Manufacturer Manufacter          `bson:"manufacturer"`
+	Status       int32               `bson:"status"`
+	Lines        []PurchaseOrderLine `bson:"lines"`
+	CreatedBy    string              `bson:"created_by,omitempty"`
+	Version      int64               `bson:"version"`
+	CreatedAt    time.Time           `bson:"created_at"`
+	UpdatedAt    time.Time           `bson:"updated_at"`
+}
+
+type PurchaseOrderLine struct {
+	PartUUID          string         `bson:"part_uuid"`
+	Quantity          int64          `bson:"quantity"`
+	UnitCost          float64        `bson:"unit_cost"`
+	ExpectedAt        *time.Time     `bson:"expected_at,omitempty"`
+	ReceivedQuantity  int64          `bson:"received_quantity"`
+	CancelledQuantity int64          `bson:"cancelled_quantity"`
+	Receipts          []GoodsReceipt `bson:"receipts,omitempty"`
+}
+
+// GoodsReceipt records who received how much of a line and when.
+type GoodsReceipt struct {
+	Quantity   int64     `bson:"quantity"`
+	ReceivedBy string    `bson:"received_by"`
+	ReceivedAt time.Time `bson:"received_at"`
+}
+
+// OpenQuantity is what is still expected to be delivered on the line.
+func (l PurchaseOrderLine) OpenQuantity() int64 {
+	return l.Quantity - l.ReceivedQuantity - l.CancelledQuantity
+}
+
+// IsClosed reports whether nothing more can be received on the order.
+func (po PurchaseOrder) IsClosed() bool {
+	return po.Status == PurchaseOrderReceived || po.Status == PurchaseOrderCancelled
+}
+
+// UpdateStatus derives the status from the lines: an order with nothing left
+// open is RECEIVED, or CANCELLED if nothing was received at all.
+func (po *PurchaseOrder) UpdateStatus() {
+	var open, received int64
+	for _, l := range po.Lines {
+		open += l.OpenQuantity()
+		received += l.ReceivedQuantity
+	}
+	switch {
+	case open > 0 && received > 0:
+		po.Status = PurchaseOrderPartiallyReceived
+	case open > 0:
+		po.Status = PurchaseOrderOpen
+	case received > 0:
+		po.Status = PurchaseOrderReceived
+	default:
+		po.Status = PurchaseOrderCancelled
+	}
+}
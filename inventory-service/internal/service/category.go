@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/converter"
+	"inventory-service/internal/model"
+	repo "inventory-service/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrInvalidCategory = errors.New("invalid category")
+	ErrInvalidMetadata = errors.New("part metadata does not match the category attributes")
+)
+
+// CategoryService manages the category tree and the placement of parts in
+// it.
+type CategoryService interface {
+	Create(ctx context.Context, c *inventorypb.PartCategory) (*inventorypb.PartCategory, error)
+	// Get returns the category and its attributes merged with the ones of
+	// its ancestors.
+	Get(ctx context.Context, id string) (*inventorypb.PartCategory, []*inventorypb.AttributeSchema, error)
+	Update(ctx context.Context, c *inventorypb.PartCategory) (*inventorypb.PartCategory, error)
+	Delete(ctx context.Context, id string) error
+	// Tree returns the subtree of rootID, or the whole tree if it is empty.
+	Tree(ctx context.Context, rootID string) ([]*inventorypb.CategoryTreeNode, error)
+	SetPartCategory(ctx context.Context, partUUID, categoryID string, metadata map[string]*inventorypb.Value) error
+}
+
+type Categories struct {
+	repo  repo.CategoryRepo
+	parts repo.PartRepo
+	now   func() time.Time
+}
+
+func NewCategoryService(r repo.CategoryRepo, parts repo.PartRepo) CategoryService {
+	return &Categories{repo: r, parts: parts, now: time.Now}
+}
+
+func (s *Categories) Create(ctx context.Context, c *inventorypb.PartCategory) (*inventorypb.PartCategory, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	category, err := categoryFromProto(c)
+	if err != nil {
+		return nil, err
+	}
+	category.ID = uuid.New().String()
+	if err := tree.checkParent(category); err != nil {
+		return nil, err
+	}
+	category.CreatedAt = s.now()
+	category.UpdatedAt = category.CreatedAt
+	if err := s.repo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+	return converter.CategoryToProto(*category), nil
+}
+
+func (s *Categories) Get(ctx context.Context, id string) (*inventorypb.PartCategory, []*inventorypb.AttributeSchema, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, ok := tree.byID[id]
+	if !ok {
+		return nil, nil, fmt.Errorf("category %s: %w", id, mongo.ErrNoDocuments)
+	}
+	return converter.CategoryToProto(*c), converter.AttributesToProto(tree.attributes(id)), nil
+}
+
+// Update replaces the category. Moving it or changing its legacy category
+// updates the legacy category of the parts in the whole subtree.
+func (s *Categories) Update(ctx context.Context, c *inventorypb.PartCategory) (*inventorypb.PartCategory, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, ok := tree.byID[c.GetId()]
+	if !ok {
+		return nil, fmt.Errorf("category %s: %w", c.GetId(), mongo.ErrNoDocuments)
+	}
+	category, err := categoryFromProto(c)
+	if err != nil {
+		return nil, err
+	}
+	category.ID = current.ID
+	if err := tree.checkParent(category); err != nil {
+		return nil, err
+	}
+	category.CreatedAt = current.CreatedAt
+	category.UpdatedAt = s.now()
+	legacyChanged := category.ParentID != current.ParentID || category.LegacyCategory != current.LegacyCategory
+	if err := s.repo.Update(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if legacyChanged {
+		tree.byID[category.ID] = category
+		byLegacy := make(map[int32][]string)
+		for _, id := range tree.descendants(category.ID) {
+			legacy := tree.legacy(id)
+			byLegacy[legacy] = append(byLegacy[legacy], id)
+		}
+		for legacy, ids := range byLegacy {
+			if err := s.parts.SetLegacyCategory(ctx, ids, legacy); err != nil {
+				return nil, fmt.Errorf("update legacy category of parts: %w", err)
+			}
+		}
+	}
+	return converter.CategoryToProto(*category), nil
+}
+
+// Delete removes a category without children and parts.
+func (s *Categories) Delete(ctx context.Context, id string) error {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := tree.byID[id]; !ok {
+		return fmt.Errorf("category %s: %w", id, mongo.ErrNoDocuments)
+	}
+	if n := len(tree.children[id]); n > 0 {
+		return fmt.Errorf("%w: %d child categories", model.ErrCategoryInUse, n)
+	}
+	parts, err := s.parts.List(ctx, &inventorypb.PartsFilter{CategoryIds: []string{id}})
+	if err != nil {
+		return err
+	}
+	if len(parts) > 0 {
+		return fmt.Errorf("%w: %d parts", model.ErrCategoryInUse, len(parts))
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *Categories) Tree(ctx context.Context, rootID string) ([]*inventorypb.CategoryTreeNode, error) {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rootID == "" {
+		var roots []*inventorypb.CategoryTreeNode
+		for _, c := range tree.children[""] {
+			roots = append(roots, tree.node(c))
+		}
+		return roots, nil
+	}
+	root, ok := tree.byID[rootID]
+	if !ok {
+		return nil, fmt.Errorf("category %s: %w", rootID, mongo.ErrNoDocuments)
+	}
+	return []*inventorypb.CategoryTreeNode{tree.node(root)}, nil
+}
+
+// SetPartCategory moves the part to the category after checking its
+// metadata, the given one or the current one, against the attributes of the
+// category. The legacy category of the part follows the category.
+func (s *Categories) SetPartCategory(ctx context.Context, partUUID, categoryID string, metadata map[string]*inventorypb.Value) error {
+	tree, err := s.tree(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := tree.byID[categoryID]; !ok {
+		return fmt.Errorf("category %s: %w", categoryID, mongo.ErrNoDocuments)
+	}
+
+	var replace map[string]string
+	values := converter.MetadataFromProto(metadata)
+	if len(metadata) > 0 {
+		replace = values
+	} else {
+		part, err := s.parts.Get(ctx, partUUID)
+		if err != nil {
+			return fmt.Errorf("part %s: %w", partUUID, err)
+		}
+		values = converter.MetadataFromProto(part.Metadata)
+	}
+	if err := checkMetadata(tree.attributes(categoryID), values); err != nil {
+		return err
+	}
+	if err := s.parts.SetCategory(ctx, partUUID, categoryID, tree.legacy(categoryID), replace); err != nil {
+		return fmt.Errorf("part %s: %w", partUUID, err)
+	}
+	return nil
+}
+
+func (s *Categories) tree(ctx context.Context) (*categoryTree, error) {
+	categories, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newCategoryTree(categories), nil
+}
+
+// WithCategories lets PartsFilter.category_ids match the descendants of the
+// given categories too.
+func WithCategories(r repo.CategoryRepo) Option {
+	return func(s *Service) {
+		s.categories = r
+	}
+}
+
+// expandCategories returns a copy of filter with the category IDs extended
+// by all their descendants.
+func (s *Service) expandCategories(ctx context.Context, filter *inventorypb.PartsFilter) (*inventorypb.PartsFilter, error) {
+	if s.categories == nil || len(filter.GetCategoryIds()) == 0 {
+		return filter, nil
+	}
+	categories, err := s.categories.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree := newCategoryTree(categories)
+	expanded := proto.Clone(filter).(*inventorypb.PartsFilter)
+	expanded.CategoryIds = nil
+	for _, id := range filter.CategoryIds {
+		if _, ok := tree.byID[id]; !ok {
+			expanded.CategoryIds = append(expanded.CategoryIds, id)
+			continue
+		}
+		expanded.CategoryIds = append(expanded.CategoryIds, tree.descendants(id)...)
+	}
+	return expanded, nil
+}
+
+// categoryTree indexes the categories by ID and by parent; children keep
+// the order of the list, by name.
+type categoryTree struct {
+	byID     map[string]*model.PartCategory
+	children map[string][]*model.PartCategory
+}
+
+func newCategoryTree(categories []model.PartCategory) *categoryTree {
+	t := &categoryTree{
+		byID:     make(map[string]*model.PartCategory, len(categories)),
+		children: make(map[string][]*model.PartCategory),
+	}
+	for i := range categories {
+		c := &categories[i]
+		t.byID[c.ID] = c
+		t.children[c.ParentID] = append(t.children[c.ParentID], c)
+	}
+	return t
+}
+
+// descendants returns id and the IDs of all categories below it.
+func (t *categoryTree) descendants(id string) []string {
+	res := []string{id}
+	for i := 0; i < len(res); i++ {
+		for _, c := range t.children[res[i]] {
+			res = append(res, c.ID)
+		}
+	}
+	return res
+}
+
+// path returns the categories from the root down to id.
+func (t *categoryTree) path(id string) []*model.PartCategory {
+	var path []*model.PartCategory
+	seen := make(map[string]bool)
+	for c, ok := t.byID[id]; ok && !seen[c.ID]; c, ok = t.byID[c.ParentID] {
+		seen[c.ID] = true
+		path = append([]*model.PartCategory{c}, path...)
+	}
+	return path
+}
+
+// legacy is the legacy category of the nearest category on the path that
+// has one.
+func (t *categoryTree) legacy(id string) int32 {
+	path := t.path(id)
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i].LegacyCategory != 0 {
+			return path[i].LegacyCategory
+		}
+	}
+	return 0
+}
+
+// attributes merges the attributes along the path, a child attribute
+// replacing the ancestor one of the same name in place.
+func (t *categoryTree) attributes(id string) []model.AttributeSchema {
+	var res []model.AttributeSchema
+	index := make(map[string]int)
+	for _, c := range t.path(id) {
+		for _, a := range c.Attributes {
+			if i, ok := index[a.Name]; ok {
+				res[i] = a
+				continue
+			}
+			index[a.Name] = len(res)
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+func (t *categoryTree) node(c *model.PartCategory) *inventorypb.CategoryTreeNode {
+	node := &inventorypb.CategoryTreeNode{Category: converter.CategoryToProto(*c)}
+	for _, child := range t.children[c.ID] {
+		node.Children = append(node.Children, t.node(child))
+	}
+	return node
+}
+
+// checkParent makes sure the parent of c exists and is not c itself or one
+// of its descendants.
+func (t *categoryTree) checkParent(c *model.PartCategory) error {
+	if c.ParentID == "" {
+		return nil
+	}
+	if _, ok := t.byID[c.ParentID]; !ok {
+		return fmt.Errorf("%w: parent %s not found", ErrInvalidCategory, c.ParentID)
+	}
+	for _, id := range t.descendants(c.ID) {
+		if id == c.ParentID {
+			return fmt.Errorf("%w: category cannot be moved under itself", ErrInvalidCategory)
+		}
+	}
+	return nil
+}
+
+func categoryFromProto(c *inventorypb.PartCategory) (*model.PartCategory, error) {
+	if strings.TrimSpace(c.GetName()) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidCategory)
+	}
+	if _, ok := inventorypb.Category_name[int32(c.GetLegacyCategory())]; !ok {
+		return nil, fmt.Errorf("%w: unknown legacy category %d", ErrInvalidCategory, c.GetLegacyCategory())
+	}
+	res := &model.PartCategory{
+		Name:           c.Name,
+		ParentID:       c.ParentId,
+		LegacyCategory: int32(c.LegacyCategory),
+	}
+	seen := make(map[string]bool)
+	for _, a := range c.Attributes {
+		if a.Name == "" || seen[a.Name] {
+			return nil, fmt.Errorf("%w: attribute names must be set and unique", ErrInvalidCategory)
+		}
+		if _, ok := inventorypb.AttributeType_name[int32(a.Type)]; !ok {
+			return nil, fmt.Errorf("%w: attribute %s has unknown type %d", ErrInvalidCategory, a.Name, a.Type)
+		}
+		seen[a.Name] = true
+		attr := model.AttributeSchema{
+			Name:          a.Name,
+			Type:          int32(a.Type),
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+			Description:   a.Description,
+		}
+		typeOnly := attr
+		typeOnly.AllowedValues = nil
+		for _, v := range attr.AllowedValues {
+			if err := typeOnly.Check(v); err != nil {
+				return nil, fmt.Errorf("%w: attribute %s: %v", ErrInvalidCategory, a.Name, err)
+			}
+		}
+		res.Attributes = append(res.Attributes, attr)
+	}
+	return res, nil
+}
+
+// checkMetadata reports every missing required attribute and invalid value
+// at once. Keys without an attribute are allowed.
+func checkMetadata(attrs []model.AttributeSchema, metadata map[string]string) error {
+	var problems []string
+	for _, a := range attrs {
+		v, ok := metadata[a.Name]
+		if !ok {
+			if a.Required {
+				problems = append(problems, fmt.Sprintf("%s is required", a.Name))
+			}
+			continue
+		}
+		if err := a.Check(v); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", a.Name, err))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidMetadata, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CategoryServiceTest struct {
+	suite.Suite
+
+	repo    *mocks.CategoryRepo
+	parts   *mocks.PartRepo
+	service CategoryService
+}
+
+func (s *CategoryServiceTest) SetupTest() {
+	s.repo = mocks.NewCategoryRepo(s.T())
+	s.parts = mocks.NewPartRepo(s.T())
+	s.service = NewCategoryService(s.repo, s.parts)
+	s.service.(*Categories).now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+}
+
+// categoryFixture is engine > {rocket engine > {methalox}, ion engine}.
+func categoryFixture() []model.PartCategory {
+	return []model.PartCategory{
+		{ID: "engine", Name: "Engine", LegacyCategory: int32(inventorypb.Category_CATEGORY_ENGINE), Attributes: []model.AttributeSchema{
+			{Name: "thrust_kn", Type: model.AttributeDouble, Required: true},
+			{Name: "cycle", Type: model.AttributeString},
+		}},
+		{ID: "ion", Name: "Ion engine", ParentID: "engine"},
+		{ID: "methalox", Name: "Methalox", ParentID: "rocket"},
+		{ID: "rocket", Name: "Rocket engine", ParentID: "engine", Attributes: []model.AttributeSchema{
+			{Name: "cycle", Type: model.AttributeString, Required: true, AllowedValues: []string{"open", "staged"}},
+			{Name: "reusable", Type: model.AttributeBool},
+		}},
+	}
+}
+
+func (s *CategoryServiceTest) TestGet_EffectiveAttributes() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	c, attrs, err := s.service.Get(ctx, "methalox")
+
+	s.Require().NoError(err)
+	s.Equal("rocket", c.ParentId)
+	s.Require().Len(attrs, 3)
+	s.Equal("thrust_kn", attrs[0].Name)
+	s.Equal("cycle", attrs[1].Name)
+	s.True(attrs[1].Required)
+	s.Equal("reusable", attrs[2].Name)
+}
+
+func (s *CategoryServiceTest) TestTree() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	roots, err := s.service.Tree(ctx, "")
+
+	s.Require().NoError(err)
+	s.Require().Len(roots, 1)
+	s.Require().Len(roots[0].Children, 2)
+	s.Equal("ion", roots[0].Children[0].Category.Id)
+	s.Equal("methalox", roots[0].Children[1].Children[0].Category.Id)
+}
+
+func (s *CategoryServiceTest) TestCreate_UnknownParent() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	_, err := s.service.Create(ctx, &inventorypb.PartCategory{Name: "Avionics", ParentId: "ghost"})
+
+	s.ErrorIs(err, ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTest) TestCreate_InvalidAllowedValue() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	_, err := s.service.Create(ctx, &inventorypb.PartCategory{Name: "Avionics", Attributes: []*inventorypb.AttributeSchema{
+		{Name: "channels", Type: inventorypb.AttributeType_ATTRIBUTE_INT, AllowedValues: []string{"2", "four"}},
+	}})
+
+	s.ErrorIs(err, ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTest) TestUpdate_RejectsCycle() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	_, err := s.service.Update(ctx, &inventorypb.PartCategory{Id: "engine", Name: "Engine", ParentId: "methalox"})
+
+	s.ErrorIs(err, ErrInvalidCategory)
+}
+
+func (s *CategoryServiceTest) TestUpdate_PropagatesLegacyCategory() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+	s.repo.On("Update", ctx, mock.Anything).Return(nil)
+	s.parts.On("SetLegacyCategory", ctx, []string{"rocket", "methalox"}, int32(inventorypb.Category_CATEGORY_FUEL)).Return(nil)
+
+	_, err := s.service.Update(ctx, &inventorypb.PartCategory{
+		Id:             "rocket",
+		Name:           "Rocket engine",
+		ParentId:       "engine",
+		LegacyCategory: inventorypb.Category_CATEGORY_FUEL,
+	})
+
+	s.NoError(err)
+}
+
+func (s *CategoryServiceTest) TestDelete_WithChildren() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	err := s.service.Delete(ctx, "rocket")
+
+	s.ErrorIs(err, model.ErrCategoryInUse)
+}
+
+func (s *CategoryServiceTest) TestSetPartCategory() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+	s.parts.On("SetCategory", ctx, "engine-1", "methalox", int32(inventorypb.Category_CATEGORY_ENGINE),
+		map[string]string{"thrust_kn": "2200", "cycle": "staged", "reusable": "true"}).Return(nil)
+
+	err := s.service.SetPartCategory(ctx, "engine-1", "methalox", map[string]*inventorypb.Value{
+		"thrust_kn": {Kind: &inventorypb.Value_Int64Value{Int64Value: 2200}},
+		"cycle":     {Kind: &inventorypb.Value_StringValue{StringValue: "staged"}},
+		"reusable":  {Kind: &inventorypb.Value_BoolValue{BoolValue: true}},
+	})
+
+	s.NoError(err)
+}
+
+func (s *CategoryServiceTest) TestSetPartCategory_InvalidMetadata() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+	s.parts.On("Get", ctx, "engine-1").Return(&inventorypb.Part{
+		Uuid: "engine-1",
+		Metadata: map[string]*inventorypb.Value{
+			"cycle": {Kind: &inventorypb.Value_StringValue{StringValue: "closed"}},
+		},
+	}, nil)
+
+	err := s.service.SetPartCategory(ctx, "engine-1", "rocket", nil)
+
+	s.ErrorIs(err, ErrInvalidMetadata)
+	s.ErrorContains(err, "thrust_kn is required")
+	s.ErrorContains(err, `cycle: "closed" is not one of`)
+}
+
+func (s *CategoryServiceTest) TestSetPartCategory_UnknownCategory() {
+	ctx := context.Background()
+	s.repo.On("List", ctx).Return(categoryFixture(), nil)
+
+	err := s.service.SetPartCategory(ctx, "engine-1", "ghost", nil)
+
+	s.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func TestCategoryServiceTest(t *testing.T) {
+	suite.Run(t, new(CategoryServiceTest))
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+)
+
+// ValidateConfiguration checks the compatibility rules of every part in items
+// against the rest of the configuration and returns all violations found.
+// Kits are replaced with their components first, so the rules of the parts
+// that actually get assembled are applied.
+func (s *Service) ValidateConfiguration(ctx context.Context, items []*inventorypb.StockItem) ([]*inventorypb.ConfigurationViolation, error) {
+	if err := validateStockItems(items); err != nil {
+		return nil, err
+	}
+	items, err := s.expandKits(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	var uuids []string
+	quantities := make(map[string]int64)
+	for _, item := range items {
+		if _, ok := quantities[item.Uuid]; !ok {
+			uuids = append(uuids, item.Uuid)
+		}
+		quantities[item.Uuid] += item.Quantity
+	}
+	parts, err := s.repo.List(ctx, &inventorypb.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+	byUUID := make(map[string]*inventorypb.Part, len(parts))
+	for _, p := range parts {
+		byUUID[p.Uuid] = p
+	}
+
+	var violations []*inventorypb.ConfigurationViolation
+	var config []*inventorypb.Part
+	categories := make(map[inventorypb.Category]int64)
+	for _, uuid := range uuids {
+		part, ok := byUUID[uuid]
+		if !ok {
+			violations = append(violations, &inventorypb.ConfigurationViolation{
+				PartUuid: uuid,
+				Message:  fmt.Sprintf("part %s not found", uuid),
+			})
+			continue
+		}
+		config = append(config, part)
+		categories[part.Category] += quantities[uuid]
+	}
+
+	targets, err := s.targetCategories(ctx, config, byUUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, part := range config {
+		violations = append(violations, checkRules(part, config, quantities, categories, targets)...)
+	}
+	return violations, nil
+}
+
+// targetCategories returns the categories of the COMPATIBLE_WITH targets given
+// by part UUID, looking up the ones that are not part of the configuration.
+func (s *Service) targetCategories(ctx context.Context, config []*inventorypb.Part, known map[string]*inventorypb.Part) (map[string]inventorypb.Category, error) {
+	res := make(map[string]inventorypb.Category)
+	var missing []string
+	for _, part := range config {
+		for _, r := range part.Compatibility {
+			if r.Type != inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH || r.TargetPartUuid == "" {
+				continue
+			}
+			if p, ok := known[r.TargetPartUuid]; ok {
+				res[p.Uuid] = p.Category
+			} else {
+				missing = append(missing, r.TargetPartUuid)
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return res, nil
+	}
+	parts, err := s.repo.List(ctx, &inventorypb.PartsFilter{Uuids: missing})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range parts {
+		res[p.Uuid] = p.Category
+	}
+	return res, nil
+}
+
+func checkRules(part *inventorypb.Part, config []*inventorypb.Part, quantities map[string]int64, categories map[inventorypb.Category]int64, targets map[string]inventorypb.Category) []*inventorypb.ConfigurationViolation {
+	var violations []*inventorypb.ConfigurationViolation
+	violation := func(r *inventorypb.CompatibilityRule, target string, format string, args ...any) {
+		v := &inventorypb.ConfigurationViolation{
+			PartUuid:       part.Uuid,
+			Rule:           r.Type,
+			TargetPartUuid: target,
+			Message:        fmt.Sprintf(format, args...),
+		}
+		if target == "" {
+			v.TargetCategory = r.TargetCategory
+		}
+		violations = append(violations, v)
+	}
+	present := func(r *inventorypb.CompatibilityRule) int64 {
+		if r.TargetPartUuid != "" {
+			return quantities[r.TargetPartUuid]
+		}
+		return categories[r.TargetCategory]
+	}
+
+	compatible := make(map[inventorypb.Category][]*inventorypb.CompatibilityRule)
+	for _, r := range part.Compatibility {
+		switch r.Type {
+		case inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_REQUIRES:
+			need := max(r.Quantity, 1) * quantities[part.Uuid]
+			if have := present(r); have < need {
+				violation(r, r.TargetPartUuid, "part %s requires %d of %s, got %d", part.Uuid, need, ruleTarget(r), have)
+			}
+		case inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_EXCLUDES:
+			if present(r) > 0 {
+				violation(r, r.TargetPartUuid, "part %s cannot be combined with %s", part.Uuid, ruleTarget(r))
+			}
+		case inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH:
+			category := r.TargetCategory
+			if r.TargetPartUuid != "" {
+				category = targets[r.TargetPartUuid]
+			}
+			compatible[category] = append(compatible[category], r)
+		}
+	}
+
+	for _, other := range config {
+		rules, ok := compatible[other.Category]
+		if !ok || other.Uuid == part.Uuid || matchesAny(rules, other) {
+			continue
+		}
+		violation(rules[0], other.Uuid, "part %s is not compatible with %s", part.Uuid, other.Uuid)
+	}
+	return violations
+}
+
+func matchesAny(rules []*inventorypb.CompatibilityRule, part *inventorypb.Part) bool {
+	for _, r := range rules {
+		if r.TargetPartUuid == part.Uuid || (r.TargetPartUuid == "" && r.TargetCategory == part.Category) {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleTarget(r *inventorypb.CompatibilityRule) string {
+	if r.TargetPartUuid != "" {
+		return r.TargetPartUuid
+	}
+	return "category " + r.TargetCategory.String()
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
+	"math"
+	"time"
+)
+
+// resolveKits fills in the stock and, for derived pricing, the price of the
+// kit parts from their components: the current stock and the prices as of
+// asOf. A kit with a missing component is reported as out of stock.
+func (s *Service) resolveKits(ctx context.Context, parts []*inventorypb.Part, asOf time.Time) error {
+	var uuids []string
+	for _, p := range parts {
+		for _, c := range p.GetKit().GetComponents() {
+			uuids = append(uuids, c.PartUuid)
+		}
+	}
+	if len(uuids) == 0 {
+		return nil
+	}
+	components, err := s.repo.List(ctx, &inventorypb.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return err
+	}
+	if err := s.applyPrices(ctx, components, asOf); err != nil {
+		return err
+	}
+	byUUID := make(map[string]*inventorypb.Part, len(components))
+	for _, c := range components {
+		byUUID[c.Uuid] = c
+	}
+	for _, p := range parts {
+		if p.Kit != nil {
+			applyKit(p, byUUID)
+		}
+	}
+	return nil
+}
+
+func applyKit(kit *inventorypb.Part, components map[string]*inventorypb.Part) {
+	available := int64(math.MaxInt64)
+	var price float64
+	for _, c := range kit.Kit.Components {
+		part, ok := components[c.PartUuid]
+		if !ok || part.Kit != nil || c.Quantity <= 0 {
+			available = 0
+			continue
+		}
+		available = min(available, part.StockQuantity/c.Quantity)
+		price += part.Price * float64(c.Quantity)
+	}
+	if len(kit.Kit.Components) == 0 {
+		available = 0
+	}
+	kit.StockQuantity = available
+	if kit.Kit.Pricing == inventorypb.KitPricing_KIT_PRICING_DERIVED {
+		kit.Price = math.Round(price*(100-kit.Kit.DiscountPercent)) / 100
+	}
+}
+
+// expandKits replaces kit lines with their components, multiplied by the
+// number of kits, and merges the quantities of parts requested more than once
+// so that a kit and a separately ordered component draw on the same stock.
+func (s *Service) expandKits(ctx context.Context, items []*inventorypb.StockItem) ([]*inventorypb.StockItem, error) {
+	uuids := make([]string, len(items))
+	for i, item := range items {
+		uuids[i] = item.Uuid
+	}
+	parts, err := s.repo.List(ctx, &inventorypb.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+	kits := make(map[string]*inventorypb.Kit)
+	for _, p := range parts {
+		if p.Kit != nil {
+			kits[p.Uuid] = p.Kit
+		}
+	}
+	if len(kits) == 0 {
+		return items, nil
+	}
+
+	var expanded []*inventorypb.StockItem
+	index := make(map[string]int)
+	add := func(uuid string, quantity int64) {
+		if i, ok := index[uuid]; ok {
+			expanded[i].Quantity += quantity
+			return
+		}
+		index[uuid] = len(expanded)
+		expanded = append(expanded, &inventorypb.StockItem{Uuid: uuid, Quantity: quantity})
+	}
+	for _, item := range items {
+		kit, ok := kits[item.Uuid]
+		if !ok {
+			add(item.Uuid, item.Quantity)
+			continue
+		}
+		if len(kit.Components) == 0 {
+			return nil, fmt.Errorf("kit %s has no components: %w", item.Uuid, model.ErrInvalidKit)
+		}
+		for _, c := range kit.Components {
+			if c.Quantity <= 0 {
+				return nil, fmt.Errorf("kit %s, component %s: %w", item.Uuid, c.PartUuid, model.ErrInvalidKit)
+			}
+			add(c.PartUuid, c.Quantity*item.Quantity)
+		}
+	}
+	return expanded, nil
+}
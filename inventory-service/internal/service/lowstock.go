@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/converter"
+)
+
+var ErrInvalidReorderLevels = errors.New("reorder threshold must not be negative and target level must be above it")
+
+// SetReorderLevels sets the low-stock threshold and the level to reorder up
+// to. A zero threshold turns the low-stock alert off.
+func (s *Service) SetReorderLevels(ctx context.Context, uuid string, threshold, target int64) error {
+	if threshold < 0 || (threshold > 0 && target <= threshold) {
+		return ErrInvalidReorderLevels
+	}
+	if err := s.repo.SetReorderLevels(ctx, uuid, threshold, target); err != nil {
+		return fmt.Errorf("part %s: %w", uuid, err)
+	}
+	return nil
+}
+
+func (s *Service) ListLowStock(ctx context.Context) ([]*inventorypb.LowStockItem, error) {
+	parts, err := s.repo.ListLowStock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*inventorypb.LowStockItem, len(parts))
+	for i, p := range parts {
+		items[i] = converter.LowStockToProto(p)
+	}
+	return items, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/converter"
+	"inventory-service/internal/model"
+	repo "inventory-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidManufacturer = errors.New("invalid manufacturer")
+
+// ManufacturerService manages the manufacturer registry and the links of
+// parts to it.
+type ManufacturerService interface {
+	Create(ctx context.Context, m *inventorypb.Manufacturer) (*inventorypb.Manufacturer, error)
+	Get(ctx context.Context, id string) (*inventorypb.Manufacturer, error)
+	Update(ctx context.Context, m *inventorypb.Manufacturer) (*inventorypb.Manufacturer, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, countries []string, statuses []inventorypb.CertificationStatus) ([]*inventorypb.Manufacturer, error)
+	SetPartManufacturer(ctx context.Context, partUUID, id string) error
+}
+
+type Manufacturers struct {
+	repo  repo.ManufacturerRepo
+	parts repo.PartRepo
+	now   func() time.Time
+}
+
+func NewManufacturerService(r repo.ManufacturerRepo, parts repo.PartRepo) ManufacturerService {
+	return &Manufacturers{repo: r, parts: parts, now: time.Now}
+}
+
+func (s *Manufacturers) Create(ctx context.Context, m *inventorypb.Manufacturer) (*inventorypb.Manufacturer, error) {
+	manufacturer, err := manufacturerFromProto(m)
+	if err != nil {
+		return nil, err
+	}
+	manufacturer.ID = uuid.New().String()
+	manufacturer.CreatedAt = s.now()
+	manufacturer.UpdatedAt = manufacturer.CreatedAt
+	if err := s.repo.Create(ctx, manufacturer); err != nil {
+		return nil, err
+	}
+	return converter.ManufacturerToProto(*manufacturer), nil
+}
+
+func (s *Manufacturers) Get(ctx context.Context, id string) (*inventorypb.Manufacturer, error) {
+	m, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return converter.ManufacturerToProto(*m), nil
+}
+
+// Update replaces the manufacturer and refreshes the copy of it kept in the
+// linked parts.
+func (s *Manufacturers) Update(ctx context.Context, m *inventorypb.Manufacturer) (*inventorypb.Manufacturer, error) {
+	current, err := s.repo.Get(ctx, m.GetId())
+	if err != nil {
+		return nil, err
+	}
+	manufacturer, err := manufacturerFromProto(m)
+	if err != nil {
+		return nil, err
+	}
+	manufacturer.ID = current.ID
+	manufacturer.CreatedAt = current.CreatedAt
+	manufacturer.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, manufacturer); err != nil {
+		return nil, err
+	}
+	if err := s.parts.UpdateManufacturerView(ctx, *manufacturer); err != nil {
+		return nil, fmt.Errorf("update parts of manufacturer %s: %w", manufacturer.ID, err)
+	}
+	return converter.ManufacturerToProto(*manufacturer), nil
+}
+
+// Delete removes a manufacturer no part is linked to.
+func (s *Manufacturers) Delete(ctx context.Context, id string) error {
+	parts, err := s.parts.List(ctx, &inventorypb.PartsFilter{ManufacturerIds: []string{id}})
+	if err != nil {
+		return err
+	}
+	if len(parts) > 0 {
+		return fmt.Errorf("%w: %d parts", model.ErrManufacturerInUse, len(parts))
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *Manufacturers) List(ctx context.Context, countries []string, statuses []inventorypb.CertificationStatus) ([]*inventorypb.Manufacturer, error) {
+	filter := repo.ManufacturerFilter{Countries: countries}
+	for _, st := range statuses {
+		filter.CertificationStatuses = append(filter.CertificationStatuses, int32(st))
+	}
+	manufacturers, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*inventorypb.Manufacturer, len(manufacturers))
+	for i, m := range manufacturers {
+		res[i] = converter.ManufacturerToProto(m)
+	}
+	return res, nil
+}
+
+func (s *Manufacturers) SetPartManufacturer(ctx context.Context, partUUID, id string) error {
+	m, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("manufacturer %s: %w", id, err)
+	}
+	if err := s.parts.SetManufacturer(ctx, partUUID, *m); err != nil {
+		return fmt.Errorf("part %s: %w", partUUID, err)
+	}
+	return nil
+}
+
+func manufacturerFromProto(m *inventorypb.Manufacturer) (*model.Manufacturer, error) {
+	normalized := model.NormalizeManufacturerName(m.GetName())
+	if normalized == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidManufacturer)
+	}
+	if _, ok := inventorypb.CertificationStatus_name[int32(m.GetCertificationStatus())]; !ok {
+		return nil, fmt.Errorf("%w: unknown certification status %d", ErrInvalidManufacturer, m.GetCertificationStatus())
+	}
+	if m.GetCertificationStatus() == inventorypb.CertificationStatus_CERTIFICATION_CERTIFIED && m.GetCertificateNumber() == "" {
+		return nil, fmt.Errorf("%w: certificate number is required for a certified manufacturer", ErrInvalidManufacturer)
+	}
+	res := &model.Manufacturer{
+		Name:           m.Name,
+		NormalizedName: normalized,
+		Country:        m.Country,
+		Website:        m.Website,
+		Contact: model.ManufacturerContact{
+			Name:  m.GetContact().GetName(),
+			Email: m.GetContact().GetEmail(),
+			Phone: m.GetContact().GetPhone(),
+		},
+		CertificationStatus: int32(m.CertificationStatus),
+		CertificateNumber:   m.CertificateNumber,
+	}
+	if m.CertifiedUntil != nil {
+		until := m.CertifiedUntil.AsTime()
+		res.CertifiedUntil = &until
+	}
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ManufacturerServiceTest struct {
+	suite.Suite
+
+	repo    *mocks.ManufacturerRepo
+	parts   *mocks.PartRepo
+	service ManufacturerService
+	now     time.Time
+}
+
+func (s *ManufacturerServiceTest) SetupTest() {
+	s.repo = mocks.NewManufacturerRepo(s.T())
+	s.parts = mocks.NewPartRepo(s.T())
+	s.service = NewManufacturerService(s.repo, s.parts)
+	s.now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	s.service.(*Manufacturers).now = func() time.Time { return s.now }
+}
+
+func (s *ManufacturerServiceTest) TestCreate() {
+	ctx := context.Background()
+	s.repo.On("Create", ctx, mock.MatchedBy(func(m *model.Manufacturer) bool {
+		return m.ID != "" && m.NormalizedName == "spacey" && m.Contact.Email == "sales@spacey.example"
+	})).Return(nil)
+
+	m, err := s.service.Create(ctx, &inventorypb.Manufacturer{
+		Name:    "Space Y",
+		Country: "USA",
+		Contact: &inventorypb.ManufacturerContact{Email: "sales@spacey.example"},
+	})
+
+	s.Require().NoError(err)
+	s.Equal("Space Y", m.Name)
+	s.True(m.CreatedAt.AsTime().Equal(s.now))
+}
+
+func (s *ManufacturerServiceTest) TestCreate_Invalid() {
+	ctx := context.Background()
+
+	_, err := s.service.Create(ctx, &inventorypb.Manufacturer{Name: " - "})
+	s.ErrorIs(err, ErrInvalidManufacturer)
+
+	_, err = s.service.Create(ctx, &inventorypb.Manufacturer{
+		Name:                "SpaceY",
+		CertificationStatus: inventorypb.CertificationStatus_CERTIFICATION_CERTIFIED,
+	})
+	s.ErrorIs(err, ErrInvalidManufacturer)
+}
+
+func (s *ManufacturerServiceTest) TestUpdate_RefreshesParts() {
+	ctx := context.Background()
+	created := s.now.Add(-time.Hour)
+	s.repo.On("Get", ctx, "m-1").Return(&model.Manufacturer{ID: "m-1", Name: "SpaceY", CreatedAt: created}, nil)
+	s.repo.On("Update", ctx, mock.Anything).Return(nil)
+	s.parts.On("UpdateManufacturerView", ctx, mock.MatchedBy(func(m model.Manufacturer) bool {
+		return m.ID == "m-1" && m.Name == "SpaceY Inc" && m.CreatedAt.Equal(created)
+	})).Return(nil)
+
+	m, err := s.service.Update(ctx, &inventorypb.Manufacturer{Id: "m-1", Name: "SpaceY Inc"})
+
+	s.Require().NoError(err)
+	s.Equal("SpaceY Inc", m.Name)
+}
+
+func (s *ManufacturerServiceTest) TestDelete_InUse() {
+	ctx := context.Background()
+	s.parts.On("List", ctx, &inventorypb.PartsFilter{ManufacturerIds: []string{"m-1"}}).
+		Return([]*inventorypb.Part{{Uuid: "engine-1"}}, nil)
+
+	err := s.service.Delete(ctx, "m-1")
+
+	s.ErrorIs(err, model.ErrManufacturerInUse)
+	s.repo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *ManufacturerServiceTest) TestSetPartManufacturer() {
+	ctx := context.Background()
+	m := &model.Manufacturer{ID: "m-1", Name: "SpaceY"}
+	s.repo.On("Get", ctx, "m-1").Return(m, nil)
+	s.parts.On("SetManufacturer", ctx, "engine-1", *m).Return(nil)
+
+	s.NoError(s.service.SetPartManufacturer(ctx, "engine-1", "m-1"))
+}
+
+func TestManufacturerServiceTest(t *testing.T) {
+	suite.Run(t, new(ManufacturerServiceTest))
+}
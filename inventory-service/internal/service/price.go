@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/converter"
+	"inventory-service/internal/model"
+	"time"
+)
+
+var (
+	ErrInvalidPrice      = errors.New("price must be greater than 0")
+	ErrPriceChangeInPast = errors.New("price change cannot take effect in the past")
+)
+
+// SchedulePriceChange records a new price of the part effective from
+// effectiveFrom, or from now when it is zero. Changes are never applied to the
+// past, so the history stays a faithful record of what was charged.
+func (s *Service) SchedulePriceChange(ctx context.Context, uuid string, price float64, effectiveFrom time.Time) (*inventorypb.PriceChange, error) {
+	if price <= 0 {
+		return nil, ErrInvalidPrice
+	}
+	now := s.now()
+	if effectiveFrom.IsZero() {
+		effectiveFrom = now
+	}
+	if effectiveFrom.Before(now.Add(-time.Minute)) {
+		return nil, ErrPriceChangeInPast
+	}
+	change := model.PriceChange{
+		Price:         price,
+		EffectiveFrom: effectiveFrom.UTC(),
+		CreatedAt:     now.UTC(),
+	}
+	if err := s.repo.AddPriceChange(ctx, uuid, change); err != nil {
+		return nil, fmt.Errorf("part %s: %w", uuid, err)
+	}
+	return converter.PriceChangeToProto(change), nil
+}
+
+// GetPriceHistory returns every price of the part ordered by the moment it
+// takes effect, starting with the price the part was created with.
+func (s *Service) GetPriceHistory(ctx context.Context, uuid string) ([]*inventorypb.PriceChange, error) {
+	part, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.repo.PriceHistory(ctx, []string{uuid})
+	if err != nil {
+		return nil, err
+	}
+	initial := &inventorypb.PriceChange{
+		Price:         part.Price,
+		EffectiveFrom: part.CreatedAt,
+		CreatedAt:     part.CreatedAt,
+	}
+	return append([]*inventorypb.PriceChange{initial}, history[uuid]...), nil
+}
+
+// applyPrices replaces the stored price of parts with the one effective at
+// asOf. Parts whose history starts after asOf keep the price they were
+// created with.
+func (s *Service) applyPrices(ctx context.Context, parts []*inventorypb.Part, asOf time.Time) error {
+	if len(parts) == 0 {
+		return nil
+	}
+	uuids := make([]string, len(parts))
+	for i, p := range parts {
+		uuids[i] = p.Uuid
+	}
+	history, err := s.repo.PriceHistory(ctx, uuids)
+	if err != nil {
+		return err
+	}
+	for _, p := range parts {
+		for _, c := range history[p.Uuid] {
+			if c.EffectiveFrom.AsTime().After(asOf) {
+				break
+			}
+			p.Price = c.Price
+		}
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/converter"
+	"inventory-service/internal/model"
+	repo "inventory-service/repository"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrInvalidPurchaseOrder = errors.New("invalid purchase order")
+
+// PurchaseOrderService manages restocking orders placed with manufacturers.
+type PurchaseOrderService interface {
+	Create(ctx context.Context, req *inventorypb.CreatePurchaseOrderRequest) (*inventorypb.PurchaseOrder, error)
+	Get(ctx context.Context, uuid string) (*inventorypb.PurchaseOrder, error)
+	Receive(ctx context.Context, uuid string, items []*inventorypb.StockItem, receivedBy string) (*inventorypb.PurchaseOrder, error)
+	CancelLines(ctx context.Context, uuid string, partUUIDs []string) (*inventorypb.PurchaseOrder, error)
+	ListOpen(ctx context.Context, partUUIDs []string) ([]*inventorypb.PartOpenPurchases, error)
+}
+
+type PurchaseOrders struct {
+	repo  repo.PurchaseOrderRepo
+	parts repo.PartRepo
+	now   func() time.Time
+}
+
+func NewPurchaseOrderService(r repo.PurchaseOrderRepo, parts repo.PartRepo) PurchaseOrderService {
+	return &PurchaseOrders{repo: r, parts: parts, now: time.Now}
+}
+
+// Create opens a purchase order. Every line must be for an existing part
+// that is not a kit, and a part can appear on one line only.
+func (s *PurchaseOrders) Create(ctx context.Context, req *inventorypb.CreatePurchaseOrderRequest) (*inventorypb.PurchaseOrder, error) {
+	if req.GetManufacturer().GetName() == "" {
+		return nil, fmt.Errorf("%w: manufacturer name is required", ErrInvalidPurchaseOrder)
+	}
+	if len(req.GetLines()) == 0 {
+		return nil, fmt.Errorf("%w: lines are required", ErrInvalidPurchaseOrder)
+	}
+
+	now := s.now()
+	po := &model.PurchaseOrder{
+		UUID: uuid.New().String(),
+		Manufacturer: model.Manufacter{
+			Name:    req.Manufacturer.Name,
+			Country: req.Manufacturer.Country,
+			Website: req.Manufacturer.Website,
+		},
+		Status:    model.PurchaseOrderOpen,
+		CreatedBy: req.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	var uuids []string
+	seen := make(map[string]bool)
+	for _, l := range req.Lines {
+		switch {
+		case l.PartUuid == "":
+			return nil, fmt.Errorf("%w: part_uuid is required", ErrInvalidPurchaseOrder)
+		case seen[l.PartUuid]:
+			return nil, fmt.Errorf("%w: part %s is on more than one line", ErrInvalidPurchaseOrder, l.PartUuid)
+		case l.Quantity <= 0:
+			return nil, fmt.Errorf("part %s: %w", l.PartUuid, ErrInvalidQuantity)
+		case l.UnitCost < 0:
+			return nil, fmt.Errorf("%w: unit cost of part %s is negative", ErrInvalidPurchaseOrder, l.PartUuid)
+		}
+		seen[l.PartUuid] = true
+		uuids = append(uuids, l.PartUuid)
+		line := model.PurchaseOrderLine{
+			PartUUID: l.PartUuid,
+			Quantity: l.Quantity,
+			UnitCost: l.UnitCost,
+		}
+		if l.ExpectedAt != nil {
+			expected := l.ExpectedAt.AsTime()
+			line.ExpectedAt = &expected
+		}
+		po.Lines = append(po.Lines, line)
+	}
+
+	parts, err := s.parts.List(ctx, &inventorypb.PartsFilter{Uuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool, len(parts))
+	for _, p := range parts {
+		if p.Kit != nil {
+			return nil, fmt.Errorf("%w: part %s is a kit, order its components instead", ErrInvalidPurchaseOrder, p.Uuid)
+		}
+		found[p.Uuid] = true
+	}
+	for _, uuid := range uuids {
+		if !found[uuid] {
+			return nil, fmt.Errorf("%w: part %s not found", ErrInvalidPurchaseOrder, uuid)
+		}
+	}
+
+	if err := s.repo.Create(ctx, po); err != nil {
+		return nil, err
+	}
+	return converter.PurchaseOrderToProto(*po), nil
+}
+
+func (s *PurchaseOrders) Get(ctx context.Context, uuid string) (*inventorypb.PurchaseOrder, error) {
+	po, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return converter.PurchaseOrderToProto(*po), nil
+}
+
+// Receive books delivered goods against the open quantity of the lines and
+// adds them to stock. The order is saved first, so concurrent receipts
+// cannot receive more than was ordered; if the stock cannot be updated, the
+// stock added so far is taken back and the order is restored.
+func (s *PurchaseOrders) Receive(ctx context.Context, uuid string, items []*inventorypb.StockItem, receivedBy string) (*inventorypb.PurchaseOrder, error) {
+	if err := validateStockItems(items); err != nil {
+		return nil, err
+	}
+	po, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if po.IsClosed() {
+		return nil, fmt.Errorf("purchase order %s: %w", uuid, model.ErrPurchaseOrderClosed)
+	}
+	prev := clonePurchaseOrder(po)
+
+	now := s.now()
+	var received []*inventorypb.StockItem
+	for _, item := range items {
+		line := findLine(po, item.Uuid)
+		if line == nil {
+			return nil, fmt.Errorf("%w: part %s is not on the order", ErrInvalidPurchaseOrder, item.Uuid)
+		}
+		if open := line.OpenQuantity(); item.Quantity > open {
+			return nil, fmt.Errorf("%w: received %d of part %s, only %d open", ErrInvalidPurchaseOrder, item.Quantity, item.Uuid, open)
+		}
+		line.ReceivedQuantity += item.Quantity
+		line.Receipts = append(line.Receipts, model.GoodsReceipt{
+			Quantity:   item.Quantity,
+			ReceivedBy: receivedBy,
+			ReceivedAt: now,
+		})
+		received = append(received, item)
+	}
+	po.UpdateStatus()
+	po.UpdatedAt = now
+	if err := s.repo.Update(ctx, po); err != nil {
+		return nil, err
+	}
+
+	for i, item := range received {
+		err := s.parts.IncrementStock(ctx, item.Uuid, item.Quantity)
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("part %s: %w", item.Uuid, err)
+		for _, done := range received[:i] {
+			if rerr := s.parts.DecrementStock(ctx, done.Uuid, done.Quantity); rerr != nil {
+				return nil, fmt.Errorf("%w (rollback of %s failed: %v)", err, done.Uuid, rerr)
+			}
+		}
+		prev.Version = po.Version
+		if rerr := s.repo.Update(ctx, prev); rerr != nil {
+			return nil, fmt.Errorf("%w (restoring purchase order failed: %v)", err, rerr)
+		}
+		return nil, err
+	}
+	return converter.PurchaseOrderToProto(*po), nil
+}
+
+// CancelLines cancels the open remainder of the given lines, or of all
+// lines. What was already received stays received.
+func (s *PurchaseOrders) CancelLines(ctx context.Context, uuid string, partUUIDs []string) (*inventorypb.PurchaseOrder, error) {
+	po, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if po.IsClosed() {
+		return nil, fmt.Errorf("purchase order %s: %w", uuid, model.ErrPurchaseOrderClosed)
+	}
+
+	if len(partUUIDs) == 0 {
+		for i := range po.Lines {
+			po.Lines[i].CancelledQuantity += po.Lines[i].OpenQuantity()
+		}
+	}
+	for _, partUUID := range partUUIDs {
+		line := findLine(po, partUUID)
+		if line == nil {
+			return nil, fmt.Errorf("%w: part %s is not on the order", ErrInvalidPurchaseOrder, partUUID)
+		}
+		line.CancelledQuantity += line.OpenQuantity()
+	}
+	po.UpdateStatus()
+	po.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, po); err != nil {
+		return nil, err
+	}
+	return converter.PurchaseOrderToProto(*po), nil
+}
+
+// ListOpen reports the quantities still expected per part, ordered by part
+// UUID, with the open lines oldest order first.
+func (s *PurchaseOrders) ListOpen(ctx context.Context, partUUIDs []string) ([]*inventorypb.PartOpenPurchases, error) {
+	orders, err := s.repo.ListOpen(ctx, partUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(partUUIDs))
+	for _, uuid := range partUUIDs {
+		wanted[uuid] = true
+	}
+
+	byPart := make(map[string]*inventorypb.PartOpenPurchases)
+	for _, po := range orders {
+		for _, l := range po.Lines {
+			open := l.OpenQuantity()
+			if open <= 0 || (len(wanted) > 0 && !wanted[l.PartUUID]) {
+				continue
+			}
+			part, ok := byPart[l.PartUUID]
+			if !ok {
+				part = &inventorypb.PartOpenPurchases{PartUuid: l.PartUUID}
+				byPart[l.PartUUID] = part
+			}
+			line := &inventorypb.OpenPurchaseLine{
+				PurchaseOrderUuid: po.UUID,
+				Manufacturer:      converter.ManufacterToProto(po.Manufacturer),
+				OpenQuantity:      open,
+			}
+			if l.ExpectedAt != nil {
+				line.ExpectedAt = timestamppb.New(*l.ExpectedAt)
+			}
+			part.OpenQuantity += open
+			part.Lines = append(part.Lines, line)
+		}
+	}
+
+	res := make([]*inventorypb.PartOpenPurchases, 0, len(byPart))
+	for _, part := range byPart {
+		res = append(res, part)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].PartUuid < res[j].PartUuid })
+	return res, nil
+}
+
+func findLine(po *model.PurchaseOrder, partUUID string) *model.PurchaseOrderLine {
+	for i := range po.Lines {
+		if po.Lines[i].PartUUID == partUUID {
+			return &po.Lines[i]
+		}
+	}
+	return nil
+}
+
+func clonePurchaseOrder(po *model.PurchaseOrder) *model.PurchaseOrder {
+	c := *po
+	c.Lines = make([]model.PurchaseOrderLine, len(po.Lines))
+	for i, l := range po.Lines {
+		l.Receipts = append([]model.GoodsReceipt(nil), l.Receipts...)
+		c.Lines[i] = l
+	}
+	return &c
+}
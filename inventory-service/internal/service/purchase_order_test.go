@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PurchaseOrderServiceTest struct {
+	suite.Suite
+
+	repo    *mocks.PurchaseOrderRepo
+	parts   *mocks.PartRepo
+	service PurchaseOrderService
+	now     time.Time
+}
+
+func (s *PurchaseOrderServiceTest) SetupTest() {
+	s.repo = mocks.NewPurchaseOrderRepo(s.T())
+	s.parts = mocks.NewPartRepo(s.T())
+	s.service = NewPurchaseOrderService(s.repo, s.parts)
+	s.now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	s.service.(*PurchaseOrders).now = func() time.Time { return s.now }
+}
+
+func openPurchaseOrder() *model.PurchaseOrder {
+	return &model.PurchaseOrder{
+		UUID:         "po-1",
+		Manufacturer: model.Manufacter{Name: "SpaceY"},
+		Status:       model.PurchaseOrderOpen,
+		Lines: []model.PurchaseOrderLine{
+			{PartUUID: "engine-1", Quantity: 5},
+			{PartUUID: "wing-1", Quantity: 4},
+		},
+		Version: 3,
+	}
+}
+
+func (s *PurchaseOrderServiceTest) TestCreate() {
+	ctx := context.Background()
+	expected := s.now.Add(72 * time.Hour)
+	s.parts.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"engine-1"}}).
+		Return([]*inventorypb.Part{{Uuid: "engine-1"}}, nil)
+	s.repo.On("Create", ctx, mock.MatchedBy(func(po *model.PurchaseOrder) bool {
+		return po.UUID != "" && po.Status == model.PurchaseOrderOpen &&
+			po.Lines[0].Quantity == 5 && po.Lines[0].ExpectedAt.Equal(expected)
+	})).Return(nil)
+
+	po, err := s.service.Create(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Manufacturer: &inventorypb.Manufacter{Name: "SpaceY"},
+		Lines: []*inventorypb.PurchaseOrderLine{
+			{PartUuid: "engine-1", Quantity: 5, UnitCost: 900000, ExpectedAt: timestamppb.New(expected)},
+		},
+		CreatedBy: "buyer",
+	})
+
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_OPEN, po.Status)
+	s.Equal("buyer", po.CreatedBy)
+}
+
+func (s *PurchaseOrderServiceTest) TestCreate_Invalid() {
+	ctx := context.Background()
+	manufacturer := &inventorypb.Manufacter{Name: "SpaceY"}
+
+	_, err := s.service.Create(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Lines: []*inventorypb.PurchaseOrderLine{{PartUuid: "engine-1", Quantity: 1}},
+	})
+	s.ErrorIs(err, ErrInvalidPurchaseOrder)
+
+	_, err = s.service.Create(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Manufacturer: manufacturer,
+		Lines:        []*inventorypb.PurchaseOrderLine{{PartUuid: "engine-1", Quantity: 1}, {PartUuid: "engine-1", Quantity: 2}},
+	})
+	s.ErrorIs(err, ErrInvalidPurchaseOrder)
+
+	_, err = s.service.Create(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Manufacturer: manufacturer,
+		Lines:        []*inventorypb.PurchaseOrderLine{{PartUuid: "engine-1"}},
+	})
+	s.ErrorIs(err, ErrInvalidQuantity)
+
+	s.parts.On("List", ctx, mock.Anything).Return([]*inventorypb.Part{{Uuid: "stage-1", Kit: &inventorypb.Kit{}}}, nil)
+	_, err = s.service.Create(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Manufacturer: manufacturer,
+		Lines:        []*inventorypb.PurchaseOrderLine{{PartUuid: "stage-1", Quantity: 1}, {PartUuid: "ghost", Quantity: 1}},
+	})
+	s.ErrorIs(err, ErrInvalidPurchaseOrder)
+}
+
+func (s *PurchaseOrderServiceTest) TestReceive_Partial() {
+	ctx := context.Background()
+	s.repo.On("Get", ctx, "po-1").Return(openPurchaseOrder(), nil)
+	s.repo.On("Update", ctx, mock.MatchedBy(func(po *model.PurchaseOrder) bool {
+		return po.Status == model.PurchaseOrderPartiallyReceived && po.Version == 3
+	})).Return(nil)
+	s.parts.On("IncrementStock", ctx, "engine-1", int64(2)).Return(nil)
+
+	po, err := s.service.Receive(ctx, "po-1", []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 2}}, "storekeeper")
+
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_PARTIALLY_RECEIVED, po.Status)
+	line := po.Lines[0]
+	s.Equal(int64(2), line.ReceivedQuantity)
+	s.Require().Len(line.Receipts, 1)
+	s.Equal("storekeeper", line.Receipts[0].ReceivedBy)
+	s.True(line.Receipts[0].ReceivedAt.AsTime().Equal(s.now))
+}
+
+func (s *PurchaseOrderServiceTest) TestReceive_CompletesOrder() {
+	ctx := context.Background()
+	order := openPurchaseOrder()
+	order.Lines[1].CancelledQuantity = 4
+	s.repo.On("Get", ctx, "po-1").Return(order, nil)
+	s.repo.On("Update", ctx, mock.Anything).Return(nil)
+	s.parts.On("IncrementStock", ctx, "engine-1", int64(5)).Return(nil)
+
+	po, err := s.service.Receive(ctx, "po-1", []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 5}}, "storekeeper")
+
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_RECEIVED, po.Status)
+}
+
+func (s *PurchaseOrderServiceTest) TestReceive_OverReceipt() {
+	ctx := context.Background()
+	s.repo.On("Get", ctx, "po-1").Return(openPurchaseOrder(), nil)
+
+	_, err := s.service.Receive(ctx, "po-1", []*inventorypb.StockItem{{Uuid: "wing-1", Quantity: 3}, {Uuid: "wing-1", Quantity: 2}}, "storekeeper")
+
+	s.ErrorIs(err, ErrInvalidPurchaseOrder)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *PurchaseOrderServiceTest) TestReceive_Closed() {
+	ctx := context.Background()
+	order := openPurchaseOrder()
+	order.Status = model.PurchaseOrderCancelled
+	s.repo.On("Get", ctx, "po-1").Return(order, nil)
+
+	_, err := s.service.Receive(ctx, "po-1", []*inventorypb.StockItem{{Uuid: "wing-1", Quantity: 1}}, "storekeeper")
+
+	s.ErrorIs(err, model.ErrPurchaseOrderClosed)
+}
+
+func (s *PurchaseOrderServiceTest) TestReceive_RollsBackOnStockFailure() {
+	ctx := context.Background()
+	s.repo.On("Get", ctx, "po-1").Return(openPurchaseOrder(), nil)
+	s.repo.On("Update", ctx, mock.MatchedBy(func(po *model.PurchaseOrder) bool {
+		return po.Lines[0].ReceivedQuantity == 1
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.PurchaseOrder).Version++
+	}).Return(nil).Once()
+	s.repo.On("Update", ctx, mock.MatchedBy(func(po *model.PurchaseOrder) bool {
+		return po.Lines[0].ReceivedQuantity == 0 && len(po.Lines[0].Receipts) == 0 && po.Version == 4
+	})).Return(nil).Once()
+	s.parts.On("IncrementStock", ctx, "engine-1", int64(1)).Return(nil)
+	s.parts.On("IncrementStock", ctx, "wing-1", int64(1)).Return(errors.New("boom"))
+	s.parts.On("DecrementStock", ctx, "engine-1", int64(1)).Return(nil)
+
+	_, err := s.service.Receive(ctx, "po-1", []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 1}, {Uuid: "wing-1", Quantity: 1}}, "storekeeper")
+
+	s.ErrorContains(err, "boom")
+}
+
+func (s *PurchaseOrderServiceTest) TestCancelLines() {
+	ctx := context.Background()
+	order := openPurchaseOrder()
+	order.Lines[0].ReceivedQuantity = 2
+	s.repo.On("Get", ctx, "po-1").Return(order, nil)
+	s.repo.On("Update", ctx, mock.Anything).Return(nil)
+
+	po, err := s.service.CancelLines(ctx, "po-1", []string{"engine-1"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(3), po.Lines[0].CancelledQuantity)
+	s.Equal(int64(0), po.Lines[1].CancelledQuantity)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_PARTIALLY_RECEIVED, po.Status)
+
+	po, err = s.service.CancelLines(ctx, "po-1", nil)
+
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_RECEIVED, po.Status)
+}
+
+func (s *PurchaseOrderServiceTest) TestCancelLines_NothingReceived() {
+	ctx := context.Background()
+	s.repo.On("Get", ctx, "po-1").Return(openPurchaseOrder(), nil)
+	s.repo.On("Update", ctx, mock.Anything).Return(nil)
+
+	po, err := s.service.CancelLines(ctx, "po-1", nil)
+
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_CANCELLED, po.Status)
+}
+
+func (s *PurchaseOrderServiceTest) TestListOpen() {
+	ctx := context.Background()
+	first := openPurchaseOrder()
+	first.Lines[0].ReceivedQuantity = 2
+	second := openPurchaseOrder()
+	second.UUID = "po-2"
+	second.Lines[1].CancelledQuantity = 4
+	s.repo.On("ListOpen", ctx, []string(nil)).Return([]model.PurchaseOrder{*first, *second}, nil)
+
+	parts, err := s.service.ListOpen(ctx, nil)
+
+	s.Require().NoError(err)
+	s.Require().Len(parts, 2)
+	s.Equal("engine-1", parts[0].PartUuid)
+	s.Equal(int64(8), parts[0].OpenQuantity)
+	s.Require().Len(parts[0].Lines, 2)
+	s.Equal("po-1", parts[0].Lines[0].PurchaseOrderUuid)
+	s.Equal(int64(3), parts[0].Lines[0].OpenQuantity)
+	s.Equal("wing-1", parts[1].PartUuid)
+	s.Equal(int64(4), parts[1].OpenQuantity)
+	s.Len(parts[1].Lines, 1)
+}
+
+func TestPurchaseOrderServiceTest(t *testing.T) {
+	suite.Run(t, new(PurchaseOrderServiceTest))
+}
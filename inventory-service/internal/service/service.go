@@ -2,27 +2,130 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"inventory-service/grpc/inventorypb"
 	repo "inventory-service/repository"
+	"time"
 )
 
+var ErrInvalidQuantity = errors.New("quantity must be greater than 0")
+
+// PartService returns parts priced as of asOf; the zero time means now.
 type PartService interface {
-	Get(ctx context.Context, uuid string) (*inventorypb.Part, error)
-	List(ctx context.Context, filter *inventorypb.PartsFilter) ([]*inventorypb.Part, error)
+	Get(ctx context.Context, uuid string, asOf time.Time) (*inventorypb.Part, error)
+	List(ctx context.Context, filter *inventorypb.PartsFilter, asOf time.Time) ([]*inventorypb.Part, error)
+	Reserve(ctx context.Context, items []*inventorypb.StockItem) error
+	Release(ctx context.Context, items []*inventorypb.StockItem) error
+	ValidateConfiguration(ctx context.Context, items []*inventorypb.StockItem) ([]*inventorypb.ConfigurationViolation, error)
+	SchedulePriceChange(ctx context.Context, uuid string, price float64, effectiveFrom time.Time) (*inventorypb.PriceChange, error)
+	GetPriceHistory(ctx context.Context, uuid string) ([]*inventorypb.PriceChange, error)
+	SetReorderLevels(ctx context.Context, uuid string, threshold, target int64) error
+	ListLowStock(ctx context.Context) ([]*inventorypb.LowStockItem, error)
 }
 
 type Service struct {
-	repo repo.PartRepo
+	repo       repo.PartRepo
+	categories repo.CategoryRepo
+	now        func() time.Time
+}
+
+type Option func(*Service)
+
+func NewPartService(r repo.PartRepo, opts ...Option) PartService {
+	s := &Service{repo: r, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) Get(ctx context.Context, uuid string, asOf time.Time) (*inventorypb.Part, error) {
+	part, err := s.repo.Get(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolve(ctx, []*inventorypb.Part{part}, asOf); err != nil {
+		return nil, err
+	}
+	return part, nil
+}
+
+func (s *Service) List(ctx context.Context, filter *inventorypb.PartsFilter, asOf time.Time) ([]*inventorypb.Part, error) {
+	filter, err := s.expandCategories(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	parts, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolve(ctx, parts, asOf); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// resolve fills in the values of parts that are not stored as is: prices
+// from the price history and the stock and price of kits.
+func (s *Service) resolve(ctx context.Context, parts []*inventorypb.Part, asOf time.Time) error {
+	if asOf.IsZero() {
+		asOf = s.now()
+	}
+	if err := s.applyPrices(ctx, parts, asOf); err != nil {
+		return err
+	}
+	return s.resolveKits(ctx, parts, asOf)
 }
 
-func NewPartService(r repo.PartRepo) PartService {
-	return &Service{repo: r}
+// Reserve decrements stock item by item. If any item cannot be reserved, the
+// items reserved so far are returned to stock and the original error is
+// reported, so a failed reservation leaves the stock untouched. Kits are
+// reserved as their components.
+func (s *Service) Reserve(ctx context.Context, items []*inventorypb.StockItem) error {
+	if err := validateStockItems(items); err != nil {
+		return err
+	}
+	items, err := s.expandKits(ctx, items)
+	if err != nil {
+		return err
+	}
+	for i, item := range items {
+		err := s.repo.DecrementStock(ctx, item.Uuid, item.Quantity)
+		if err == nil {
+			continue
+		}
+		for _, reserved := range items[:i] {
+			if rerr := s.repo.IncrementStock(ctx, reserved.Uuid, reserved.Quantity); rerr != nil {
+				return fmt.Errorf("%w (rollback of %s failed: %v)", err, reserved.Uuid, rerr)
+			}
+		}
+		return fmt.Errorf("part %s: %w", item.Uuid, err)
+	}
+	return nil
 }
 
-func (s *Service) Get(ctx context.Context, uuid string) (*inventorypb.Part, error) {
-	return s.repo.Get(ctx, uuid)
+func (s *Service) Release(ctx context.Context, items []*inventorypb.StockItem) error {
+	if err := validateStockItems(items); err != nil {
+		return err
+	}
+	items, err := s.expandKits(ctx, items)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := s.repo.IncrementStock(ctx, item.Uuid, item.Quantity); err != nil {
+			return fmt.Errorf("part %s: %w", item.Uuid, err)
+		}
+	}
+	return nil
 }
 
-func (s *Service) List(ctx context.Context, filter *inventorypb.PartsFilter) ([]*inventorypb.Part, error) {
-	return s.repo.List(ctx, filter)
+func validateStockItems(items []*inventorypb.StockItem) error {
+	for _, item := range items {
+		if item.GetQuantity() <= 0 {
+			return fmt.Errorf("part %s: %w", item.GetUuid(), ErrInvalidQuantity)
+		}
+	}
+	return nil
 }
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/model"
 	"inventory-service/mocks"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type InventoryServiceTest struct {
@@ -21,6 +25,7 @@ type InventoryServiceTest struct {
 func (s *InventoryServiceTest) SetupTest() {
 	s.repo = mocks.NewPartRepo(s.T())
 	s.service = NewPartService(s.repo)
+	s.service.(*Service).now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
 }
 
 func (s *InventoryServiceTest) TestGet() {
@@ -33,7 +38,9 @@ func (s *InventoryServiceTest) TestGet() {
 		On("Get", ctx, uuid).
 		Return(expected, nil)
 
-	res, err := s.service.Get(ctx, uuid)
+	s.repo.On("PriceHistory", ctx, []string{uuid}).Return(nil, nil)
+
+	res, err := s.service.Get(ctx, uuid, time.Time{})
 
 	s.NoError(err)
 	s.Equal(expected, res)
@@ -46,7 +53,7 @@ func (s *InventoryServiceTest) TestGet_NotFound() {
 	uuid := "engine-1"
 
 	s.repo.On("Get", ctx, uuid).Return(nil, errors.New("not found"))
-	_, err := s.service.Get(ctx, uuid)
+	_, err := s.service.Get(ctx, uuid, time.Time{})
 
 	s.Error(err)
 	s.repo.AssertExpectations(s.T())
@@ -62,13 +69,326 @@ func (s *InventoryServiceTest) TestList() {
 		{Uuid: "wing-1", Name: "Wing"},
 	}
 	s.repo.On("List", ctx, filter).Return(expected, nil)
-	res, err := s.service.List(ctx, filter)
+	s.repo.On("PriceHistory", ctx, []string{"engine-1", "wing-1"}).Return(nil, nil)
+	res, err := s.service.List(ctx, filter, time.Time{})
 	s.NoError(err)
 	s.Equal(res, expected)
 	s.repo.AssertExpectations(s.T())
 
 }
 
+func (s *InventoryServiceTest) TestReserve() {
+	ctx := context.Background()
+	items := []*inventorypb.StockItem{
+		{Uuid: "engine-1", Quantity: 2},
+		{Uuid: "wing-1", Quantity: 4},
+	}
+	s.repo.On("List", ctx, mock.Anything).Return(nil, nil)
+	s.repo.On("DecrementStock", ctx, "engine-1", int64(2)).Return(nil)
+	s.repo.On("DecrementStock", ctx, "wing-1", int64(4)).Return(nil)
+
+	s.NoError(s.service.Reserve(ctx, items))
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *InventoryServiceTest) TestReserve_RollsBackOnShortage() {
+	ctx := context.Background()
+	items := []*inventorypb.StockItem{
+		{Uuid: "engine-1", Quantity: 2},
+		{Uuid: "wing-1", Quantity: 40},
+	}
+	s.repo.On("List", ctx, mock.Anything).Return(nil, nil)
+	s.repo.On("DecrementStock", ctx, "engine-1", int64(2)).Return(nil)
+	s.repo.On("DecrementStock", ctx, "wing-1", int64(40)).Return(model.ErrNotEnoughStock)
+	s.repo.On("IncrementStock", ctx, "engine-1", int64(2)).Return(nil)
+
+	err := s.service.Reserve(ctx, items)
+	s.ErrorIs(err, model.ErrNotEnoughStock)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *InventoryServiceTest) TestReserve_InvalidQuantity() {
+	err := s.service.Reserve(context.Background(), []*inventorypb.StockItem{{Uuid: "engine-1"}})
+	s.ErrorIs(err, ErrInvalidQuantity)
+	s.repo.AssertNotCalled(s.T(), "DecrementStock")
+}
+
+func (s *InventoryServiceTest) TestRelease() {
+	ctx := context.Background()
+	s.repo.On("List", ctx, mock.Anything).Return(nil, nil)
+	s.repo.On("IncrementStock", ctx, "engine-1", int64(3)).Return(nil)
+
+	s.NoError(s.service.Release(ctx, []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 3}}))
+	s.repo.AssertExpectations(s.T())
+}
+
+func stageKit(pricing inventorypb.KitPricing) *inventorypb.Part {
+	return &inventorypb.Part{
+		Uuid:  "stage-1",
+		Price: 1900000,
+		Kit: &inventorypb.Kit{
+			Components: []*inventorypb.KitComponent{
+				{PartUuid: "engine-1", Quantity: 1},
+				{PartUuid: "wing-1", Quantity: 2},
+			},
+			Pricing:         pricing,
+			DiscountPercent: 10,
+		},
+	}
+}
+
+func (s *InventoryServiceTest) TestGet_KitDerivedPriceAndStock() {
+	ctx := context.Background()
+	s.repo.On("Get", ctx, "stage-1").Return(stageKit(inventorypb.KitPricing_KIT_PRICING_DERIVED), nil)
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"engine-1", "wing-1"}}).Return([]*inventorypb.Part{
+		{Uuid: "engine-1", Price: 1500000, StockQuantity: 10},
+		{Uuid: "wing-1", Price: 250000, StockQuantity: 5},
+	}, nil)
+
+	s.repo.On("PriceHistory", ctx, mock.Anything).Return(nil, nil)
+
+	part, err := s.service.Get(ctx, "stage-1", time.Time{})
+	s.Require().NoError(err)
+	s.Equal(int64(2), part.StockQuantity)
+	s.Equal(1800000.0, part.Price)
+}
+
+func (s *InventoryServiceTest) TestList_KitFixedPriceMissingComponent() {
+	ctx := context.Background()
+	s.repo.On("List", ctx, (*inventorypb.PartsFilter)(nil)).Return([]*inventorypb.Part{stageKit(inventorypb.KitPricing_KIT_PRICING_FIXED)}, nil)
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"engine-1", "wing-1"}}).Return([]*inventorypb.Part{
+		{Uuid: "engine-1", Price: 1500000, StockQuantity: 10},
+	}, nil)
+
+	s.repo.On("PriceHistory", ctx, mock.Anything).Return(nil, nil)
+
+	parts, err := s.service.List(ctx, nil, time.Time{})
+	s.Require().NoError(err)
+	s.Equal(int64(0), parts[0].StockQuantity)
+	s.Equal(1900000.0, parts[0].Price)
+}
+
+func (s *InventoryServiceTest) TestReserve_ExpandsKits() {
+	ctx := context.Background()
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"stage-1", "wing-1"}}).
+		Return([]*inventorypb.Part{stageKit(inventorypb.KitPricing_KIT_PRICING_FIXED), {Uuid: "wing-1"}}, nil)
+	s.repo.On("DecrementStock", ctx, "engine-1", int64(2)).Return(nil)
+	s.repo.On("DecrementStock", ctx, "wing-1", int64(5)).Return(nil)
+
+	err := s.service.Reserve(ctx, []*inventorypb.StockItem{
+		{Uuid: "stage-1", Quantity: 2},
+		{Uuid: "wing-1", Quantity: 1},
+	})
+	s.NoError(err)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *InventoryServiceTest) TestRelease_ExpandsKits() {
+	ctx := context.Background()
+	s.repo.On("List", ctx, mock.Anything).Return([]*inventorypb.Part{stageKit(inventorypb.KitPricing_KIT_PRICING_FIXED)}, nil)
+	s.repo.On("IncrementStock", ctx, "engine-1", int64(1)).Return(nil)
+	s.repo.On("IncrementStock", ctx, "wing-1", int64(2)).Return(nil)
+
+	s.NoError(s.service.Release(ctx, []*inventorypb.StockItem{{Uuid: "stage-1", Quantity: 1}}))
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *InventoryServiceTest) TestReserve_EmptyKit() {
+	ctx := context.Background()
+	s.repo.On("List", ctx, mock.Anything).Return([]*inventorypb.Part{{Uuid: "stage-1", Kit: &inventorypb.Kit{}}}, nil)
+
+	err := s.service.Reserve(ctx, []*inventorypb.StockItem{{Uuid: "stage-1", Quantity: 1}})
+	s.ErrorIs(err, model.ErrInvalidKit)
+	s.repo.AssertNotCalled(s.T(), "DecrementStock")
+}
+
+func (s *InventoryServiceTest) TestValidateConfiguration() {
+	ctx := context.Background()
+	engine := &inventorypb.Part{
+		Uuid:     "engine-1",
+		Category: inventorypb.Category_CATEGORY_ENGINE,
+		Compatibility: []*inventorypb.CompatibilityRule{
+			{Type: inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_REQUIRES, TargetCategory: inventorypb.Category_CATEGORY_WING, Quantity: 2},
+			{Type: inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH, TargetPartUuid: "fuel-1"},
+			{Type: inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_EXCLUDES, TargetPartUuid: "porthole-1"},
+		},
+	}
+	parts := []*inventorypb.Part{
+		engine,
+		{Uuid: "wing-1", Category: inventorypb.Category_CATEGORY_WING},
+		{Uuid: "fuel-2", Category: inventorypb.Category_CATEGORY_FUEL},
+		{Uuid: "porthole-1", Category: inventorypb.Category_CATEGORY_PORTHOLE},
+	}
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"engine-1", "wing-1", "fuel-2", "porthole-1", "ghost-1"}}).Return(parts, nil)
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{Uuids: []string{"fuel-1"}}).
+		Return([]*inventorypb.Part{{Uuid: "fuel-1", Category: inventorypb.Category_CATEGORY_FUEL}}, nil)
+
+	violations, err := s.service.ValidateConfiguration(ctx, []*inventorypb.StockItem{
+		{Uuid: "engine-1", Quantity: 1},
+		{Uuid: "wing-1", Quantity: 1},
+		{Uuid: "fuel-2", Quantity: 1},
+		{Uuid: "porthole-1", Quantity: 1},
+		{Uuid: "ghost-1", Quantity: 1},
+	})
+	s.Require().NoError(err)
+
+	type got struct {
+		part   string
+		rule   inventorypb.CompatibilityRuleType
+		target string
+	}
+	var res []got
+	for _, v := range violations {
+		res = append(res, got{v.PartUuid, v.Rule, v.TargetPartUuid})
+	}
+	s.Equal([]got{
+		{"ghost-1", inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_UNKNOWN, ""},
+		{"engine-1", inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_REQUIRES, ""},
+		{"engine-1", inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_EXCLUDES, "porthole-1"},
+		{"engine-1", inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH, "fuel-2"},
+	}, res)
+	s.Equal(inventorypb.Category_CATEGORY_WING, violations[1].TargetCategory)
+}
+
+func (s *InventoryServiceTest) TestValidateConfiguration_Valid() {
+	ctx := context.Background()
+	engine := &inventorypb.Part{
+		Uuid:     "engine-1",
+		Category: inventorypb.Category_CATEGORY_ENGINE,
+		Compatibility: []*inventorypb.CompatibilityRule{
+			{Type: inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_REQUIRES, TargetPartUuid: "fuel-1"},
+			{Type: inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH, TargetPartUuid: "fuel-1"},
+		},
+	}
+	s.repo.On("List", ctx, mock.Anything).Return([]*inventorypb.Part{
+		engine,
+		{Uuid: "fuel-1", Category: inventorypb.Category_CATEGORY_FUEL},
+	}, nil)
+
+	violations, err := s.service.ValidateConfiguration(ctx, []*inventorypb.StockItem{
+		{Uuid: "engine-1", Quantity: 2},
+		{Uuid: "fuel-1", Quantity: 2},
+	})
+	s.Require().NoError(err)
+	s.Empty(violations)
+}
+
+func priceChange(price float64, effectiveFrom time.Time) *inventorypb.PriceChange {
+	return &inventorypb.PriceChange{Price: price, EffectiveFrom: timestamppb.New(effectiveFrom)}
+}
+
+func (s *InventoryServiceTest) TestGet_PriceAsOf() {
+	ctx := context.Background()
+	history := map[string][]*inventorypb.PriceChange{
+		"engine-1": {
+			priceChange(1600000, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+			priceChange(1700000, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+	s.repo.On("Get", ctx, "engine-1").Return(func(context.Context, string) (*inventorypb.Part, error) {
+		return &inventorypb.Part{Uuid: "engine-1", Price: 1500000}, nil
+	})
+	s.repo.On("PriceHistory", ctx, []string{"engine-1"}).Return(history, nil)
+
+	for _, tc := range []struct {
+		asOf  time.Time
+		price float64
+	}{
+		{time.Time{}, 1600000},
+		{time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), 1500000},
+		{time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), 1700000},
+	} {
+		part, err := s.service.Get(ctx, "engine-1", tc.asOf)
+		s.Require().NoError(err)
+		s.Equal(tc.price, part.Price, tc.asOf)
+	}
+}
+
+func (s *InventoryServiceTest) TestSchedulePriceChange() {
+	ctx := context.Background()
+	effective := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	s.repo.On("AddPriceChange", ctx, "engine-1", model.PriceChange{
+		Price:         1700000,
+		EffectiveFrom: effective,
+		CreatedAt:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+	}).Return(nil)
+
+	change, err := s.service.SchedulePriceChange(ctx, "engine-1", 1700000, effective)
+	s.Require().NoError(err)
+	s.Equal(effective, change.EffectiveFrom.AsTime())
+}
+
+func (s *InventoryServiceTest) TestSchedulePriceChange_Invalid() {
+	ctx := context.Background()
+	_, err := s.service.SchedulePriceChange(ctx, "engine-1", 0, time.Time{})
+	s.ErrorIs(err, ErrInvalidPrice)
+
+	_, err = s.service.SchedulePriceChange(ctx, "engine-1", 10, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	s.ErrorIs(err, ErrPriceChangeInPast)
+	s.repo.AssertNotCalled(s.T(), "AddPriceChange")
+}
+
+func (s *InventoryServiceTest) TestGetPriceHistory() {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduled := priceChange(1700000, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+	s.repo.On("Get", ctx, "engine-1").Return(&inventorypb.Part{Uuid: "engine-1", Price: 1500000, CreatedAt: timestamppb.New(created)}, nil)
+	s.repo.On("PriceHistory", ctx, []string{"engine-1"}).Return(map[string][]*inventorypb.PriceChange{"engine-1": {scheduled}}, nil)
+
+	history, err := s.service.GetPriceHistory(ctx, "engine-1")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(1500000.0, history[0].Price)
+	s.Equal(created, history[0].EffectiveFrom.AsTime())
+	s.Equal(scheduled, history[1])
+}
+
+func (s *InventoryServiceTest) TestSetReorderLevels() {
+	ctx := context.Background()
+	s.repo.On("SetReorderLevels", ctx, "engine-1", int64(2), int64(10)).Return(nil)
+
+	s.NoError(s.service.SetReorderLevels(ctx, "engine-1", 2, 10))
+	s.ErrorIs(s.service.SetReorderLevels(ctx, "engine-1", 5, 5), ErrInvalidReorderLevels)
+	s.ErrorIs(s.service.SetReorderLevels(ctx, "engine-1", -1, 0), ErrInvalidReorderLevels)
+}
+
+func (s *InventoryServiceTest) TestListLowStock() {
+	ctx := context.Background()
+	alertedAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	s.repo.On("ListLowStock", ctx).Return([]model.Part{
+		{UUID: "engine-1", StockQuantity: 0, ReorderThreshold: 2, TargetLevel: 10,
+			StockAlert: &model.StockAlert{Level: model.StockLevelLow, At: alertedAt}},
+		{UUID: "wing-1", StockQuantity: 1, ReorderThreshold: 2, TargetLevel: 6,
+			StockAlert: &model.StockAlert{Level: model.StockLevelLow, At: alertedAt}},
+	}, nil)
+
+	items, err := s.service.ListLowStock(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+	s.Equal(inventorypb.StockLevel_STOCK_LEVEL_OUT, items[0].Level)
+	s.Equal(int64(10), items[0].ReorderQuantity)
+	s.Nil(items[0].AlertedAt)
+	s.Equal(inventorypb.StockLevel_STOCK_LEVEL_LOW, items[1].Level)
+	s.Equal(alertedAt, items[1].AlertedAt.AsTime())
+}
+
+func (s *InventoryServiceTest) TestList_CategoryIncludesDescendants() {
+	ctx := context.Background()
+	categories := mocks.NewCategoryRepo(s.T())
+	s.service.(*Service).categories = categories
+	categories.On("List", ctx).Return(categoryFixture(), nil)
+	filter := &inventorypb.PartsFilter{CategoryIds: []string{"rocket"}}
+	s.repo.On("List", ctx, &inventorypb.PartsFilter{CategoryIds: []string{"rocket", "methalox"}}).
+		Return([]*inventorypb.Part{{Uuid: "engine-1", CategoryId: "methalox"}}, nil)
+	s.repo.On("PriceHistory", ctx, []string{"engine-1"}).Return(nil, nil)
+
+	parts, err := s.service.List(ctx, filter, time.Time{})
+
+	s.NoError(err)
+	s.Len(parts, 1)
+	s.Equal([]string{"rocket"}, filter.CategoryIds)
+}
+
 func TestInventoryServiceTest(t *testing.T) {
 	suite.Run(t, new(InventoryServiceTest))
 }
@@ -3,10 +3,13 @@ package test
 import (
 	"context"
 	"inventory-service/grpc/inventorypb"
+	"inventory-service/internal/migration"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *InvE2ESuite) TestListParts_Success() {
@@ -92,3 +95,371 @@ func (s *InvE2ESuite) TestGetPart_Success() {
 	s.Require().NoError(err)
 	s.Equal("engine-1", resp.Part.Uuid)
 }
+
+func (s *InvE2ESuite) TestReserveStock_Success() {
+	ctx := context.Background()
+	_, err := s.Col.InsertOne(ctx, bson.M{
+		"uuid":           "engine-1",
+		"name":           "Main Engine",
+		"price":          100.00,
+		"stock_quantity": 10,
+		"category":       1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.Client.ReserveStock(ctx, &inventorypb.ReserveStockRequest{
+		Items: []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 4}},
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Equal(int64(6), resp.Part.StockQuantity)
+
+	_, err = s.Client.ReleaseStock(ctx, &inventorypb.ReleaseStockRequest{
+		Items: []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 4}},
+	})
+	s.Require().NoError(err)
+
+	resp, err = s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Equal(int64(10), resp.Part.StockQuantity)
+}
+
+func (s *InvE2ESuite) TestReserveStock_NotEnough() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "price": 100.00, "stock_quantity": 10},
+		bson.M{"uuid": "wing-1", "name": "Wing", "price": 150.00, "stock_quantity": 1},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.Client.ReserveStock(ctx, &inventorypb.ReserveStockRequest{
+		Items: []*inventorypb.StockItem{
+			{Uuid: "engine-1", Quantity: 4},
+			{Uuid: "wing-1", Quantity: 2},
+		},
+	})
+	s.Require().Error(err)
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.FailedPrecondition, st.Code())
+
+	resp, err := s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Equal(int64(10), resp.Part.StockQuantity)
+}
+
+func (s *InvE2ESuite) TestKit_ReserveDrawsOnComponents() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "price": 100.00, "stock_quantity": 3},
+		bson.M{"uuid": "wing-1", "name": "Wing", "price": 50.00, "stock_quantity": 5},
+		bson.M{
+			"uuid": "stage-1",
+			"name": "Stage",
+			"kit": bson.M{
+				"components": []bson.M{
+					{"part_uuid": "engine-1", "quantity": 1},
+					{"part_uuid": "wing-1", "quantity": 2},
+				},
+				"pricing":          int32(inventorypb.KitPricing_KIT_PRICING_DERIVED),
+				"discount_percent": 10,
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "stage-1"})
+	s.Require().NoError(err)
+	s.Equal(int64(2), resp.Part.StockQuantity)
+	s.Equal(180.0, resp.Part.Price)
+
+	_, err = s.Client.ReserveStock(ctx, &inventorypb.ReserveStockRequest{
+		Items: []*inventorypb.StockItem{{Uuid: "stage-1", Quantity: 2}},
+	})
+	s.Require().NoError(err)
+
+	list, err := s.Client.ListParts(ctx, &inventorypb.ListPartsRequest{
+		Filter: &inventorypb.PartsFilter{Uuids: []string{"engine-1", "wing-1", "stage-1"}},
+	})
+	s.Require().NoError(err)
+	stock := make(map[string]int64)
+	for _, p := range list.Parts {
+		stock[p.Uuid] = p.StockQuantity
+	}
+	s.Equal(map[string]int64{"engine-1": 1, "wing-1": 1, "stage-1": 0}, stock)
+
+	_, err = s.Client.ReserveStock(ctx, &inventorypb.ReserveStockRequest{
+		Items: []*inventorypb.StockItem{{Uuid: "stage-1", Quantity: 1}},
+	})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Equal(codes.FailedPrecondition, st.Code())
+}
+
+func (s *InvE2ESuite) TestValidateConfiguration_Incompatible() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{
+			"uuid":     "engine-1",
+			"name":     "Main Engine",
+			"category": int32(inventorypb.Category_CATEGORY_ENGINE),
+			"compatibility": []bson.M{
+				{"type": int32(inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_COMPATIBLE_WITH), "target_part_uuid": "fuel-1"},
+			},
+		},
+		bson.M{"uuid": "fuel-1", "name": "Kerosene", "category": int32(inventorypb.Category_CATEGORY_FUEL)},
+		bson.M{"uuid": "fuel-2", "name": "Methane", "category": int32(inventorypb.Category_CATEGORY_FUEL)},
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.ValidateConfiguration(ctx, &inventorypb.ValidateConfigurationRequest{
+		Items: []*inventorypb.StockItem{
+			{Uuid: "engine-1", Quantity: 1},
+			{Uuid: "fuel-1", Quantity: 1},
+		},
+	})
+	s.Require().NoError(err)
+	s.True(resp.Valid)
+
+	resp, err = s.Client.ValidateConfiguration(ctx, &inventorypb.ValidateConfigurationRequest{
+		Items: []*inventorypb.StockItem{
+			{Uuid: "engine-1", Quantity: 1},
+			{Uuid: "fuel-2", Quantity: 1},
+		},
+	})
+	s.Require().NoError(err)
+	s.False(resp.Valid)
+	s.Require().Len(resp.Violations, 1)
+	s.Equal("fuel-2", resp.Violations[0].TargetPartUuid)
+}
+
+func (s *InvE2ESuite) TestPriceHistory_ScheduledChange() {
+	ctx := context.Background()
+	created := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Millisecond)
+	_, err := s.Col.InsertOne(ctx, bson.M{
+		"uuid":           "engine-1",
+		"name":           "Main Engine",
+		"price":          100.00,
+		"stock_quantity": 10,
+		"created_at":     created,
+	})
+	s.Require().NoError(err)
+
+	effective := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+	_, err = s.Client.SchedulePriceChange(ctx, &inventorypb.SchedulePriceChangeRequest{
+		PartUuid:      "engine-1",
+		Price:         120.00,
+		EffectiveFrom: timestamppb.New(effective),
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Equal(100.00, resp.Part.Price)
+
+	resp, err = s.Client.GetPart(ctx, &inventorypb.GetPartRequest{
+		Uuid: "engine-1",
+		AsOf: timestamppb.New(effective.Add(time.Minute)),
+	})
+	s.Require().NoError(err)
+	s.Equal(120.00, resp.Part.Price)
+
+	history, err := s.Client.GetPriceHistory(ctx, &inventorypb.GetPriceHistoryRequest{PartUuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Require().Len(history.Changes, 2)
+	s.Equal(created, history.Changes[0].EffectiveFrom.AsTime())
+	s.Equal(120.00, history.Changes[1].Price)
+
+	_, err = s.Client.SchedulePriceChange(ctx, &inventorypb.SchedulePriceChangeRequest{
+		PartUuid:      "engine-1",
+		Price:         90.00,
+		EffectiveFrom: timestamppb.New(created),
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *InvE2ESuite) TestListLowStock() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "stock_quantity": 0},
+		bson.M{"uuid": "wing-1", "name": "Wing", "stock_quantity": 3},
+		bson.M{"uuid": "fuel-1", "name": "Fuel", "stock_quantity": 30, "reorder_threshold": 5, "target_level": 50},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.Client.SetReorderLevels(ctx, &inventorypb.SetReorderLevelsRequest{
+		PartUuid:         "wing-1",
+		ReorderThreshold: 3,
+		TargetLevel:      8,
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.ListLowStock(ctx, &inventorypb.ListLowStockRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Items, 2)
+	s.Equal("engine-1", resp.Items[0].PartUuid)
+	s.Equal(inventorypb.StockLevel_STOCK_LEVEL_OUT, resp.Items[0].Level)
+	s.Equal("wing-1", resp.Items[1].PartUuid)
+	s.Equal(inventorypb.StockLevel_STOCK_LEVEL_LOW, resp.Items[1].Level)
+	s.Equal(int64(5), resp.Items[1].ReorderQuantity)
+}
+
+func (s *InvE2ESuite) TestPurchaseOrder_ReceiveAndCancel() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "stock_quantity": 1},
+		bson.M{"uuid": "wing-1", "name": "Wing", "stock_quantity": 0},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.Client.CreatePurchaseOrder(ctx, &inventorypb.CreatePurchaseOrderRequest{
+		Manufacturer: &inventorypb.Manufacter{Name: "SpaceY", Country: "USA"},
+		Lines: []*inventorypb.PurchaseOrderLine{
+			{PartUuid: "engine-1", Quantity: 4, ExpectedAt: timestamppb.New(time.Now().Add(48 * time.Hour))},
+			{PartUuid: "wing-1", Quantity: 6},
+		},
+	})
+	s.Require().NoError(err)
+	poUUID := created.PurchaseOrder.Uuid
+
+	received, err := s.Client.ReceivePurchaseOrder(ctx, &inventorypb.ReceivePurchaseOrderRequest{
+		Uuid:       poUUID,
+		Items:      []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 3}},
+		ReceivedBy: "storekeeper",
+	})
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_PARTIALLY_RECEIVED, received.PurchaseOrder.Status)
+
+	part, err := s.Client.GetPart(ctx, &inventorypb.GetPartRequest{Uuid: "engine-1"})
+	s.Require().NoError(err)
+	s.Equal(int64(4), part.Part.StockQuantity)
+
+	_, err = s.Client.ReceivePurchaseOrder(ctx, &inventorypb.ReceivePurchaseOrderRequest{
+		Uuid:       poUUID,
+		Items:      []*inventorypb.StockItem{{Uuid: "engine-1", Quantity: 2}},
+		ReceivedBy: "storekeeper",
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	open, err := s.Client.ListOpenPurchaseOrders(ctx, &inventorypb.ListOpenPurchaseOrdersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(open.Parts, 2)
+	s.Equal(int64(1), open.Parts[0].OpenQuantity)
+	s.Equal(int64(6), open.Parts[1].OpenQuantity)
+
+	cancelled, err := s.Client.CancelPurchaseOrderLines(ctx, &inventorypb.CancelPurchaseOrderLinesRequest{Uuid: poUUID})
+	s.Require().NoError(err)
+	s.Equal(inventorypb.PurchaseOrderStatus_PURCHASE_ORDER_RECEIVED, cancelled.PurchaseOrder.Status)
+
+	open, err = s.Client.ListOpenPurchaseOrders(ctx, &inventorypb.ListOpenPurchaseOrdersRequest{PartUuids: []string{"wing-1"}})
+	s.Require().NoError(err)
+	s.Empty(open.Parts)
+}
+
+func (s *InvE2ESuite) TestManufacturers_DedupeAndFilter() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "manufacter": bson.M{"name": "SpaceY", "country": "USA"}},
+		bson.M{"uuid": "engine-2", "name": "Spare Engine", "manufacter": bson.M{"name": "Space Y", "country": "USA"}},
+		bson.M{"uuid": "fuel-1", "name": "Fuel", "manufacter": bson.M{"name": "SpaceY", "country": "USA"}},
+		bson.M{"uuid": "wing-1", "name": "Wing", "manufacter": bson.M{"name": "AeroWorks", "country": "Germany"}},
+	})
+	s.Require().NoError(err)
+
+	res, err := migration.DedupeManufacturers(ctx, s.Col, s.Manufacturers)
+	s.Require().NoError(err)
+	s.Equal(2, res.Created)
+	s.Equal(4, res.Linked)
+
+	res, err = migration.DedupeManufacturers(ctx, s.Col, s.Manufacturers)
+	s.Require().NoError(err)
+	s.Zero(res.Linked)
+
+	list, err := s.Client.ListManufacturers(ctx, &inventorypb.ListManufacturersRequest{Countries: []string{"USA"}})
+	s.Require().NoError(err)
+	s.Require().Len(list.Manufacturers, 1)
+	spacey := list.Manufacturers[0]
+	s.Equal("SpaceY", spacey.Name)
+
+	_, err = s.Client.CreateManufacturer(ctx, &inventorypb.CreateManufacturerRequest{
+		Manufacturer: &inventorypb.Manufacturer{Name: "space-y"},
+	})
+	s.Equal(codes.AlreadyExists, status.Code(err))
+
+	spacey.Website = "https://spacey.example"
+	_, err = s.Client.UpdateManufacturer(ctx, &inventorypb.UpdateManufacturerRequest{Manufacturer: spacey})
+	s.Require().NoError(err)
+
+	parts, err := s.Client.ListParts(ctx, &inventorypb.ListPartsRequest{
+		Filter: &inventorypb.PartsFilter{ManufacturerIds: []string{spacey.Id}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(parts.Parts, 3)
+	for _, p := range parts.Parts {
+		s.Equal(spacey.Id, p.ManufacturerId)
+		s.Equal("SpaceY", p.Manufacter.Name)
+		s.Equal("https://spacey.example", p.Manufacter.Website)
+	}
+
+	_, err = s.Client.DeleteManufacturer(ctx, &inventorypb.DeleteManufacturerRequest{Id: spacey.Id})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *InvE2ESuite) TestCategories_TreeFilterAndLegacy() {
+	ctx := context.Background()
+	_, err := s.Col.InsertMany(ctx, []interface{}{
+		bson.M{"uuid": "engine-1", "name": "Main Engine", "category": inventorypb.Category_CATEGORY_ENGINE},
+		bson.M{"uuid": "wing-1", "name": "Wing", "category": inventorypb.Category_CATEGORY_WING},
+		bson.M{"uuid": "gyro-1", "name": "Gyroscope"},
+	})
+	s.Require().NoError(err)
+
+	res, err := migration.SeedCategories(ctx, s.Col, s.Categories)
+	s.Require().NoError(err)
+	s.Equal(4, res.Created)
+	s.Equal(2, res.Linked)
+
+	tree, err := s.Client.ListCategoryTree(ctx, &inventorypb.ListCategoryTreeRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(tree.Roots, 4)
+	s.Equal("Engine", tree.Roots[0].Category.Name)
+	engineID := tree.Roots[0].Category.Id
+
+	avionics, err := s.Client.CreateCategory(ctx, &inventorypb.CreateCategoryRequest{Category: &inventorypb.PartCategory{
+		Name:     "Avionics",
+		ParentId: engineID,
+		Attributes: []*inventorypb.AttributeSchema{
+			{Name: "channels", Type: inventorypb.AttributeType_ATTRIBUTE_INT, Required: true},
+		},
+	}})
+	s.Require().NoError(err)
+
+	_, err = s.Client.SetPartCategory(ctx, &inventorypb.SetPartCategoryRequest{
+		PartUuid:   "gyro-1",
+		CategoryId: avionics.Category.Id,
+	})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Client.SetPartCategory(ctx, &inventorypb.SetPartCategoryRequest{
+		PartUuid:   "gyro-1",
+		CategoryId: avionics.Category.Id,
+		Metadata: map[string]*inventorypb.Value{
+			"channels": {Kind: &inventorypb.Value_Int64Value{Int64Value: 3}},
+		},
+	})
+	s.Require().NoError(err)
+
+	parts, err := s.Client.ListParts(ctx, &inventorypb.ListPartsRequest{
+		Filter: &inventorypb.PartsFilter{CategoryIds: []string{engineID}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(parts.Parts, 2)
+	for _, p := range parts.Parts {
+		s.Equal(inventorypb.Category_CATEGORY_ENGINE, p.Category)
+	}
+
+	_, err = s.Client.DeleteCategory(ctx, &inventorypb.DeleteCategoryRequest{Id: avionics.Category.Id})
+	s.Equal(codes.FailedPrecondition, status.Code(err))
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
@@ -18,12 +19,15 @@ import (
 
 type InvE2ESuite struct {
 	suite.Suite
-	Env      *TestEnv
-	Mongo    *mongo.Client
-	Col      *mongo.Collection
-	Server   *grpc.Server
-	Listener net.Listener
-	Client   inventorypb.InventoryServiceClient
+	Env            *TestEnv
+	Mongo          *mongo.Client
+	Col            *mongo.Collection
+	PurchaseOrders *mongo.Collection
+	Manufacturers  *mongo.Collection
+	Categories     *mongo.Collection
+	Server         *grpc.Server
+	Listener       net.Listener
+	Client         inventorypb.InventoryServiceClient
 }
 
 func (s *InvE2ESuite) SetupSuite() {
@@ -37,9 +41,20 @@ func (s *InvE2ESuite) SetupSuite() {
 
 	s.Col = client.Database("inventory_test").Collection("items")
 
-	repo := repo.NewMongoRepo(s.Col)
-	svc := service.NewPartService(repo)
-	handler := handlers.NewInventoryHandler(svc)
+	s.PurchaseOrders = client.Database("inventory_test").Collection("purchase_orders")
+	s.Manufacturers = client.Database("inventory_test").Collection("manufacturers")
+	s.Categories = client.Database("inventory_test").Collection("categories")
+
+	partRepo := repo.NewMongoRepo(s.Col)
+	categoryRepo := repo.NewMongoCategoryRepo(s.Categories)
+	s.Require().NoError(categoryRepo.EnsureIndexes(ctx))
+	svc := service.NewPartService(partRepo, service.WithCategories(categoryRepo))
+	purchases := service.NewPurchaseOrderService(repo.NewMongoPurchaseOrderRepo(s.PurchaseOrders), partRepo)
+	manufacturerRepo := repo.NewMongoManufacturerRepo(s.Manufacturers)
+	s.Require().NoError(manufacturerRepo.EnsureIndexes(ctx))
+	manufacturers := service.NewManufacturerService(manufacturerRepo, partRepo)
+	categories := service.NewCategoryService(categoryRepo, partRepo)
+	handler := handlers.NewInventoryHandler(svc, purchases, manufacturers, categories)
 	lis, err := net.Listen("tcp", ":0")
 	s.Require().NoError(err)
 	s.Listener = lis
@@ -72,6 +87,10 @@ func (s *InvE2ESuite) TearDownSuite() {
 
 func (s *InvE2ESuite) SetupTest() {
 	s.Col.Drop(context.Background())
+	s.PurchaseOrders.Drop(context.Background())
+	// Not dropped, the unique indexes have to stay.
+	s.Manufacturers.DeleteMany(context.Background(), bson.M{})
+	s.Categories.DeleteMany(context.Background(), bson.M{})
 }
 
 func TestInventoryE2E(t *testing.T) {
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "inventory-service/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repo "inventory-service/repository"
+)
+
+// ManufacturerRepo is an autogenerated mock type for the ManufacturerRepo type
+type ManufacturerRepo struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, m
+func (_m *ManufacturerRepo) Create(ctx context.Context, m *model.Manufacturer) error {
+	ret := _m.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ManufacturerRepo) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ManufacturerRepo) Get(ctx context.Context, id string) (*model.Manufacturer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Manufacturer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Manufacturer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *ManufacturerRepo) List(ctx context.Context, filter repo.ManufacturerFilter) ([]model.Manufacturer, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []model.Manufacturer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repo.ManufacturerFilter) ([]model.Manufacturer, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repo.ManufacturerFilter) []model.Manufacturer); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Manufacturer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repo.ManufacturerFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, m
+func (_m *ManufacturerRepo) Update(ctx context.Context, m *model.Manufacturer) error {
+	ret := _m.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Manufacturer) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewManufacturerRepo creates a new instance of ManufacturerRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewManufacturerRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ManufacturerRepo {
+	mock := &ManufacturerRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
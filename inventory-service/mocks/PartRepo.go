@@ -7,6 +7,8 @@ import (
 	inventorypb "inventory-service/grpc/inventorypb"
 
 	mock "github.com/stretchr/testify/mock"
+
+	model "inventory-service/internal/model"
 )
 
 // PartRepo is an autogenerated mock type for the PartRepo type
@@ -14,6 +16,60 @@ type PartRepo struct {
 	mock.Mock
 }
 
+// AddPriceChange provides a mock function with given fields: ctx, uuid, change
+func (_m *PartRepo) AddPriceChange(ctx context.Context, uuid string, change model.PriceChange) error {
+	ret := _m.Called(ctx, uuid, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPriceChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PriceChange) error); ok {
+		r0 = rf(ctx, uuid, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearStockAlerts provides a mock function with given fields: ctx, keep
+func (_m *PartRepo) ClearStockAlerts(ctx context.Context, keep []string) error {
+	ret := _m.Called(ctx, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearStockAlerts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DecrementStock provides a mock function with given fields: ctx, uuid, quantity
+func (_m *PartRepo) DecrementStock(ctx context.Context, uuid string, quantity int64) error {
+	ret := _m.Called(ctx, uuid, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecrementStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, uuid, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, uuid
 func (_m *PartRepo) Get(ctx context.Context, uuid string) (*inventorypb.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -44,6 +100,24 @@ func (_m *PartRepo) Get(ctx context.Context, uuid string) (*inventorypb.Part, er
 	return r0, r1
 }
 
+// IncrementStock provides a mock function with given fields: ctx, uuid, quantity
+func (_m *PartRepo) IncrementStock(ctx context.Context, uuid string, quantity int64) error {
+	ret := _m.Called(ctx, uuid, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, uuid, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // List provides a mock function with given fields: ctx, filter
 func (_m *PartRepo) List(ctx context.Context, filter *inventorypb.PartsFilter) ([]*inventorypb.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -74,6 +148,174 @@ func (_m *PartRepo) List(ctx context.Context, filter *inventorypb.PartsFilter) (
 	return r0, r1
 }
 
+// ListLowStock provides a mock function with given fields: ctx
+func (_m *PartRepo) ListLowStock(ctx context.Context) ([]model.Part, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLowStock")
+	}
+
+	var r0 []model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Part, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Part); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceHistory provides a mock function with given fields: ctx, uuids
+func (_m *PartRepo) PriceHistory(ctx context.Context, uuids []string) (map[string][]*inventorypb.PriceChange, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceHistory")
+	}
+
+	var r0 map[string][]*inventorypb.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*inventorypb.PriceChange, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*inventorypb.PriceChange); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*inventorypb.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCategory provides a mock function with given fields: ctx, uuid, categoryID, legacy, metadata
+func (_m *PartRepo) SetCategory(ctx context.Context, uuid string, categoryID string, legacy int32, metadata map[string]string) error {
+	ret := _m.Called(ctx, uuid, categoryID, legacy, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, map[string]string) error); ok {
+		r0 = rf(ctx, uuid, categoryID, legacy, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLegacyCategory provides a mock function with given fields: ctx, categoryIDs, legacy
+func (_m *PartRepo) SetLegacyCategory(ctx context.Context, categoryIDs []string, legacy int32) error {
+	ret := _m.Called(ctx, categoryIDs, legacy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLegacyCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int32) error); ok {
+		r0 = rf(ctx, categoryIDs, legacy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetManufacturer provides a mock function with given fields: ctx, uuid, m
+func (_m *PartRepo) SetManufacturer(ctx context.Context, uuid string, m model.Manufacturer) error {
+	ret := _m.Called(ctx, uuid, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetManufacturer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.Manufacturer) error); ok {
+		r0 = rf(ctx, uuid, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetReorderLevels provides a mock function with given fields: ctx, uuid, threshold, target
+func (_m *PartRepo) SetReorderLevels(ctx context.Context, uuid string, threshold int64, target int64) error {
+	ret := _m.Called(ctx, uuid, threshold, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReorderLevels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) error); ok {
+		r0 = rf(ctx, uuid, threshold, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetStockAlert provides a mock function with given fields: ctx, uuid, alert
+func (_m *PartRepo) SetStockAlert(ctx context.Context, uuid string, alert model.StockAlert) error {
+	ret := _m.Called(ctx, uuid, alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStockAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.StockAlert) error); ok {
+		r0 = rf(ctx, uuid, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateManufacturerView provides a mock function with given fields: ctx, m
+func (_m *PartRepo) UpdateManufacturerView(ctx context.Context, m model.Manufacturer) error {
+	ret := _m.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManufacturerView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Manufacturer) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewPartRepo creates a new instance of PartRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartRepo(t interface {
@@ -0,0 +1,125 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "inventory-service/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PurchaseOrderRepo is an autogenerated mock type for the PurchaseOrderRepo type
+type PurchaseOrderRepo struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, po
+func (_m *PurchaseOrderRepo) Create(ctx context.Context, po *model.PurchaseOrder) error {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrder) error); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, uuid
+func (_m *PurchaseOrderRepo) Get(ctx context.Context, uuid string) (*model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.PurchaseOrder, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PurchaseOrder); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOpen provides a mock function with given fields: ctx, partUUIDs
+func (_m *PurchaseOrderRepo) ListOpen(ctx context.Context, partUUIDs []string) ([]model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, partUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOpen")
+	}
+
+	var r0 []model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.PurchaseOrder, error)); ok {
+		return rf(ctx, partUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.PurchaseOrder); ok {
+		r0 = rf(ctx, partUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, partUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, po
+func (_m *PurchaseOrderRepo) Update(ctx context.Context, po *model.PurchaseOrder) error {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PurchaseOrder) error); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPurchaseOrderRepo creates a new instance of PurchaseOrderRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurchaseOrderRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurchaseOrderRepo {
+	mock := &PurchaseOrderRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+package repo
+
+import (
+	"context"
+	"inventory-service/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CategoryRepo interface {
+	// Create and Update return model.ErrCategoryExists when the parent
+	// already has a child of the same name.
+	Create(ctx context.Context, c *model.PartCategory) error
+	Get(ctx context.Context, id string) (*model.PartCategory, error)
+	Update(ctx context.Context, c *model.PartCategory) error
+	Delete(ctx context.Context, id string) error
+	// List returns the whole tree, ordered by name.
+	List(ctx context.Context) ([]model.PartCategory, error)
+}
+
+type MongoCategoryRepo struct {
+	col *mongo.Collection
+}
+
+func NewMongoCategoryRepo(col *mongo.Collection) *MongoCategoryRepo {
+	return &MongoCategoryRepo{
+		col: col,
+	}
+}
+
+// EnsureIndexes creates the unique indexes on id and on the name within a
+// parent.
+func (r *MongoCategoryRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "parent_id", Value: 1}, {Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+	})
+	return err
+}
+
+func (r *MongoCategoryRepo) Create(ctx context.Context, c *model.PartCategory) error {
+	_, err := r.col.InsertOne(ctx, c)
+	if mongo.IsDuplicateKeyError(err) {
+		return model.ErrCategoryExists
+	}
+	return err
+}
+
+func (r *MongoCategoryRepo) Get(ctx context.Context, id string) (*model.PartCategory, error) {
+	var c model.PartCategory
+	if err := r.col.FindOne(ctx, bson.M{"id": id}).Decode(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *MongoCategoryRepo) Update(ctx context.Context, c *model.PartCategory) error {
+	res, err := r.col.ReplaceOne(ctx, bson.M{"id": c.ID}, c)
+	if mongo.IsDuplicateKeyError(err) {
+		return model.ErrCategoryExists
+	}
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoCategoryRepo) Delete(ctx context.Context, id string) error {
+	res, err := r.col.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoCategoryRepo) List(ctx context.Context) ([]model.PartCategory, error) {
+	cur, err := r.col.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var categories []model.PartCategory
+	if err := cur.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"context"
+	"inventory-service/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ManufacturerFilter struct {
+	Countries             []string
+	CertificationStatuses []int32
+}
+
+type ManufacturerRepo interface {
+	// Create and Update return model.ErrManufacturerExists when another
+	// manufacturer has the same normalized name.
+	Create(ctx context.Context, m *model.Manufacturer) error
+	Get(ctx context.Context, id string) (*model.Manufacturer, error)
+	Update(ctx context.Context, m *model.Manufacturer) error
+	Delete(ctx context.Context, id string) error
+	// List returns the manufacturers ordered by name.
+	List(ctx context.Context, filter ManufacturerFilter) ([]model.Manufacturer, error)
+}
+
+type MongoManufacturerRepo struct {
+	col *mongo.Collection
+}
+
+func NewMongoManufacturerRepo(col *mongo.Collection) *MongoManufacturerRepo {
+	return &MongoManufacturerRepo{
+		col: col,
+	}
+}
+
+// EnsureIndexes creates the unique indexes on id and the normalized name.
+func (r *MongoManufacturerRepo) EnsureIndexes(ctx context.Context) error {
+	_, err := r.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.M{"id": 1}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.M{"normalized_name": 1}, Options: options.Index().SetUnique(true)},
+	})
+	return err
+}
+
+func (r *MongoManufacturerRepo) Create(ctx context.Context, m *model.Manufacturer) error {
+	_, err := r.col.InsertOne(ctx, m)
+	if mongo.IsDuplicateKeyError(err) {
+		return model.ErrManufacturerExists
+	}
+	return err
+}
+
+func (r *MongoManufacturerRepo) Get(ctx context.Context, id string) (*model.Manufacturer, error) {
+	var m model.Manufacturer
+	if err := r.col.FindOne(ctx, bson.M{"id": id}).Decode(&m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *MongoManufacturerRepo) Update(ctx context.Context, m *model.Manufacturer) error {
+	res, err := r.col.ReplaceOne(ctx, bson.M{"id": m.ID}, m)
+	if mongo.IsDuplicateKeyError(err) {
+		return model.ErrManufacturerExists
+	}
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoManufacturerRepo) Delete(ctx context.Context, id string) error {
+	res, err := r.col.DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoManufacturerRepo) List(ctx context.Context, filter ManufacturerFilter) ([]model.Manufacturer, error) {
+	filterBson := bson.M{}
+	if len(filter.Countries) > 0 {
+		filterBson["country"] = bson.M{"$in": filter.Countries}
+	}
+	if len(filter.CertificationStatuses) > 0 {
+		filterBson["certification_status"] = bson.M{"$in": filter.CertificationStatuses}
+	}
+	cur, err := r.col.Find(ctx, filterBson, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var manufacturers []model.Manufacturer
+	if err := cur.All(ctx, &manufacturers); err != nil {
+		return nil, err
+	}
+	return manufacturers, nil
+}
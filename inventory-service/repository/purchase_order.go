@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"inventory-service/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PurchaseOrderRepo interface {
+	Create(ctx context.Context, po *model.PurchaseOrder) error
+	Get(ctx context.Context, uuid string) (*model.PurchaseOrder, error)
+	// Update replaces the order if it is still at po.Version and bumps the
+	// version; model.ErrPurchaseOrderChanged is returned otherwise.
+	Update(ctx context.Context, po *model.PurchaseOrder) error
+	// ListOpen returns the orders that are not yet closed, oldest first,
+	// limited to the ones with a line for any of partUUIDs if given.
+	ListOpen(ctx context.Context, partUUIDs []string) ([]model.PurchaseOrder, error)
+}
+
+type MongoPurchaseOrderRepo struct {
+	col *mongo.Collection
+}
+
+func NewMongoPurchaseOrderRepo(col *mongo.Collection) *MongoPurchaseOrderRepo {
+	return &MongoPurchaseOrderRepo{
+		col: col,
+	}
+}
+
+func (r *MongoPurchaseOrderRepo) Create(ctx context.Context, po *model.PurchaseOrder) error {
+	_, err := r.col.InsertOne(ctx, po)
+	return err
+}
+
+func (r *MongoPurchaseOrderRepo) Get(ctx context.Context, uuid string) (*model.PurchaseOrder, error) {
+	var po model.PurchaseOrder
+	if err := r.col.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&po); err != nil {
+		return nil, err
+	}
+	return &po, nil
+}
+
+func (r *MongoPurchaseOrderRepo) Update(ctx context.Context, po *model.PurchaseOrder) error {
+	next := *po
+	next.Version++
+	res, err := r.col.ReplaceOne(ctx, bson.M{"uuid": po.UUID, "version": po.Version}, next)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return model.ErrPurchaseOrderChanged
+	}
+	po.Version = next.Version
+	return nil
+}
+
+func (r *MongoPurchaseOrderRepo) ListOpen(ctx context.Context, partUUIDs []string) ([]model.PurchaseOrder, error) {
+	filter := bson.M{"status": bson.M{"$in": bson.A{model.PurchaseOrderOpen, model.PurchaseOrderPartiallyReceived}}}
+	if len(partUUIDs) > 0 {
+		filter["lines.part_uuid"] = bson.M{"$in": partUUIDs}
+	}
+	cur, err := r.col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var orders []model.PurchaseOrder
+	if err := cur.All(ctx, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
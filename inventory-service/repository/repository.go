@@ -5,14 +5,40 @@ import (
 	"inventory-service/grpc/inventorypb"
 	"inventory-service/internal/converter"
 	"inventory-service/internal/model"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type PartRepo interface {
 	Get(ctx context.Context, uuid string) (*inventorypb.Part, error)
 	List(ctx context.Context, filter *inventorypb.PartsFilter) ([]*inventorypb.Part, error)
+	DecrementStock(ctx context.Context, uuid string, quantity int64) error
+	IncrementStock(ctx context.Context, uuid string, quantity int64) error
+	AddPriceChange(ctx context.Context, uuid string, change model.PriceChange) error
+	// PriceHistory returns the recorded price changes of the given parts
+	// ordered by effective_from. Parts without changes are left out.
+	PriceHistory(ctx context.Context, uuids []string) (map[string][]*inventorypb.PriceChange, error)
+	SetReorderLevels(ctx context.Context, uuid string, threshold, target int64) error
+	// ListLowStock returns the parts that are out of stock or at or below
+	// their reorder threshold, kits excluded.
+	ListLowStock(ctx context.Context) ([]model.Part, error)
+	SetStockAlert(ctx context.Context, uuid string, alert model.StockAlert) error
+	// ClearStockAlerts forgets the alerts sent for every part except keep,
+	// so a part that was restocked is reported again when it runs low.
+	ClearStockAlerts(ctx context.Context, keep []string) error
+	// SetManufacturer links the part to m and copies its view into the part.
+	SetManufacturer(ctx context.Context, uuid string, m model.Manufacturer) error
+	// UpdateManufacturerView refreshes the copy of m in every part linked to it.
+	UpdateManufacturerView(ctx context.Context, m model.Manufacturer) error
+	// SetCategory moves the part to a category node, setting the legacy
+	// category derived from it. A nil metadata keeps the part metadata.
+	SetCategory(ctx context.Context, uuid, categoryID string, legacy int32, metadata map[string]string) error
+	// SetLegacyCategory sets the legacy category of all parts in the given
+	// category nodes.
+	SetLegacyCategory(ctx context.Context, categoryIDs []string, legacy int32) error
 }
 
 type MongoRepo struct {
@@ -63,6 +89,14 @@ func (r *MongoRepo) List(ctx context.Context, filter *inventorypb.PartsFilter) (
 			filterBson["tags"] = bson.M{"$in": filter.Tags}
 		}
 
+		if len(filter.ManufacturerIds) > 0 {
+			filterBson["manufacturer_id"] = bson.M{"$in": filter.ManufacturerIds}
+		}
+
+		if len(filter.CategoryIds) > 0 {
+			filterBson["category_id"] = bson.M{"$in": filter.CategoryIds}
+		}
+
 	}
 
 	cur, err := r.col.Find(ctx, filterBson)
@@ -91,3 +125,183 @@ func (r *MongoRepo) List(ctx context.Context, filter *inventorypb.PartsFilter) (
 	}
 	return parts, nil
 }
+
+// DecrementStock takes quantity out of the part stock. The update is
+// conditional, so the stock never goes below zero: model.ErrNotEnoughStock is
+// returned when the part is missing or has less than quantity in stock.
+func (r *MongoRepo) DecrementStock(ctx context.Context, uuid string, quantity int64) error {
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"uuid": uuid, "stock_quantity": bson.M{"$gte": quantity}},
+		bson.M{
+			"$inc": bson.M{"stock_quantity": -quantity},
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return model.ErrNotEnoughStock
+	}
+	return nil
+}
+
+func (r *MongoRepo) IncrementStock(ctx context.Context, uuid string, quantity int64) error {
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"uuid": uuid},
+		bson.M{
+			"$inc": bson.M{"stock_quantity": quantity},
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRepo) AddPriceChange(ctx context.Context, uuid string, change model.PriceChange) error {
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"uuid": uuid},
+		bson.M{
+			"$push": bson.M{"price_history": change},
+			"$set":  bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRepo) PriceHistory(ctx context.Context, uuids []string) (map[string][]*inventorypb.PriceChange, error) {
+	cur, err := r.col.Find(ctx,
+		bson.M{"uuid": bson.M{"$in": uuids}, "price_history.0": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"uuid": 1, "price_history": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	res := make(map[string][]*inventorypb.PriceChange)
+	for cur.Next(ctx) {
+		var p model.Part
+		if err := cur.Decode(&p); err != nil {
+			return nil, err
+		}
+		res[p.UUID] = converter.PriceHistoryToProto(p.PriceHistory)
+	}
+	return res, cur.Err()
+}
+
+func (r *MongoRepo) SetReorderLevels(ctx context.Context, uuid string, threshold, target int64) error {
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"uuid": uuid},
+		bson.M{"$set": bson.M{
+			"reorder_threshold": threshold,
+			"target_level":      target,
+			"updated_at":        time.Now(),
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRepo) ListLowStock(ctx context.Context) ([]model.Part, error) {
+	cur, err := r.col.Find(ctx, bson.M{
+		"kit": bson.M{"$exists": false},
+		"$or": bson.A{
+			bson.M{"stock_quantity": bson.M{"$lte": 0}},
+			bson.M{"$expr": bson.M{"$lte": bson.A{"$stock_quantity", "$reorder_threshold"}}},
+		},
+	}, options.Find().SetSort(bson.M{"uuid": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var parts []model.Part
+	if err := cur.All(ctx, &parts); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+func (r *MongoRepo) SetStockAlert(ctx context.Context, uuid string, alert model.StockAlert) error {
+	_, err := r.col.UpdateOne(ctx, bson.M{"uuid": uuid}, bson.M{"$set": bson.M{"stock_alert": alert}})
+	return err
+}
+
+func (r *MongoRepo) ClearStockAlerts(ctx context.Context, keep []string) error {
+	filter := bson.M{"stock_alert": bson.M{"$exists": true}}
+	if len(keep) > 0 {
+		filter["uuid"] = bson.M{"$nin": keep}
+	}
+	_, err := r.col.UpdateMany(ctx, filter, bson.M{"$unset": bson.M{"stock_alert": ""}})
+	return err
+}
+
+func (r *MongoRepo) SetManufacturer(ctx context.Context, uuid string, m model.Manufacturer) error {
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"uuid": uuid},
+		bson.M{"$set": bson.M{
+			"manufacturer_id": m.ID,
+			"manufacter":      m.View(),
+			"updated_at":      time.Now(),
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRepo) UpdateManufacturerView(ctx context.Context, m model.Manufacturer) error {
+	_, err := r.col.UpdateMany(ctx,
+		bson.M{"manufacturer_id": m.ID},
+		bson.M{"$set": bson.M{"manufacter": m.View()}},
+	)
+	return err
+}
+
+func (r *MongoRepo) SetCategory(ctx context.Context, uuid, categoryID string, legacy int32, metadata map[string]string) error {
+	set := bson.M{
+		"category_id": categoryID,
+		"category":    legacy,
+		"updated_at":  time.Now(),
+	}
+	if metadata != nil {
+		set["metadata"] = metadata
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"uuid": uuid}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *MongoRepo) SetLegacyCategory(ctx context.Context, categoryIDs []string, legacy int32) error {
+	_, err := r.col.UpdateMany(ctx,
+		bson.M{"category_id": bson.M{"$in": categoryIDs}},
+		bson.M{"$set": bson.M{"category": legacy}},
+	)
+	return err
+}
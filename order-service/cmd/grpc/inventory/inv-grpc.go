@@ -2,8 +2,13 @@ package inventorygrpc
 
 import (
 	"context"
+	"fmt"
 	"inventory-service/grpc/inventorypb"
 	"order-service/internal/repository/model"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCClient struct {
@@ -26,11 +31,89 @@ func (g *GRPCClient) ListParts(ctx context.Context, partIDs []string) ([]*model.
 	parts := make([]*model.Part, len(resp.Parts))
 	for i, v := range resp.Parts {
 		parts[i] = &model.Part{
-			Quantity: int(v.StockQuantity),
-			UUID:     v.Uuid,
-			Name:     v.Name,
-			Price:    v.Price,
+			Quantity:            int(v.StockQuantity),
+			UUID:                v.Uuid,
+			Name:                v.Name,
+			Price:               v.Price,
+			Category:            strings.TrimPrefix(v.Category.String(), "CATEGORY_"),
+			ManufacturerCountry: v.Manufacter.GetCountry(),
+		}
+		if d := v.Dimensions; d != nil {
+			parts[i].Dimensions = &model.Dimensions{
+				Length: d.Length,
+				Width:  d.Width,
+				Height: d.Height,
+				Weight: d.Weight,
+			}
 		}
 	}
 	return parts, nil
 }
+
+func (g *GRPCClient) ReserveStock(ctx context.Context, items []model.Item) error {
+	_, err := g.client.ReserveStock(ctx, &inventorypb.ReserveStockRequest{
+		Items: stockItems(items),
+	})
+	return stockError(err)
+}
+
+func (g *GRPCClient) ReleaseStock(ctx context.Context, items []model.Item) error {
+	_, err := g.client.ReleaseStock(ctx, &inventorypb.ReleaseStockRequest{
+		Items: stockItems(items),
+	})
+	return stockError(err)
+}
+
+func (g *GRPCClient) ValidateConfiguration(ctx context.Context, items []model.Item) ([]model.ConfigurationViolation, error) {
+	resp, err := g.client.ValidateConfiguration(ctx, &inventorypb.ValidateConfigurationRequest{
+		Items: stockItems(items),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.ConfigurationViolation, len(resp.Violations))
+	for i, v := range resp.Violations {
+		res[i] = model.ConfigurationViolation{
+			PartUUID:       v.PartUuid,
+			Rule:           ruleName(v.Rule),
+			TargetPartUUID: v.TargetPartUuid,
+			Message:        v.Message,
+		}
+		if v.TargetCategory != inventorypb.Category_CATEGORY_UNKNOWN {
+			res[i].TargetCategory = strings.TrimPrefix(v.TargetCategory.String(), "CATEGORY_")
+		}
+	}
+	return res, nil
+}
+
+func ruleName(rule inventorypb.CompatibilityRuleType) string {
+	if rule == inventorypb.CompatibilityRuleType_COMPATIBILITY_RULE_UNKNOWN {
+		return model.RuleUnknownPart
+	}
+	return strings.TrimPrefix(rule.String(), "COMPATIBILITY_RULE_")
+}
+
+func stockItems(items []model.Item) []*inventorypb.StockItem {
+	res := make([]*inventorypb.StockItem, len(items))
+	for i, v := range items {
+		res[i] = &inventorypb.StockItem{
+			Uuid:     v.PartUUID,
+			Quantity: int64(v.Quantity),
+		}
+	}
+	return res
+}
+
+func stockError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", model.ErrNotEnoughInStock, status.Convert(err).Message())
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", model.ErrNotFound, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
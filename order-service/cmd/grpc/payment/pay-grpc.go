@@ -5,6 +5,13 @@ import (
 	"fmt"
 	"order-service/internal/repository/model"
 	"payment-service/grpc/paymentpb"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GRPCClient struct {
@@ -17,21 +24,231 @@ func New(client paymentpb.PaymentServiceClient) *GRPCClient {
 	}
 }
 
-func (g *GRPCClient) MakePayment(ctx context.Context, orderID, userID string, pm *model.PaymentMethod) (string, error) {
-	if pm == nil {
-		return "", fmt.Errorf("payment method is required")
-	}
-	pbValue, ok := paymentpb.PaymentMethod_value[string(*pm)]
-	if !ok {
-		pbValue = int32(paymentpb.PaymentMethod_UNKNOWN)
+func (g *GRPCClient) MakePayment(ctx context.Context, orderID, userID string, amount float64, pm *model.PaymentMethod, idempotencyKey string) (*model.Payment, error) {
+	method, err := paymentMethod(pm)
+	if err != nil {
+		return nil, err
 	}
 	resp, err := g.client.PayOrder(ctx, &paymentpb.PayOrderRequest{
+		OrderUuid:      orderID,
+		UserUuid:       userID,
+		PaymentMethod:  method,
+		Amount:         amount,
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	payment := &model.Payment{TransactionUUID: resp.TransactionUuid, State: model.PaymentSucceeded}
+	switch resp.Status {
+	case paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		payment.State = model.PaymentPending
+		payment.PaymentURL = resp.ProviderReference
+	case paymentpb.TransactionStatus_TRANSACTION_STATUS_REVIEW:
+		// Held for a manual review; the order waits like for a pending
+		// payment, just without a payment link.
+		payment.State = model.PaymentPending
+	}
+	if payment.State == model.PaymentPending && resp.ExpiresAt != nil {
+		payment.ExpiresAt = resp.ExpiresAt.AsTime()
+	}
+	return payment, nil
+}
+
+func (g *GRPCClient) PaymentStatus(ctx context.Context, transactionID string) (*model.Payment, error) {
+	resp, err := g.client.GetPaymentStatus(ctx, &paymentpb.GetPaymentStatusRequest{
+		TransactionUuid: transactionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	payment := &model.Payment{
+		TransactionUUID: resp.TransactionUuid,
+		PaymentURL:      resp.ProviderReference,
+		FailureCode:     resp.FailureCode,
+	}
+	if resp.ExpiresAt != nil {
+		payment.ExpiresAt = resp.ExpiresAt.AsTime()
+	}
+	switch resp.Status {
+	case paymentpb.TransactionStatus_TRANSACTION_STATUS_PAID:
+		payment.State = model.PaymentSucceeded
+	case paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING, paymentpb.TransactionStatus_TRANSACTION_STATUS_REVIEW:
+		payment.State = model.PaymentPending
+	case paymentpb.TransactionStatus_TRANSACTION_STATUS_FAILED, paymentpb.TransactionStatus_TRANSACTION_STATUS_EXPIRED,
+		paymentpb.TransactionStatus_TRANSACTION_STATUS_REJECTED:
+		payment.State = model.PaymentFailed
+	default:
+		return nil, fmt.Errorf("transaction %s is %s, not a payment", transactionID, resp.Status)
+	}
+	return payment, nil
+}
+
+func (g *GRPCClient) Refund(ctx context.Context, transactionID, orderID, userID, reason string) (string, error) {
+	resp, err := g.client.RefundPayment(ctx, &paymentpb.RefundPaymentRequest{
+		TransactionUuid: transactionID,
+		OrderUuid:       orderID,
+		UserUuid:        userID,
+		Reason:          reason,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.RefundTransactionUuid, nil
+}
+
+func (g *GRPCClient) Authorize(ctx context.Context, orderID, userID string, amount float64, pm *model.PaymentMethod) (*model.Authorization, error) {
+	method, err := paymentMethod(pm)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.client.AuthorizePayment(ctx, &paymentpb.AuthorizePaymentRequest{
 		OrderUuid:     orderID,
 		UserUuid:      userID,
-		PaymentMethod: paymentpb.PaymentMethod(pbValue),
+		PaymentMethod: method,
+		Amount:        amount,
 	})
 	if err != nil {
-		return "", err
+		return nil, paymentError(err)
+	}
+	return &model.Authorization{
+		TransactionUUID: resp.TransactionUuid,
+		ExpiresAt:       resp.ExpiresAt.AsTime(),
+	}, nil
+}
+
+func (g *GRPCClient) Capture(ctx context.Context, transactionID string, amount float64) (float64, error) {
+	resp, err := g.client.CapturePayment(ctx, &paymentpb.CapturePaymentRequest{
+		TransactionUuid: transactionID,
+		Amount:          amount,
+	})
+	if err != nil {
+		return 0, captureError(err)
+	}
+	return resp.CapturedAmount, nil
+}
+
+func (g *GRPCClient) Void(ctx context.Context, transactionID, reason string) error {
+	_, err := g.client.VoidAuthorization(ctx, &paymentpb.VoidAuthorizationRequest{
+		TransactionUuid: transactionID,
+		Reason:          reason,
+	})
+	return err
+}
+
+// ListTransactions pages through the transactions payment-service made in
+// [from, to).
+func (g *GRPCClient) ListTransactions(ctx context.Context, from, to time.Time) ([]model.PaymentTransaction, error) {
+	req := &paymentpb.ListTransactionsRequest{
+		CreatedFrom: timestamppb.New(from),
+		CreatedTo:   timestamppb.New(to),
+		PageSize:    1000,
+	}
+	var txs []model.PaymentTransaction
+	for {
+		resp, err := g.client.ListTransactions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range resp.Transactions {
+			txs = append(txs, model.PaymentTransaction{
+				TransactionUUID: tx.TransactionUuid,
+				OrderUUID:       tx.OrderUuid,
+				UserUUID:        tx.UserUuid,
+				Method:          model.PaymentMethod(tx.PaymentMethod.String()),
+				Status:          transactionStatus(tx.Status),
+				Amount:          tx.Amount,
+				CapturedAmount:  tx.CapturedAmount,
+				RefundUUID:      tx.RefundTransactionUuid,
+				CreatedAt:       tx.CreatedAt.AsTime(),
+			})
+		}
+		if resp.NextPageToken == "" {
+			return txs, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+func (g *GRPCClient) Transaction(ctx context.Context, transactionID string) (*model.PaymentTransaction, error) {
+	resp, err := g.client.GetPaymentStatus(ctx, &paymentpb.GetPaymentStatusRequest{
+		TransactionUuid: transactionID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: %s", model.ErrNotFound, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return &model.PaymentTransaction{
+		TransactionUUID: resp.TransactionUuid,
+		OrderUUID:       resp.OrderUuid,
+		UserUUID:        resp.UserUuid,
+		Method:          model.PaymentMethod(resp.PaymentMethod.String()),
+		Status:          transactionStatus(resp.Status),
+		Amount:          resp.Amount,
+		CapturedAmount:  resp.CapturedAmount,
+		RefundUUID:      resp.RefundTransactionUuid,
+		CreatedAt:       resp.CreatedAt.AsTime(),
+	}, nil
+}
+
+// transactionStatus is the payment-service status without the enum prefix.
+func transactionStatus(s paymentpb.TransactionStatus) string {
+	return strings.TrimPrefix(s.String(), "TRANSACTION_STATUS_")
+}
+
+func paymentMethod(pm *model.PaymentMethod) (paymentpb.PaymentMethod, error) {
+	if pm == nil {
+		return paymentpb.PaymentMethod_UNKNOWN, fmt.Errorf("payment method is required")
+	}
+	pbValue, ok := paymentpb.PaymentMethod_value[string(*pm)]
+	if !ok {
+		pbValue = int32(paymentpb.PaymentMethod_UNKNOWN)
+	}
+	return paymentpb.PaymentMethod(pbValue), nil
+}
+
+// refusalReasons are the ErrorInfo reasons of payments the provider or the
+// risk checks refused to make; they are reported as
+// model.PaymentRefusedError.
+var refusalReasons = map[string]bool{
+	"DECLINED":           true,
+	"INSUFFICIENT_FUNDS": true,
+	"LIMIT_EXCEEDED":     true,
+	"RISK_REJECTED":      true,
+}
+
+// paymentError turns a payment refused by the provider into
+// model.PaymentRefusedError, keeping the reason payment-service gives, and
+// a conflicting payment of the order into model.ErrConflict.
+func paymentError(err error) error {
+	st := status.Convert(err)
+	if st.Code() == codes.AlreadyExists {
+		return fmt.Errorf("%w: %s", model.ErrConflict, st.Message())
+	}
+	if st.Code() != codes.FailedPrecondition {
+		return err
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && refusalReasons[info.Reason] {
+			return &model.PaymentRefusedError{Reason: info.Reason, Message: st.Message()}
+		}
+	}
+	return err
+}
+
+// reasonAuthorizationExpired is the ErrorInfo reason payment-service reports
+// for a capture after the authorization expired.
+const reasonAuthorizationExpired = "AUTHORIZATION_EXPIRED"
+
+// captureError turns the expiry reported by payment-service into
+// model.ErrAuthorizationExpired.
+func captureError(err error) error {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == reasonAuthorizationExpired {
+			return fmt.Errorf("%w: %v", model.ErrAuthorizationExpired, err)
+		}
 	}
-	return resp.TransactionUuid, nil
+	return err
 }
@@ -0,0 +1,39 @@
+package paymentgrpc
+
+import (
+	"context"
+	"order-service/internal/repository/model"
+	"payment-service/grpc/paymentpb"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// paymentClient records the PayOrder requests it gets; calling any other
+// method panics.
+type paymentClient struct {
+	paymentpb.PaymentServiceClient
+	requests []*paymentpb.PayOrderRequest
+}
+
+func (c *paymentClient) PayOrder(_ context.Context, req *paymentpb.PayOrderRequest, _ ...grpc.CallOption) (*paymentpb.PayOrderResponse, error) {
+	c.requests = append(c.requests, req)
+	return &paymentpb.PayOrderResponse{
+		TransactionUuid: "tx-1",
+		Status:          paymentpb.TransactionStatus_TRANSACTION_STATUS_PAID,
+	}, nil
+}
+
+func TestMakePayment_sendsIdempotencyKey(t *testing.T) {
+	client := &paymentClient{}
+	pm := model.PaymentCard
+
+	payment, err := New(client).MakePayment(context.Background(), "order-1", "user-1", 300, &pm, "order-1-1790856000000000")
+	require.NoError(t, err)
+	assert.Equal(t, "tx-1", payment.TransactionUUID)
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "order-1-1790856000000000", client.requests[0].IdempotencyKey)
+	assert.Equal(t, paymentpb.PaymentMethod_CARD, client.requests[0].PaymentMethod)
+}
@@ -86,7 +86,7 @@ func (c *PaymentClient) Close() error {
 	return c.conn.Close()
 }
 
-func (c *PaymentClient) MakePayment(ctx context.Context, orderUuid, userUuid string, pm *model.PaymentMethod) (string, error) {
+func (c *PaymentClient) MakePayment(ctx context.Context, orderUuid, userUuid string, amount float64, pm *model.PaymentMethod) (string, error) {
 	if pm == nil {
 		return "", fmt.Errorf("payment method is required")
 	}
@@ -96,6 +96,7 @@ func (c *PaymentClient) MakePayment(ctx context.Context, orderUuid, userUuid str
 		OrderUuid:     orderUuid,
 		UserUuid:      userUuid,
 		PaymentMethod: paymentpb.PaymentMethod(pmProto),
+		Amount:        amount,
 	}
 	resp, err := c.client.PayOrder(ctx, req)
 	if err != nil {
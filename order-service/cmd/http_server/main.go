@@ -11,8 +11,15 @@ import (
 	"order-service/internal/handlers"
 	"order-service/internal/migrator"
 	api "order-service/internal/oapi"
+	cartrepo "order-service/internal/repository/cart"
 	repository "order-service/internal/repository/order"
+	promorepo "order-service/internal/repository/promo"
+	quoterepo "order-service/internal/repository/quote"
+	"order-service/internal/service/cart"
 	"order-service/internal/service/order"
+	"order-service/internal/service/promo"
+	"order-service/internal/service/shipping"
+	"order-service/internal/service/tax"
 	"payment-service/grpc/paymentpb"
 
 	"os"
@@ -67,9 +74,65 @@ func main() {
 
 	invService := inventorygrpc.New(inventorypb.NewInventoryServiceClient(invConn))
 	payService := paymentgrpc.New(paymentpb.NewPaymentServiceClient(payConn))
-	orderService := order.NewService(repo, invService, payService)
+	promoRepo := promorepo.NewRepository(pool)
+	opts := []order.Option{
+		order.WithPromotions(promoRepo),
+	}
+	if path := os.Getenv("TAX_CONFIG_PATH"); path != "" {
+		taxCfg, err := tax.LoadConfig(path)
+		if err != nil {
+			log.Fatalf("не удалось загрузить налоговую конфигурацию: %v", err)
+		}
+		opts = append(opts, order.WithTaxes(tax.NewEngine(taxCfg)))
+	} else {
+		log.Println("TAX_CONFIG_PATH не задан, налоги не начисляются")
+	}
+	if dir := os.Getenv("CARRIERS_CONFIG_DIR"); dir != "" {
+		carriers, err := shipping.LoadCarriers(dir)
+		if err != nil {
+			log.Fatalf("не удалось загрузить тарифы перевозчиков: %v", err)
+		}
+		calc, err := shipping.NewCalculator(carriers)
+		if err != nil {
+			log.Fatalf("некорректные тарифы перевозчиков: %v", err)
+		}
+		opts = append(opts, order.WithShipping(calc))
+	} else {
+		log.Println("CARRIERS_CONFIG_DIR не задан, доставка недоступна")
+	}
+	quoteTTL := 72 * time.Hour
+	if v := os.Getenv("QUOTE_TTL"); v != "" {
+		quoteTTL, err = time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("некорректный QUOTE_TTL: %v", err)
+		}
+	}
+	opts = append(opts, order.WithQuotes(quoterepo.NewRepository(pool), quoteTTL))
+	if v := os.Getenv("PAYMENT_PENDING_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("некорректный PAYMENT_PENDING_TIMEOUT: %v", err)
+		}
+		opts = append(opts, order.WithPaymentTimeout(timeout))
+	}
+	if os.Getenv("ENFORCE_COMPATIBILITY") == "true" {
+		opts = append(opts, order.WithCompatibility(invService))
+	}
+	orderService := order.NewService(repo, invService, payService, opts...)
+	pollInterval := 30 * time.Second
+	if v := os.Getenv("PAYMENT_POLL_INTERVAL"); v != "" {
+		pollInterval, err = time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("некорректный PAYMENT_POLL_INTERVAL: %v", err)
+		}
+	}
+	pollCtx, stopPoll := context.WithCancel(context.Background())
+	defer stopPoll()
+	go orderService.RunPaymentSync(pollCtx, pollInterval)
 	handler := &handlers.OrderHandler{
 		Service: orderService,
+		Cart:    cart.NewService(cartrepo.NewRepository(pool), invService, orderService),
+		Promos:  promo.NewService(promoRepo),
 	}
 	server, err := api.NewServer(handler)
 	if err != nil {
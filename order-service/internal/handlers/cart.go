@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	api "order-service/internal/oapi"
+	"order-service/internal/repository/model"
+)
+
+func (h *OrderHandler) GetCart(ctx context.Context, params api.GetCartParams) (api.GetCartRes, error) {
+	cart, err := h.Cart.GetCart(ctx, params.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	return cartToAPI(cart), nil
+}
+
+func (h *OrderHandler) AddCartItem(
+	ctx context.Context,
+	req *api.AddCartItemRequest,
+	params api.AddCartItemParams,
+) (api.AddCartItemRes, error) {
+
+	cart, err := h.Cart.AddItem(ctx, params.UserUUID, req.PartUUID, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return cartToAPI(cart), nil
+}
+
+func (h *OrderHandler) UpdateCartItem(
+	ctx context.Context,
+	req *api.UpdateCartItemRequest,
+	params api.UpdateCartItemParams,
+) (api.UpdateCartItemRes, error) {
+
+	cart, err := h.Cart.UpdateItem(ctx, params.UserUUID, params.PartUUID, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return cartToAPI(cart), nil
+}
+
+func (h *OrderHandler) RemoveCartItem(ctx context.Context, params api.RemoveCartItemParams) (api.RemoveCartItemRes, error) {
+	cart, err := h.Cart.RemoveItem(ctx, params.UserUUID, params.PartUUID)
+	if err != nil {
+		return nil, err
+	}
+	return cartToAPI(cart), nil
+}
+
+func (h *OrderHandler) CheckoutCart(
+	ctx context.Context,
+	req api.OptCheckoutRequest,
+	params api.CheckoutCartParams,
+) (api.CheckoutCartRes, error) {
+
+	checkout := model.CheckoutRequest{UserUUID: params.UserUUID}
+	if v, ok := req.Get(); ok {
+		checkout.PromoCode = v.PromoCode.Or("")
+		checkout.BuyerCountry = v.BuyerCountry.Or("")
+		checkout.ShippingOption = v.ShippingOption.Or("")
+		if a, ok := v.DeliveryAddress.Get(); ok {
+			address := addressFromAPI(a)
+			checkout.DeliveryAddress = &address
+		}
+	}
+
+	order, err := h.Cart.Checkout(ctx, checkout)
+	if err != nil {
+		return nil, err
+	}
+	return createOrderResponse(order), nil
+}
+
+func cartToAPI(cart *model.Cart) *api.Cart {
+	lines := make([]api.CartLine, len(cart.Lines))
+	for i, v := range cart.Lines {
+		lines[i] = api.CartLine{
+			PartUUID:      v.PartUUID,
+			Quantity:      v.Quantity,
+			AddedPrice:    v.AddedPrice,
+			Price:         v.Price,
+			Name:          v.Name,
+			StockQuantity: v.StockQuantity,
+			Available:     v.Available,
+			AddedAt:       v.AddedAt,
+		}
+	}
+	warnings := make([]api.CartWarning, len(cart.Warnings))
+	for i, v := range cart.Warnings {
+		warnings[i] = api.CartWarning{
+			PartUUID: v.PartUUID,
+			Code:     api.CartWarningCode(v.Code),
+			Message:  v.Message,
+		}
+	}
+
+	resp := &api.Cart{
+		UserUUID: cart.UserUUID,
+		Version:  cart.Version,
+		Lines:    lines,
+		Subtotal: cart.Subtotal,
+		Warnings: warnings,
+	}
+	if cart.UpdatedAt != nil {
+		resp.UpdatedAt = api.NewOptNilDateTime(*cart.UpdatedAt)
+	}
+	return resp
+}
@@ -4,49 +4,75 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"order-service/internal/oapi"
 	api "order-service/internal/oapi"
 	"order-service/internal/repository/model"
+	"order-service/internal/service/cart"
 	"order-service/internal/service/order"
+	"order-service/internal/service/promo"
 )
 
 type OrderHandler struct {
 	Service *order.Service
+	Cart    *cart.Service
+	Promos  *promo.Service
 }
 
 func (h *OrderHandler) CreateOrder(ctx context.Context, req *api.CreateOrderRequest) (api.CreateOrderRes, error) {
+	orderReq, err := orderRequestFromAPI(req)
+	if err != nil {
+		return nil, err
+	}
+	order, err := h.Service.CreateOrder(ctx, orderReq)
+	if err != nil {
+		return nil, err
+	}
+	return createOrderResponse(order), nil
+}
+
+func orderRequestFromAPI(req *api.CreateOrderRequest) (model.OrderRequest, error) {
 	if len(req.Items) == 0 {
-		return nil,  fmt.Errorf("%w: items required", model.ErrBadRequest)
+		return model.OrderRequest{}, fmt.Errorf("%w: items required", model.ErrBadRequest)
 	}
 	items := make([]model.Item, len(req.Items))
 	if req.UserUUID == "" {
-		return nil, model.ErrBadRequest
+		return model.OrderRequest{}, model.ErrBadRequest
 	}
 
 	for i, v := range req.Items {
-		if v.PartUUID == "" {
-			return nil, fmt.Errorf("%w: part_uuid is required for item %d", model.ErrBadRequest, i)
-		}
-		if v.Quantity <= 0 {
-			return nil, fmt.Errorf("%w: quantity must be greater than 0 for item %d", model.ErrBadRequest, i)
-		}
-
 		items[i] = model.Item{
 			PartUUID: v.PartUUID,
 			Quantity: int(v.Quantity),
 		}
 	}
 
-	order, err := h.Service.CreateOrder(ctx, req.UserUUID, items)
-	if err != nil {
-		return nil, err
+	var address *model.Address
+	if v, ok := req.DeliveryAddress.Get(); ok {
+		a := addressFromAPI(v)
+		address = &a
 	}
-	return &api.CreateOrderResponse{
-		OrderUUID:  order.OrderUUID,
-		TotalPrice: order.TotalPrice,
+
+	return model.OrderRequest{
+		UserUUID:        req.UserUUID,
+		Items:           items,
+		PromoCode:       req.PromoCode.Or(""),
+		BuyerCountry:    req.BuyerCountry.Or(""),
+		ShippingOption:  req.ShippingOption.Or(""),
+		DeliveryAddress: address,
 	}, nil
 }
 
+func createOrderResponse(order *model.Order) *api.CreateOrderResponse {
+	return &api.CreateOrderResponse{
+		OrderUUID:     order.OrderUUID,
+		Subtotal:      order.Subtotal,
+		DiscountTotal: order.DiscountTotal,
+		TaxTotal:      order.TaxTotal,
+		DutyTotal:     order.DutyTotal,
+		ShippingCost:  order.ShippingCost,
+		TotalPrice:    order.TotalPrice,
+	}
+}
+
 func (h *OrderHandler) GetOrder(
 	ctx context.Context,
 	params api.GetOrderParams,
@@ -56,37 +82,98 @@ func (h *OrderHandler) GetOrder(
 	if err != nil {
 		return nil, err
 	}
-	items := make([]oapi.OrderItemsItem, 0, len(order.Items))
+	return orderToAPI(order), nil
+}
 
-	for _, v := range order.Items {
-		items = append(items, api.OrderItemsItem{
-			Quantity: float64(v.Quantity),
+func (h *OrderHandler) UpdateOrderItems(
+	ctx context.Context,
+	req *api.UpdateOrderItemsRequest,
+	params api.UpdateOrderItemsParams,
+) (api.UpdateOrderItemsRes, error) {
+
+	ops := make([]model.ItemOperation, len(req.Operations))
+	for i, v := range req.Operations {
+		ops[i] = model.ItemOperation{
+			Op:       model.ItemOperationType(v.Op),
 			PartUUID: v.PartUUID,
-			Price:    v.Price,
-			Name:     v.Name,
-		})
+			Quantity: int(v.Quantity.Or(0)),
+		}
 	}
 
-	return &api.Order{
-		OrderUUID:  order.OrderUUID,
-		UserUUID:   order.UserUUID,
-		Items:      items,
-		TotalPrice: order.TotalPrice,
-		Status:     api.OrderStatus(order.Status),
-	}, nil
+	order, err := h.Service.ModifyItems(ctx, params.OrderUUID, ops)
+	if err != nil {
+		return nil, err
+	}
+	return orderToAPI(order), nil
+}
+
+func (h *OrderHandler) GetOrderHistory(
+	ctx context.Context,
+	params api.GetOrderHistoryParams,
+) (api.GetOrderHistoryRes, error) {
+
+	history, err := h.Service.GetOrderHistory(ctx, params.OrderUUID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]api.StatusChange, 0, len(history.Changes))
+	for _, v := range history.Changes {
+		entry := api.StatusChange{
+			ToStatus:  api.OrderStatus(v.To),
+			Actor:     v.Actor,
+			Reason:    v.Reason,
+			CreatedAt: v.CreatedAt,
+		}
+		if v.From != "" {
+			entry.FromStatus = api.NewOptNilOrderStatus(api.OrderStatus(v.From))
+		}
+		entries = append(entries, entry)
+	}
+
+	order := history.Order
+	resp := &api.OrderHistory{
+		OrderUUID: order.OrderUUID,
+		Status:    api.OrderStatus(order.Status),
+		CreatedAt: order.CreatedAt,
+		UpdatedAt: order.UpdatedAt,
+		Entries:   entries,
+	}
+	if order.PaidAt != nil {
+		resp.PaidAt = api.NewOptNilDateTime(*order.PaidAt)
+	}
+	if order.CancelledAt != nil {
+		resp.CancelledAt = api.NewOptNilDateTime(*order.CancelledAt)
+	}
+	return resp, nil
 }
 
 func (h *OrderHandler) CancelOrder(
 	ctx context.Context,
+	req api.OptCancelOrderRequest,
 	params api.CancelOrderParams,
 ) (api.CancelOrderRes, error) {
 
-	err := h.Service.CancelOrder(ctx, params.OrderUUID)
+	var reason model.CancelReason
+	var comment string
+	if body, ok := req.Get(); ok {
+		reason = model.CancelReason(body.Reason.Or(""))
+		comment = body.Comment.Or("")
+	}
+
+	order, err := h.Service.CancelOrder(ctx, params.OrderUUID, params.XActor, reason, comment)
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.CancelOrderNoContent{}, nil
+	resp := &api.CancelOrderResponse{
+		OrderUUID: order.OrderUUID,
+		Status:    api.OrderStatus(order.Status),
+		Reason:    api.CancelReason(*order.CancelReason),
+	}
+	if order.RefundUUID != nil {
+		resp.RefundTransactionUUID = api.NewOptNilString(*order.RefundUUID)
+	}
+	return resp, nil
 }
 
 func (h *OrderHandler) PayOrder(
@@ -97,60 +184,369 @@ func (h *OrderHandler) PayOrder(
 
 	pm := model.PaymentMethod(req.PaymentMethod)
 
-	tUid, err := h.Service.PayOrder(ctx, params.OrderUUID, &pm)
+	payment, err := h.Service.PayOrder(ctx, params.OrderUUID, params.XActor, &pm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.PayOrderResponse{
+		TransactionUUID: payment.TransactionUUID,
+		Status:          api.PayOrderResponseStatusPAID,
+	}
+	if payment.State == model.PaymentPending {
+		resp.Status = api.PayOrderResponseStatusPENDING
+		resp.PaymentURL = api.NewOptString(payment.PaymentURL)
+		if !payment.ExpiresAt.IsZero() {
+			resp.ExpiresAt = api.NewOptDateTime(payment.ExpiresAt)
+		}
+	}
+	return resp, nil
+}
+
+// PaymentCallback is called by payment-service when a pending payment is
+// over. The order is finalised with the outcome payment-service reports, not
+// with the one in the request.
+func (h *OrderHandler) PaymentCallback(
+	ctx context.Context,
+	req *api.PaymentCallbackRequest,
+) (api.PaymentCallbackRes, error) {
+
+	order, err := h.Service.GetOrder(ctx, req.OrderUUID)
 	if err != nil {
 		return nil, err
 	}
+	if order.Status == model.StatusPaymentPending &&
+		(order.TransactionUUID == nil || *order.TransactionUUID != req.TransactionUUID) {
+		return nil, fmt.Errorf("%w: transaction %s is not the payment of the order", model.ErrConflict, req.TransactionUUID)
+	}
+	order, err = h.Service.SyncPayment(ctx, req.OrderUUID)
+	if err != nil {
+		return nil, err
+	}
+	return orderToAPI(order), nil
+}
 
-	return &api.PayOrderResponse{
-		TransactionUUID: tUid,
+func (h *OrderHandler) AuthorizeOrder(
+	ctx context.Context,
+	req *api.PayOrderRequest,
+	params api.AuthorizeOrderParams,
+) (api.AuthorizeOrderRes, error) {
+
+	pm := model.PaymentMethod(req.PaymentMethod)
+
+	order, err := h.Service.AuthorizeOrder(ctx, params.OrderUUID, params.XActor, &pm)
+	if err != nil {
+		return nil, err
+	}
+	return orderToAPI(order), nil
+}
+
+func (h *OrderHandler) CaptureOrder(
+	ctx context.Context,
+	req api.OptCaptureOrderRequest,
+	params api.CaptureOrderParams,
+) (api.CaptureOrderRes, error) {
+
+	var amount float64
+	if body, ok := req.Get(); ok {
+		amount = body.Amount.Or(0)
+	}
+
+	order, err := h.Service.CaptureOrder(ctx, params.OrderUUID, params.XActor, amount)
+	if err != nil {
+		return nil, err
+	}
+	return orderToAPI(order), nil
+}
+
+func (h *OrderHandler) QuoteShipping(
+	ctx context.Context,
+	req *api.ShippingQuoteRequest,
+) (api.QuoteShippingRes, error) {
+	if len(req.Items) == 0 {
+		return nil, fmt.Errorf("%w: items required", model.ErrBadRequest)
+	}
+	items := make([]model.Item, len(req.Items))
+	for i, v := range req.Items {
+		items[i] = model.Item{
+			PartUUID: v.PartUUID,
+			Quantity: int(v.Quantity),
+		}
+	}
+
+	quotes, err := h.Service.QuoteShipping(ctx, model.ShippingQuoteRequest{
+		Items:   items,
+		Address: addressFromAPI(req.Address),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]api.ShippingQuote, len(quotes.Options))
+	for i, v := range quotes.Options {
+		options[i] = api.ShippingQuote{
+			Carrier:          v.Carrier,
+			Option:           v.Option,
+			Name:             v.Name,
+			Cost:             v.Cost,
+			DaysMin:          v.DaysMin,
+			DaysMax:          v.DaysMax,
+			VolumetricWeight: v.VolumetricWeight,
+			ChargeableWeight: v.ChargeableWeight,
+		}
+	}
+	return &api.ShippingQuoteResponse{
+		ActualWeight: quotes.ActualWeight,
+		Volume:       quotes.Volume,
+		Options:      options,
 	}, nil
 }
 
+func (h *OrderHandler) GetOrderShipment(
+	ctx context.Context,
+	params api.GetOrderShipmentParams,
+) (api.GetOrderShipmentRes, error) {
+
+	shipment, err := h.Service.GetShipment(ctx, params.OrderUUID)
+	if err != nil {
+		return nil, err
+	}
+	return shipmentToAPI(shipment), nil
+}
+
+func (h *OrderHandler) AddShipmentEvent(
+	ctx context.Context,
+	req *api.ShipmentEventRequest,
+	params api.AddShipmentEventParams,
+) (api.AddShipmentEventRes, error) {
+
+	shipment, err := h.Service.RecordShipmentEvent(ctx, params.OrderUUID, model.ShipmentUpdate{
+		Type:           model.ShipmentEventType(req.Type),
+		Carrier:        req.Carrier.Or(""),
+		TrackingNumber: req.TrackingNumber.Or(""),
+		Location:       req.Location.Or(""),
+		Description:    req.Description.Or(""),
+		Actor:          params.XActor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shipmentToAPI(shipment), nil
+}
+
+func shipmentToAPI(shipment *model.Shipment) *api.Shipment {
+	events := make([]api.ShipmentEvent, len(shipment.Events))
+	for i, v := range shipment.Events {
+		events[i] = api.ShipmentEvent{
+			Type:        api.ShipmentEventType(v.Type),
+			Location:    v.Location,
+			Description: v.Description,
+			Actor:       v.Actor,
+			CreatedAt:   v.CreatedAt,
+		}
+	}
+
+	resp := &api.Shipment{
+		OrderUUID: shipment.OrderUUID,
+		Status:    api.OrderStatus(shipment.Status),
+		CreatedAt: shipment.CreatedAt,
+		UpdatedAt: shipment.UpdatedAt,
+		Events:    events,
+	}
+	if shipment.Carrier != nil {
+		resp.Carrier = api.NewOptNilString(*shipment.Carrier)
+	}
+	if shipment.TrackingNumber != nil {
+		resp.TrackingNumber = api.NewOptNilString(*shipment.TrackingNumber)
+	}
+	if shipment.Address != nil {
+		resp.DeliveryAddress = api.NewOptNilAddress(addressToAPI(*shipment.Address))
+	}
+	return resp
+}
+
+func addressToAPI(a model.Address) api.Address {
+	resp := api.Address{Country: a.Country}
+	if a.City != "" {
+		resp.City = api.NewOptString(a.City)
+	}
+	if a.PostalCode != "" {
+		resp.PostalCode = api.NewOptString(a.PostalCode)
+	}
+	if a.Street != "" {
+		resp.Street = api.NewOptString(a.Street)
+	}
+	return resp
+}
+
+func addressFromAPI(a api.Address) model.Address {
+	return model.Address{
+		Country:    a.Country,
+		City:       a.City.Or(""),
+		PostalCode: a.PostalCode.Or(""),
+		Street:     a.Street.Or(""),
+	}
+}
+
 func (h *OrderHandler) NewError(
 	ctx context.Context,
 	err error,
 ) *api.ErrorStatusCode {
 
+	resp := api.Error{
+		Message: err.Error(),
+	}
+	var validationErr *model.ValidationError
+	if errors.As(err, &validationErr) {
+		resp.Code = api.NewOptString("VALIDATION_FAILED")
+		for _, v := range validationErr.Lines {
+			line := api.LineError{
+				Line:    v.Line,
+				Code:    api.LineErrorCode(v.Code),
+				Message: v.Message,
+			}
+			if v.PartUUID != "" {
+				line.PartUUID = api.NewOptString(v.PartUUID)
+			}
+			resp.Details = append(resp.Details, line)
+		}
+	}
+	var configErr *model.ConfigurationError
+	if errors.As(err, &configErr) {
+		resp.Code = api.NewOptString("INCOMPATIBLE_CONFIGURATION")
+		for _, v := range configErr.Violations {
+			violation := api.ConfigurationViolation{
+				PartUUID: v.PartUUID,
+				Rule:     api.ConfigurationViolationRule(v.Rule),
+				Message:  v.Message,
+			}
+			if v.TargetPartUUID != "" {
+				violation.TargetPartUUID = api.NewOptString(v.TargetPartUUID)
+			}
+			if v.TargetCategory != "" {
+				violation.TargetCategory = api.NewOptString(v.TargetCategory)
+			}
+			resp.Violations = append(resp.Violations, violation)
+		}
+	}
+	var promoErr *model.PromoError
+	if errors.As(err, &promoErr) {
+		resp.Code = api.NewOptString(promoErr.Code)
+		resp.Message = promoErr.Message
+	}
+	var refusedErr *model.PaymentRefusedError
+	if errors.As(err, &refusedErr) {
+		resp.Code = api.NewOptString(refusedErr.Reason)
+		resp.Message = refusedErr.Message
+	}
+
 	switch {
 	case errors.Is(err, model.ErrNotFound):
-		return &api.ErrorStatusCode{
-			StatusCode: 404,
-			Response: api.Error{
-				Message: err.Error(),
-			},
-		}
+		return errorStatus(404, "NOT_FOUND", resp)
+
+	case errors.Is(err, model.ErrPaymentRequired):
+		return errorStatus(402, "PAYMENT_REQUIRED", resp)
+
+	case errors.Is(err, model.ErrQuoteExpired):
+		return errorStatus(410, "QUOTE_EXPIRED", resp)
+
+	case errors.Is(err, model.ErrAuthorizationExpired):
+		return errorStatus(409, "AUTHORIZATION_EXPIRED", resp)
 
 	case errors.Is(err, model.ErrConflict):
-		return &api.ErrorStatusCode{
-			StatusCode: 409,
-			Response: api.Error{
-				Message: err.Error(),
-			},
-		}
+		return errorStatus(409, "CONFLICT", resp)
 
 	case errors.Is(err, model.ErrBadRequest):
-		return &api.ErrorStatusCode{
-			StatusCode: 400,
-			Response: api.Error{
-				Message: err.Error(),
-			},
-		}
+		return errorStatus(400, "BAD_REQUEST", resp)
 
 	case errors.Is(err, model.ErrNotEnoughInStock):
-		return &api.ErrorStatusCode{
-			StatusCode: 400,
-			Response: api.Error{
-				Message: err.Error(),
-			},
-		}
+		return errorStatus(400, "NOT_ENOUGH_STOCK", resp)
 
 	default:
-		return &api.ErrorStatusCode{
-			StatusCode: 500,
-			Response: api.Error{
-				Message: err.Error(),
-			},
+		return errorStatus(500, "INTERNAL", resp)
+	}
+}
+
+// errorStatus fills in the error code unless a more specific one is already
+// set and wraps the response with the HTTP status.
+func errorStatus(statusCode int, code string, resp api.Error) *api.ErrorStatusCode {
+	if !resp.Code.IsSet() {
+		resp.Code = api.NewOptString(code)
+	}
+	return &api.ErrorStatusCode{
+		StatusCode: statusCode,
+		Response:   resp,
+	}
+}
+
+func itemsToAPI(items []model.Item) []api.OrderItem {
+	res := make([]api.OrderItem, 0, len(items))
+	for _, v := range items {
+		item := api.OrderItem{
+			Quantity: float64(v.Quantity),
+			PartUUID: v.PartUUID,
+			Price:    v.Price,
+			Name:     v.Name,
+			Discount: v.Discount,
+			Tax:      v.Tax,
+			Duty:     v.Duty,
+		}
+		if v.Category != "" {
+			item.Category = api.NewOptString(v.Category)
 		}
+		if v.ManufacturerCountry != "" {
+			item.ManufacturerCountry = api.NewOptString(v.ManufacturerCountry)
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+func orderToAPI(order *model.Order) *api.Order {
+	resp := &api.Order{
+		OrderUUID:     order.OrderUUID,
+		UserUUID:      order.UserUUID,
+		Items:         itemsToAPI(order.Items),
+		Subtotal:      order.Subtotal,
+		DiscountTotal: order.DiscountTotal,
+		TaxTotal:      order.TaxTotal,
+		DutyTotal:     order.DutyTotal,
+		ShippingCost:  order.ShippingCost,
+		TotalPrice:    order.TotalPrice,
+		Status:        api.OrderStatus(order.Status),
+	}
+	if order.PromoCode != nil {
+		resp.PromoCode = api.NewOptNilString(*order.PromoCode)
+	}
+	if order.BuyerCountry != nil {
+		resp.BuyerCountry = api.NewOptNilString(*order.BuyerCountry)
+	}
+	if order.ShippingCarrier != nil {
+		resp.ShippingCarrier = api.NewOptNilString(*order.ShippingCarrier)
+	}
+	if order.ShippingOption != nil {
+		resp.ShippingOption = api.NewOptNilString(*order.ShippingOption)
+	}
+	if order.DeliveryAddress != nil {
+		resp.DeliveryAddress = api.NewOptNilAddress(addressToAPI(*order.DeliveryAddress))
+	}
+	if order.TransactionUUID != nil {
+		resp.TransactionUUID = api.NewOptNilString(*order.TransactionUUID)
+	}
+	if order.PaymentMethod != nil {
+		resp.PaymentMethod = api.NewOptNilOrderPaymentMethod(api.OrderPaymentMethod(*order.PaymentMethod))
+	}
+	if order.AuthorizedAmount != nil {
+		resp.AuthorizedAmount = api.NewOptNilFloat64(*order.AuthorizedAmount)
+	}
+	if order.CapturedAmount != nil {
+		resp.CapturedAmount = api.NewOptNilFloat64(*order.CapturedAmount)
+	}
+	if order.AuthorizationExpiresAt != nil {
+		resp.AuthorizationExpiresAt = api.NewOptNilDateTime(*order.AuthorizationExpiresAt)
+	}
+	if order.PaymentDeadline != nil {
+		resp.PaymentDeadline = api.NewOptNilDateTime(*order.PaymentDeadline)
 	}
+	return resp
 }
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	api "order-service/internal/oapi"
+	"order-service/internal/repository/model"
+)
+
+func (h *OrderHandler) CreatePromoCode(ctx context.Context, req *api.CreatePromoCodeRequest) (api.CreatePromoCodeRes, error) {
+	promo := model.PromoCode{
+		Code:        req.Code,
+		RuleType:    model.PromoRuleType(req.RuleType),
+		Value:       req.Value,
+		Category:    req.Category.Or(""),
+		BuyQuantity: req.BuyQuantity.Or(0),
+		GetCategory: req.RewardCategory.Or(""),
+		GetQuantity: req.RewardQuantity.Or(0),
+		MinSubtotal: req.MinSubtotal.Or(0),
+	}
+	if v, ok := req.ValidFrom.Get(); ok {
+		promo.ValidFrom = &v
+	}
+	if v, ok := req.ValidTo.Get(); ok {
+		promo.ValidTo = &v
+	}
+	if v, ok := req.MaxUses.Get(); ok {
+		promo.MaxUses = &v
+	}
+	if v, ok := req.PerUserLimit.Get(); ok {
+		promo.PerUserLimit = &v
+	}
+
+	created, err := h.Promos.CreatePromo(ctx, promo)
+	if err != nil {
+		return nil, err
+	}
+	return promoToAPI(created), nil
+}
+
+func (h *OrderHandler) DeactivatePromoCode(ctx context.Context, params api.DeactivatePromoCodeParams) (api.DeactivatePromoCodeRes, error) {
+	promo, err := h.Promos.DeactivatePromo(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	return promoToAPI(promo), nil
+}
+
+func (h *OrderHandler) ListPromoCodes(ctx context.Context) (*api.PromoCodeList, error) {
+	promos, err := h.Promos.ListPromos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &api.PromoCodeList{PromoCodes: make([]api.PromoCode, 0, len(promos))}
+	for _, v := range promos {
+		resp.PromoCodes = append(resp.PromoCodes, *promoToAPI(v))
+	}
+	return resp, nil
+}
+
+func promoToAPI(promo *model.PromoCode) *api.PromoCode {
+	resp := &api.PromoCode{
+		Code:        promo.Code,
+		RuleType:    api.PromoRuleType(promo.RuleType),
+		Value:       promo.Value,
+		MinSubtotal: promo.MinSubtotal,
+		UsedCount:   promo.UsedCount,
+		Active:      promo.Active,
+		CreatedAt:   promo.CreatedAt,
+	}
+	if promo.Category != "" {
+		resp.Category = api.NewOptString(promo.Category)
+	}
+	if promo.BuyQuantity != 0 {
+		resp.BuyQuantity = api.NewOptInt(promo.BuyQuantity)
+	}
+	if promo.GetCategory != "" {
+		resp.RewardCategory = api.NewOptString(promo.GetCategory)
+	}
+	if promo.GetQuantity != 0 {
+		resp.RewardQuantity = api.NewOptInt(promo.GetQuantity)
+	}
+	if promo.ValidFrom != nil {
+		resp.ValidFrom = api.NewOptDateTime(*promo.ValidFrom)
+	}
+	if promo.ValidTo != nil {
+		resp.ValidTo = api.NewOptDateTime(*promo.ValidTo)
+	}
+	if promo.MaxUses != nil {
+		resp.MaxUses = api.NewOptInt(*promo.MaxUses)
+	}
+	if promo.PerUserLimit != nil {
+		resp.PerUserLimit = api.NewOptInt(*promo.PerUserLimit)
+	}
+	return resp
+}
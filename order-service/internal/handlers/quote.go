@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	api "order-service/internal/oapi"
+	"order-service/internal/repository/model"
+)
+
+func (h *OrderHandler) CreateQuote(ctx context.Context, req *api.CreateOrderRequest) (api.CreateQuoteRes, error) {
+	orderReq, err := orderRequestFromAPI(req)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := h.Service.CreateQuote(ctx, orderReq)
+	if err != nil {
+		return nil, err
+	}
+	return quoteToAPI(quote), nil
+}
+
+func (h *OrderHandler) GetQuote(ctx context.Context, params api.GetQuoteParams) (api.GetQuoteRes, error) {
+	quote, err := h.Service.GetQuote(ctx, params.QuoteUUID)
+	if err != nil {
+		return nil, err
+	}
+	return quoteToAPI(quote), nil
+}
+
+func (h *OrderHandler) AcceptQuote(ctx context.Context, params api.AcceptQuoteParams) (api.AcceptQuoteRes, error) {
+	order, err := h.Service.AcceptQuote(ctx, params.QuoteUUID)
+	if err != nil {
+		return nil, err
+	}
+	return createOrderResponse(order), nil
+}
+
+func quoteToAPI(quote *model.Quote) *api.Quote {
+	o := quote.Order
+	resp := &api.Quote{
+		QuoteUUID:     quote.QuoteUUID,
+		UserUUID:      o.UserUUID,
+		Status:        api.QuoteStatus(quote.Status),
+		Items:         itemsToAPI(o.Items),
+		Subtotal:      o.Subtotal,
+		DiscountTotal: o.DiscountTotal,
+		TaxTotal:      o.TaxTotal,
+		DutyTotal:     o.DutyTotal,
+		ShippingCost:  o.ShippingCost,
+		TotalPrice:    o.TotalPrice,
+		ExpiresAt:     quote.ExpiresAt,
+		CreatedAt:     quote.CreatedAt,
+	}
+	if o.PromoCode != nil {
+		resp.PromoCode = api.NewOptNilString(*o.PromoCode)
+	}
+	if o.BuyerCountry != nil {
+		resp.BuyerCountry = api.NewOptNilString(*o.BuyerCountry)
+	}
+	if o.ShippingCarrier != nil {
+		resp.ShippingCarrier = api.NewOptNilString(*o.ShippingCarrier)
+	}
+	if o.ShippingOption != nil {
+		resp.ShippingOption = api.NewOptNilString(*o.ShippingOption)
+	}
+	if o.DeliveryAddress != nil {
+		resp.DeliveryAddress = api.NewOptNilAddress(addressToAPI(*o.DeliveryAddress))
+	}
+	if quote.AcceptedAt != nil {
+		resp.AcceptedAt = api.NewOptNilDateTime(*quote.AcceptedAt)
+	}
+	if quote.OrderUUID != nil {
+		resp.OrderUUID = api.NewOptNilString(*quote.OrderUUID)
+	}
+	return resp
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "order-service/internal/repository/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompatibilityValidator is an autogenerated mock type for the CompatibilityValidator type
+type CompatibilityValidator struct {
+	mock.Mock
+}
+
+// ValidateConfiguration provides a mock function with given fields: ctx, items
+func (_m *CompatibilityValidator) ValidateConfiguration(ctx context.Context, items []model.Item) ([]model.ConfigurationViolation, error) {
+	ret := _m.Called(ctx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfiguration")
+	}
+
+	var r0 []model.ConfigurationViolation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Item) ([]model.ConfigurationViolation, error)); ok {
+		return rf(ctx, items)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Item) []model.ConfigurationViolation); ok {
+		r0 = rf(ctx, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ConfigurationViolation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Item) error); ok {
+		r1 = rf(ctx, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCompatibilityValidator creates a new instance of CompatibilityValidator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompatibilityValidator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompatibilityValidator {
+	mock := &CompatibilityValidator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
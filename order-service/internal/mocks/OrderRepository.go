@@ -7,6 +7,8 @@ import (
 	model "order-service/internal/repository/model"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -62,12 +64,162 @@ func (_m *OrderRepository) Get(ctx context.Context, orderID string) (*model.Orde
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, order
-func (_m *OrderRepository) Update(ctx context.Context, order *model.Order) error {
+// GetShipment provides a mock function with given fields: ctx, orderID
+func (_m *OrderRepository) GetShipment(ctx context.Context, orderID string) (*model.Shipment, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShipment")
+	}
+
+	var r0 *model.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Shipment, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Shipment); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Shipment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// History provides a mock function with given fields: ctx, orderID
+func (_m *OrderRepository) History(ctx context.Context, orderID string) ([]model.StatusChange, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []model.StatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.StatusChange, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.StatusChange); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCancelling provides a mock function with given fields: ctx
+func (_m *OrderRepository) ListCancelling(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCancelling")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPaid provides a mock function with given fields: ctx, from, to
+func (_m *OrderRepository) ListPaid(ctx context.Context, from time.Time, to time.Time) ([]*model.Order, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaid")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*model.Order, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*model.Order); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPaymentPending provides a mock function with given fields: ctx
+func (_m *OrderRepository) ListPaymentPending(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaymentPending")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceItems provides a mock function with given fields: ctx, order
+func (_m *OrderRepository) ReplaceItems(ctx context.Context, order *model.Order) error {
 	ret := _m.Called(ctx, order)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for ReplaceItems")
 	}
 
 	var r0 error
@@ -80,6 +232,42 @@ func (_m *OrderRepository) Update(ctx context.Context, order *model.Order) error
 	return r0
 }
 
+// SaveShipment provides a mock function with given fields: ctx, order, change, shipment, event
+func (_m *OrderRepository) SaveShipment(ctx context.Context, order *model.Order, change model.StatusChange, shipment *model.Shipment, event model.ShipmentEvent) error {
+	ret := _m.Called(ctx, order, change, shipment, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveShipment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange, *model.Shipment, model.ShipmentEvent) error); ok {
+		r0 = rf(ctx, order, change, shipment, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, order, change
+func (_m *OrderRepository) Update(ctx context.Context, order *model.Order, change model.StatusChange) error {
+	ret := _m.Called(ctx, order, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Order, model.StatusChange) error); ok {
+		r0 = rf(ctx, order, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOrderRepository creates a new instance of OrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderRepository(t interface {
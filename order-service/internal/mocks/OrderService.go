@@ -0,0 +1,329 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "order-service/internal/repository/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderService is an autogenerated mock type for the OrderService type
+type OrderService struct {
+	mock.Mock
+}
+
+// AuthorizeOrder provides a mock function with given fields: ctx, orderID, actor, pm
+func (_m *OrderService) AuthorizeOrder(ctx context.Context, orderID string, actor string, pm *model.PaymentMethod) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID, actor, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthorizeOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) (*model.Order, error)); ok {
+		return rf(ctx, orderID, actor, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) *model.Order); ok {
+		r0 = rf(ctx, orderID, actor, pm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.PaymentMethod) error); ok {
+		r1 = rf(ctx, orderID, actor, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelOrder provides a mock function with given fields: ctx, orderID, actor, reason, comment
+func (_m *OrderService) CancelOrder(ctx context.Context, orderID string, actor string, reason model.CancelReason, comment string) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID, actor, reason, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.CancelReason, string) (*model.Order, error)); ok {
+		return rf(ctx, orderID, actor, reason, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.CancelReason, string) *model.Order); ok {
+		r0 = rf(ctx, orderID, actor, reason, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.CancelReason, string) error); ok {
+		r1 = rf(ctx, orderID, actor, reason, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CaptureOrder provides a mock function with given fields: ctx, orderID, actor, amount
+func (_m *OrderService) CaptureOrder(ctx context.Context, orderID string, actor string, amount float64) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID, actor, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CaptureOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64) (*model.Order, error)); ok {
+		return rf(ctx, orderID, actor, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64) *model.Order); ok {
+		r0 = rf(ctx, orderID, actor, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64) error); ok {
+		r1 = rf(ctx, orderID, actor, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrder provides a mock function with given fields: ctx, req
+func (_m *OrderService) CreateOrder(ctx context.Context, req model.OrderRequest) (*model.Order, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRequest) (*model.Order, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.OrderRequest) *model.Order); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.OrderRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrder provides a mock function with given fields: ctx, orderID
+func (_m *OrderService) GetOrder(ctx context.Context, orderID string) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderHistory provides a mock function with given fields: ctx, orderID
+func (_m *OrderService) GetOrderHistory(ctx context.Context, orderID string) (*model.OrderHistory, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 *model.OrderHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.OrderHistory, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.OrderHistory); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyItems provides a mock function with given fields: ctx, orderID, ops
+func (_m *OrderService) ModifyItems(ctx context.Context, orderID string, ops []model.ItemOperation) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID, ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyItems")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ItemOperation) (*model.Order, error)); ok {
+		return rf(ctx, orderID, ops)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.ItemOperation) *model.Order); ok {
+		r0 = rf(ctx, orderID, ops)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.ItemOperation) error); ok {
+		r1 = rf(ctx, orderID, ops)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayOrder provides a mock function with given fields: ctx, orderID, actor, pm
+func (_m *OrderService) PayOrder(ctx context.Context, orderID string, actor string, pm *model.PaymentMethod) (*model.Payment, error) {
+	ret := _m.Called(ctx, orderID, actor, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayOrder")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) (*model.Payment, error)); ok {
+		return rf(ctx, orderID, actor, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) *model.Payment); ok {
+		r0 = rf(ctx, orderID, actor, pm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.PaymentMethod) error); ok {
+		r1 = rf(ctx, orderID, actor, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordPayment provides a mock function with given fields: ctx, orderID, tx
+func (_m *OrderService) RecordPayment(ctx context.Context, orderID string, tx model.PaymentTransaction) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPayment")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentTransaction) (*model.Order, error)); ok {
+		return rf(ctx, orderID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentTransaction) *model.Order); ok {
+		r0 = rf(ctx, orderID, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.PaymentTransaction) error); ok {
+		r1 = rf(ctx, orderID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncPayment provides a mock function with given fields: ctx, orderID
+func (_m *OrderService) SyncPayment(ctx context.Context, orderID string) (*model.Order, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncPayment")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOrderService creates a new instance of OrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderService {
+	mock := &OrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
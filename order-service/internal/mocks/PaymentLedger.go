@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "order-service/internal/repository/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PaymentLedger is an autogenerated mock type for the PaymentLedger type
+type PaymentLedger struct {
+	mock.Mock
+}
+
+// ListTransactions provides a mock function with given fields: ctx, from, to
+func (_m *PaymentLedger) ListTransactions(ctx context.Context, from time.Time, to time.Time) ([]model.PaymentTransaction, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 []model.PaymentTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]model.PaymentTransaction, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []model.PaymentTransaction); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transaction provides a mock function with given fields: ctx, transactionID
+func (_m *PaymentLedger) Transaction(ctx context.Context, transactionID string) (*model.PaymentTransaction, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 *model.PaymentTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.PaymentTransaction, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PaymentTransaction); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PaymentTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPaymentLedger creates a new instance of PaymentLedger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentLedger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentLedger {
+	mock := &PaymentLedger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,27 +14,145 @@ type PaymentService struct {
 	mock.Mock
 }
 
-// MakePayment provides a mock function with given fields: ctx, orderID, userID, pm
-func (_m *PaymentService) MakePayment(ctx context.Context, orderID string, userID string, pm *model.PaymentMethod) (string, error) {
-	ret := _m.Called(ctx, orderID, userID, pm)
+// Authorize provides a mock function with given fields: ctx, orderID, userID, amount, pm
+func (_m *PaymentService) Authorize(ctx context.Context, orderID string, userID string, amount float64, pm *model.PaymentMethod) (*model.Authorization, error) {
+	ret := _m.Called(ctx, orderID, userID, amount, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 *model.Authorization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, *model.PaymentMethod) (*model.Authorization, error)); ok {
+		return rf(ctx, orderID, userID, amount, pm)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, *model.PaymentMethod) *model.Authorization); ok {
+		r0 = rf(ctx, orderID, userID, amount, pm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Authorization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64, *model.PaymentMethod) error); ok {
+		r1 = rf(ctx, orderID, userID, amount, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Capture provides a mock function with given fields: ctx, transactionID, amount
+func (_m *PaymentService) Capture(ctx context.Context, transactionID string, amount float64) (float64, error) {
+	ret := _m.Called(ctx, transactionID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capture")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) (float64, error)); ok {
+		return rf(ctx, transactionID, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) float64); ok {
+		r0 = rf(ctx, transactionID, amount)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64) error); ok {
+		r1 = rf(ctx, transactionID, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MakePayment provides a mock function with given fields: ctx, orderID, userID, amount, pm, idempotencyKey
+func (_m *PaymentService) MakePayment(ctx context.Context, orderID string, userID string, amount float64, pm *model.PaymentMethod, idempotencyKey string) (*model.Payment, error) {
+	ret := _m.Called(ctx, orderID, userID, amount, pm, idempotencyKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MakePayment")
 	}
 
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, *model.PaymentMethod, string) (*model.Payment, error)); ok {
+		return rf(ctx, orderID, userID, amount, pm, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, *model.PaymentMethod, string) *model.Payment); ok {
+		r0 = rf(ctx, orderID, userID, amount, pm, idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64, *model.PaymentMethod, string) error); ok {
+		r1 = rf(ctx, orderID, userID, amount, pm, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentStatus provides a mock function with given fields: ctx, transactionID
+func (_m *PaymentService) PaymentStatus(ctx context.Context, transactionID string) (*model.Payment, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentStatus")
+	}
+
+	var r0 *model.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Payment, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Payment); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refund provides a mock function with given fields: ctx, transactionID, orderID, userID, reason
+func (_m *PaymentService) Refund(ctx context.Context, transactionID string, orderID string, userID string, reason string) (string, error) {
+	ret := _m.Called(ctx, transactionID, orderID, userID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refund")
+	}
+
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) (string, error)); ok {
-		return rf(ctx, orderID, userID, pm)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, transactionID, orderID, userID, reason)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.PaymentMethod) string); ok {
-		r0 = rf(ctx, orderID, userID, pm)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, transactionID, orderID, userID, reason)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, *model.PaymentMethod) error); ok {
-		r1 = rf(ctx, orderID, userID, pm)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, transactionID, orderID, userID, reason)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,6 +160,24 @@ func (_m *PaymentService) MakePayment(ctx context.Context, orderID string, userI
 	return r0, r1
 }
 
+// Void provides a mock function with given fields: ctx, transactionID, reason
+func (_m *PaymentService) Void(ctx context.Context, transactionID string, reason string) error {
+	ret := _m.Called(ctx, transactionID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Void")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, transactionID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewPaymentService creates a new instance of PaymentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentService(t interface {
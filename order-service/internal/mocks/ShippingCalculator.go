@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "order-service/internal/repository/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShippingCalculator is an autogenerated mock type for the ShippingCalculator type
+type ShippingCalculator struct {
+	mock.Mock
+}
+
+// Quote provides a mock function with given fields: country, items
+func (_m *ShippingCalculator) Quote(country string, items []model.Item) (*model.ShippingQuotes, error) {
+	ret := _m.Called(country, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quote")
+	}
+
+	var r0 *model.ShippingQuotes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []model.Item) (*model.ShippingQuotes, error)); ok {
+		return rf(country, items)
+	}
+	if rf, ok := ret.Get(0).(func(string, []model.Item) *model.ShippingQuotes); ok {
+		r0 = rf(country, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShippingQuotes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []model.Item) error); ok {
+		r1 = rf(country, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewShippingCalculator creates a new instance of ShippingCalculator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShippingCalculator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShippingCalculator {
+	mock := &ShippingCalculator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "order-service/internal/repository/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaxCalculator is an autogenerated mock type for the TaxCalculator type
+type TaxCalculator struct {
+	mock.Mock
+}
+
+// Apply provides a mock function with given fields: country, items
+func (_m *TaxCalculator) Apply(country string, items []model.Item) (string, error) {
+	ret := _m.Called(country, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []model.Item) (string, error)); ok {
+		return rf(country, items)
+	}
+	if rf, ok := ret.Get(0).(func(string, []model.Item) string); ok {
+		r0 = rf(country, items)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []model.Item) error); ok {
+		r1 = rf(country, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTaxCalculator creates a new instance of TaxCalculator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaxCalculator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaxCalculator {
+	mock := &TaxCalculator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,18 +1,90 @@
 // Code generated by ogen, DO NOT EDIT.
 package oapi
 
+type AcceptQuoteRes interface {
+	acceptQuoteRes()
+}
+
+type AddCartItemRes interface {
+	addCartItemRes()
+}
+
+type AddShipmentEventRes interface {
+	addShipmentEventRes()
+}
+
+type AuthorizeOrderRes interface {
+	authorizeOrderRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
 
+type CaptureOrderRes interface {
+	captureOrderRes()
+}
+
+type CheckoutCartRes interface {
+	checkoutCartRes()
+}
+
 type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type CreatePromoCodeRes interface {
+	createPromoCodeRes()
+}
+
+type CreateQuoteRes interface {
+	createQuoteRes()
+}
+
+type DeactivatePromoCodeRes interface {
+	deactivatePromoCodeRes()
+}
+
+type GetCartRes interface {
+	getCartRes()
+}
+
+type GetOrderHistoryRes interface {
+	getOrderHistoryRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
 
+type GetOrderShipmentRes interface {
+	getOrderShipmentRes()
+}
+
+type GetQuoteRes interface {
+	getQuoteRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
+
+type PaymentCallbackRes interface {
+	paymentCallbackRes()
+}
+
+type QuoteShippingRes interface {
+	quoteShippingRes()
+}
+
+type RemoveCartItemRes interface {
+	removeCartItemRes()
+}
+
+type UpdateCartItemRes interface {
+	updateCartItemRes()
+}
+
+type UpdateOrderItemsRes interface {
+	updateOrderItemsRes()
+}
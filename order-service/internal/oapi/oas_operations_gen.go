@@ -6,8 +6,27 @@ package oapi
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	AcceptQuoteOperation         OperationName = "AcceptQuote"
+	AddCartItemOperation         OperationName = "AddCartItem"
+	AddShipmentEventOperation    OperationName = "AddShipmentEvent"
+	AuthorizeOrderOperation      OperationName = "AuthorizeOrder"
+	CancelOrderOperation         OperationName = "CancelOrder"
+	CaptureOrderOperation        OperationName = "CaptureOrder"
+	CheckoutCartOperation        OperationName = "CheckoutCart"
+	CreateOrderOperation         OperationName = "CreateOrder"
+	CreatePromoCodeOperation     OperationName = "CreatePromoCode"
+	CreateQuoteOperation         OperationName = "CreateQuote"
+	DeactivatePromoCodeOperation OperationName = "DeactivatePromoCode"
+	GetCartOperation             OperationName = "GetCart"
+	GetOrderOperation            OperationName = "GetOrder"
+	GetOrderHistoryOperation     OperationName = "GetOrderHistory"
+	GetOrderShipmentOperation    OperationName = "GetOrderShipment"
+	GetQuoteOperation            OperationName = "GetQuote"
+	ListPromoCodesOperation      OperationName = "ListPromoCodes"
+	PayOrderOperation            OperationName = "PayOrder"
+	PaymentCallbackOperation     OperationName = "PaymentCallback"
+	QuoteShippingOperation       OperationName = "QuoteShipping"
+	RemoveCartItemOperation      OperationName = "RemoveCartItem"
+	UpdateCartItemOperation      OperationName = "UpdateCartItem"
+	UpdateOrderItemsOperation    OperationName = "UpdateOrderItems"
 )
@@ -4,6 +4,7 @@ package oapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 )
@@ -12,6 +13,144 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type AcceptQuoteBadRequest Error
+
+func (*AcceptQuoteBadRequest) acceptQuoteRes() {}
+
+type AcceptQuoteConflict Error
+
+func (*AcceptQuoteConflict) acceptQuoteRes() {}
+
+type AcceptQuoteGone Error
+
+func (*AcceptQuoteGone) acceptQuoteRes() {}
+
+type AcceptQuoteNotFound Error
+
+func (*AcceptQuoteNotFound) acceptQuoteRes() {}
+
+type AddCartItemBadRequest Error
+
+func (*AddCartItemBadRequest) addCartItemRes() {}
+
+type AddCartItemInternalServerError Error
+
+func (*AddCartItemInternalServerError) addCartItemRes() {}
+
+type AddCartItemNotFound Error
+
+func (*AddCartItemNotFound) addCartItemRes() {}
+
+// Ref: #/components/schemas/AddCartItemRequest
+type AddCartItemRequest struct {
+	PartUUID string `json:"part_uuid"`
+	Quantity int    `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *AddCartItemRequest) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *AddCartItemRequest) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *AddCartItemRequest) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *AddCartItemRequest) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+type AddShipmentEventBadRequest Error
+
+func (*AddShipmentEventBadRequest) addShipmentEventRes() {}
+
+type AddShipmentEventConflict Error
+
+func (*AddShipmentEventConflict) addShipmentEventRes() {}
+
+type AddShipmentEventInternalServerError Error
+
+func (*AddShipmentEventInternalServerError) addShipmentEventRes() {}
+
+type AddShipmentEventNotFound Error
+
+func (*AddShipmentEventNotFound) addShipmentEventRes() {}
+
+// Ref: #/components/schemas/Address
+type Address struct {
+	Country    string    `json:"country"`
+	City       OptString `json:"city"`
+	PostalCode OptString `json:"postal_code"`
+	Street     OptString `json:"street"`
+}
+
+// GetCountry returns the value of Country.
+func (s *Address) GetCountry() string {
+	return s.Country
+}
+
+// GetCity returns the value of City.
+func (s *Address) GetCity() OptString {
+	return s.City
+}
+
+// GetPostalCode returns the value of PostalCode.
+func (s *Address) GetPostalCode() OptString {
+	return s.PostalCode
+}
+
+// GetStreet returns the value of Street.
+func (s *Address) GetStreet() OptString {
+	return s.Street
+}
+
+// SetCountry sets the value of Country.
+func (s *Address) SetCountry(val string) {
+	s.Country = val
+}
+
+// SetCity sets the value of City.
+func (s *Address) SetCity(val OptString) {
+	s.City = val
+}
+
+// SetPostalCode sets the value of PostalCode.
+func (s *Address) SetPostalCode(val OptString) {
+	s.PostalCode = val
+}
+
+// SetStreet sets the value of Street.
+func (s *Address) SetStreet(val OptString) {
+	s.Street = val
+}
+
+type AuthorizeOrderBadRequest Error
+
+func (*AuthorizeOrderBadRequest) authorizeOrderRes() {}
+
+type AuthorizeOrderConflict Error
+
+func (*AuthorizeOrderConflict) authorizeOrderRes() {}
+
+type AuthorizeOrderInternalServerError Error
+
+func (*AuthorizeOrderInternalServerError) authorizeOrderRes() {}
+
+type AuthorizeOrderNotFound Error
+
+func (*AuthorizeOrderNotFound) authorizeOrderRes() {}
+
+type AuthorizeOrderPaymentRequired Error
+
+func (*AuthorizeOrderPaymentRequired) authorizeOrderRes() {}
+
 type CancelOrderConflict Error
 
 func (*CancelOrderConflict) cancelOrderRes() {}
@@ -20,631 +159,3974 @@ type CancelOrderInternalServerError Error
 
 func (*CancelOrderInternalServerError) cancelOrderRes() {}
 
-// CancelOrderNoContent is response for CancelOrder operation.
-type CancelOrderNoContent struct{}
-
-func (*CancelOrderNoContent) cancelOrderRes() {}
-
 type CancelOrderNotFound Error
 
 func (*CancelOrderNotFound) cancelOrderRes() {}
 
-type CreateOrderBadRequest Error
+// Ref: #/components/schemas/CancelOrderRequest
+type CancelOrderRequest struct {
+	Reason  OptCancelReason `json:"reason"`
+	Comment OptString       `json:"comment"`
+}
 
-func (*CreateOrderBadRequest) createOrderRes() {}
+// GetReason returns the value of Reason.
+func (s *CancelOrderRequest) GetReason() OptCancelReason {
+	return s.Reason
+}
 
-type CreateOrderConflict Error
+// GetComment returns the value of Comment.
+func (s *CancelOrderRequest) GetComment() OptString {
+	return s.Comment
+}
 
-func (*CreateOrderConflict) createOrderRes() {}
+// SetReason sets the value of Reason.
+func (s *CancelOrderRequest) SetReason(val OptCancelReason) {
+	s.Reason = val
+}
 
-type CreateOrderInternalServerError Error
+// SetComment sets the value of Comment.
+func (s *CancelOrderRequest) SetComment(val OptString) {
+	s.Comment = val
+}
+
+// Ref: #/components/schemas/CancelOrderResponse
+type CancelOrderResponse struct {
+	OrderUUID string       `json:"order_uuid"`
+	Status    OrderStatus  `json:"status"`
+	Reason    CancelReason `json:"reason"`
+	// UUID транзакции возврата, если заказ был оплачен.
+	RefundTransactionUUID OptNilString `json:"refund_transaction_uuid"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *CancelOrderResponse) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *CancelOrderResponse) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// GetReason returns the value of Reason.
+func (s *CancelOrderResponse) GetReason() CancelReason {
+	return s.Reason
+}
+
+// GetRefundTransactionUUID returns the value of RefundTransactionUUID.
+func (s *CancelOrderResponse) GetRefundTransactionUUID() OptNilString {
+	return s.RefundTransactionUUID
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *CancelOrderResponse) SetOrderUUID(val string) {
+	s.OrderUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *CancelOrderResponse) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// SetReason sets the value of Reason.
+func (s *CancelOrderResponse) SetReason(val CancelReason) {
+	s.Reason = val
+}
+
+// SetRefundTransactionUUID sets the value of RefundTransactionUUID.
+func (s *CancelOrderResponse) SetRefundTransactionUUID(val OptNilString) {
+	s.RefundTransactionUUID = val
+}
+
+func (*CancelOrderResponse) cancelOrderRes() {}
+
+// Ref: #/components/schemas/CancelReason
+type CancelReason string
+
+const (
+	CancelReasonCUSTOMERREQUEST  CancelReason = "CUSTOMER_REQUEST"
+	CancelReasonORDEREDBYMISTAKE CancelReason = "ORDERED_BY_MISTAKE"
+	CancelReasonFOUNDCHEAPER     CancelReason = "FOUND_CHEAPER"
+	CancelReasonDELIVERYTOOLONG  CancelReason = "DELIVERY_TOO_LONG"
+	CancelReasonOTHER            CancelReason = "OTHER"
+)
+
+// AllValues returns all CancelReason values.
+func (CancelReason) AllValues() []CancelReason {
+	return []CancelReason{
+		CancelReasonCUSTOMERREQUEST,
+		CancelReasonORDEREDBYMISTAKE,
+		CancelReasonFOUNDCHEAPER,
+		CancelReasonDELIVERYTOOLONG,
+		CancelReasonOTHER,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CancelReason) MarshalText() ([]byte, error) {
+	switch s {
+	case CancelReasonCUSTOMERREQUEST:
+		return []byte(s), nil
+	case CancelReasonORDEREDBYMISTAKE:
+		return []byte(s), nil
+	case CancelReasonFOUNDCHEAPER:
+		return []byte(s), nil
+	case CancelReasonDELIVERYTOOLONG:
+		return []byte(s), nil
+	case CancelReasonOTHER:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CancelReason) UnmarshalText(data []byte) error {
+	switch CancelReason(data) {
+	case CancelReasonCUSTOMERREQUEST:
+		*s = CancelReasonCUSTOMERREQUEST
+		return nil
+	case CancelReasonORDEREDBYMISTAKE:
+		*s = CancelReasonORDEREDBYMISTAKE
+		return nil
+	case CancelReasonFOUNDCHEAPER:
+		*s = CancelReasonFOUNDCHEAPER
+		return nil
+	case CancelReasonDELIVERYTOOLONG:
+		*s = CancelReasonDELIVERYTOOLONG
+		return nil
+	case CancelReasonOTHER:
+		*s = CancelReasonOTHER
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CaptureOrderBadRequest Error
+
+func (*CaptureOrderBadRequest) captureOrderRes() {}
+
+type CaptureOrderConflict Error
+
+func (*CaptureOrderConflict) captureOrderRes() {}
+
+type CaptureOrderInternalServerError Error
+
+func (*CaptureOrderInternalServerError) captureOrderRes() {}
+
+type CaptureOrderNotFound Error
+
+func (*CaptureOrderNotFound) captureOrderRes() {}
+
+// Ref: #/components/schemas/CaptureOrderRequest
+type CaptureOrderRequest struct {
+	// Сумма списания, не больше заблокированной; по
+	// умолчанию вся.
+	Amount OptFloat64 `json:"amount"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *CaptureOrderRequest) GetAmount() OptFloat64 {
+	return s.Amount
+}
+
+// SetAmount sets the value of Amount.
+func (s *CaptureOrderRequest) SetAmount(val OptFloat64) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/Cart
+type Cart struct {
+	UserUUID string     `json:"user_uuid"`
+	Version  int        `json:"version"`
+	Lines    []CartLine `json:"lines"`
+	// Сумма по текущим ценам.
+	Subtotal  float64        `json:"subtotal"`
+	Warnings  []CartWarning  `json:"warnings"`
+	UpdatedAt OptNilDateTime `json:"updated_at"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Cart) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetVersion returns the value of Version.
+func (s *Cart) GetVersion() int {
+	return s.Version
+}
+
+// GetLines returns the value of Lines.
+func (s *Cart) GetLines() []CartLine {
+	return s.Lines
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *Cart) GetSubtotal() float64 {
+	return s.Subtotal
+}
+
+// GetWarnings returns the value of Warnings.
+func (s *Cart) GetWarnings() []CartWarning {
+	return s.Warnings
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Cart) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Cart) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Cart) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetLines sets the value of Lines.
+func (s *Cart) SetLines(val []CartLine) {
+	s.Lines = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *Cart) SetSubtotal(val float64) {
+	s.Subtotal = val
+}
+
+// SetWarnings sets the value of Warnings.
+func (s *Cart) SetWarnings(val []CartWarning) {
+	s.Warnings = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Cart) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*Cart) addCartItemRes()    {}
+func (*Cart) getCartRes()        {}
+func (*Cart) removeCartItemRes() {}
+func (*Cart) updateCartItemRes() {}
+
+// Ref: #/components/schemas/CartLine
+type CartLine struct {
+	PartUUID string `json:"part_uuid"`
+	Quantity int    `json:"quantity"`
+	// Цена на момент добавления в корзину.
+	AddedPrice float64 `json:"added_price"`
+	// Текущая цена.
+	Price         float64   `json:"price"`
+	Name          string    `json:"name"`
+	StockQuantity int       `json:"stock_quantity"`
+	Available     bool      `json:"available"`
+	AddedAt       time.Time `json:"added_at"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *CartLine) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CartLine) GetQuantity() int {
+	return s.Quantity
+}
+
+// GetAddedPrice returns the value of AddedPrice.
+func (s *CartLine) GetAddedPrice() float64 {
+	return s.AddedPrice
+}
+
+// GetPrice returns the value of Price.
+func (s *CartLine) GetPrice() float64 {
+	return s.Price
+}
+
+// GetName returns the value of Name.
+func (s *CartLine) GetName() string {
+	return s.Name
+}
+
+// GetStockQuantity returns the value of StockQuantity.
+func (s *CartLine) GetStockQuantity() int {
+	return s.StockQuantity
+}
+
+// GetAvailable returns the value of Available.
+func (s *CartLine) GetAvailable() bool {
+	return s.Available
+}
+
+// GetAddedAt returns the value of AddedAt.
+func (s *CartLine) GetAddedAt() time.Time {
+	return s.AddedAt
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *CartLine) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CartLine) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// SetAddedPrice sets the value of AddedPrice.
+func (s *CartLine) SetAddedPrice(val float64) {
+	s.AddedPrice = val
+}
+
+// SetPrice sets the value of Price.
+func (s *CartLine) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetName sets the value of Name.
+func (s *CartLine) SetName(val string) {
+	s.Name = val
+}
+
+// SetStockQuantity sets the value of StockQuantity.
+func (s *CartLine) SetStockQuantity(val int) {
+	s.StockQuantity = val
+}
+
+// SetAvailable sets the value of Available.
+func (s *CartLine) SetAvailable(val bool) {
+	s.Available = val
+}
+
+// SetAddedAt sets the value of AddedAt.
+func (s *CartLine) SetAddedAt(val time.Time) {
+	s.AddedAt = val
+}
+
+// Ref: #/components/schemas/CartWarning
+type CartWarning struct {
+	PartUUID string          `json:"part_uuid"`
+	Code     CartWarningCode `json:"code"`
+	Message  string          `json:"message"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *CartWarning) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetCode returns the value of Code.
+func (s *CartWarning) GetCode() CartWarningCode {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *CartWarning) GetMessage() string {
+	return s.Message
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *CartWarning) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetCode sets the value of Code.
+func (s *CartWarning) SetCode(val CartWarningCode) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *CartWarning) SetMessage(val string) {
+	s.Message = val
+}
+
+type CartWarningCode string
+
+const (
+	CartWarningCodePRICECHANGED   CartWarningCode = "PRICE_CHANGED"
+	CartWarningCodePARTNOTFOUND   CartWarningCode = "PART_NOT_FOUND"
+	CartWarningCodeNOTENOUGHSTOCK CartWarningCode = "NOT_ENOUGH_STOCK"
+)
+
+// AllValues returns all CartWarningCode values.
+func (CartWarningCode) AllValues() []CartWarningCode {
+	return []CartWarningCode{
+		CartWarningCodePRICECHANGED,
+		CartWarningCodePARTNOTFOUND,
+		CartWarningCodeNOTENOUGHSTOCK,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CartWarningCode) MarshalText() ([]byte, error) {
+	switch s {
+	case CartWarningCodePRICECHANGED:
+		return []byte(s), nil
+	case CartWarningCodePARTNOTFOUND:
+		return []byte(s), nil
+	case CartWarningCodeNOTENOUGHSTOCK:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CartWarningCode) UnmarshalText(data []byte) error {
+	switch CartWarningCode(data) {
+	case CartWarningCodePRICECHANGED:
+		*s = CartWarningCodePRICECHANGED
+		return nil
+	case CartWarningCodePARTNOTFOUND:
+		*s = CartWarningCodePARTNOTFOUND
+		return nil
+	case CartWarningCodeNOTENOUGHSTOCK:
+		*s = CartWarningCodeNOTENOUGHSTOCK
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CheckoutCartBadRequest Error
+
+func (*CheckoutCartBadRequest) checkoutCartRes() {}
+
+type CheckoutCartConflict Error
+
+func (*CheckoutCartConflict) checkoutCartRes() {}
+
+type CheckoutCartInternalServerError Error
+
+func (*CheckoutCartInternalServerError) checkoutCartRes() {}
+
+type CheckoutCartNotFound Error
+
+func (*CheckoutCartNotFound) checkoutCartRes() {}
+
+// Ref: #/components/schemas/CheckoutRequest
+type CheckoutRequest struct {
+	PromoCode       OptString  `json:"promo_code"`
+	BuyerCountry    OptString  `json:"buyer_country"`
+	ShippingOption  OptString  `json:"shipping_option"`
+	DeliveryAddress OptAddress `json:"delivery_address"`
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *CheckoutRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetBuyerCountry returns the value of BuyerCountry.
+func (s *CheckoutRequest) GetBuyerCountry() OptString {
+	return s.BuyerCountry
+}
+
+// GetShippingOption returns the value of ShippingOption.
+func (s *CheckoutRequest) GetShippingOption() OptString {
+	return s.ShippingOption
+}
+
+// GetDeliveryAddress returns the value of DeliveryAddress.
+func (s *CheckoutRequest) GetDeliveryAddress() OptAddress {
+	return s.DeliveryAddress
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *CheckoutRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetBuyerCountry sets the value of BuyerCountry.
+func (s *CheckoutRequest) SetBuyerCountry(val OptString) {
+	s.BuyerCountry = val
+}
+
+// SetShippingOption sets the value of ShippingOption.
+func (s *CheckoutRequest) SetShippingOption(val OptString) {
+	s.ShippingOption = val
+}
+
+// SetDeliveryAddress sets the value of DeliveryAddress.
+func (s *CheckoutRequest) SetDeliveryAddress(val OptAddress) {
+	s.DeliveryAddress = val
+}
+
+// Ref: #/components/schemas/ConfigurationViolation
+type ConfigurationViolation struct {
+	PartUUID       string                     `json:"part_uuid"`
+	Rule           ConfigurationViolationRule `json:"rule"`
+	TargetPartUUID OptString                  `json:"target_part_uuid"`
+	TargetCategory OptString                  `json:"target_category"`
+	Message        string                     `json:"message"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *ConfigurationViolation) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetRule returns the value of Rule.
+func (s *ConfigurationViolation) GetRule() ConfigurationViolationRule {
+	return s.Rule
+}
+
+// GetTargetPartUUID returns the value of TargetPartUUID.
+func (s *ConfigurationViolation) GetTargetPartUUID() OptString {
+	return s.TargetPartUUID
+}
+
+// GetTargetCategory returns the value of TargetCategory.
+func (s *ConfigurationViolation) GetTargetCategory() OptString {
+	return s.TargetCategory
+}
+
+// GetMessage returns the value of Message.
+func (s *ConfigurationViolation) GetMessage() string {
+	return s.Message
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *ConfigurationViolation) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetRule sets the value of Rule.
+func (s *ConfigurationViolation) SetRule(val ConfigurationViolationRule) {
+	s.Rule = val
+}
+
+// SetTargetPartUUID sets the value of TargetPartUUID.
+func (s *ConfigurationViolation) SetTargetPartUUID(val OptString) {
+	s.TargetPartUUID = val
+}
+
+// SetTargetCategory sets the value of TargetCategory.
+func (s *ConfigurationViolation) SetTargetCategory(val OptString) {
+	s.TargetCategory = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ConfigurationViolation) SetMessage(val string) {
+	s.Message = val
+}
+
+type ConfigurationViolationRule string
+
+const (
+	ConfigurationViolationRuleREQUIRES       ConfigurationViolationRule = "REQUIRES"
+	ConfigurationViolationRuleEXCLUDES       ConfigurationViolationRule = "EXCLUDES"
+	ConfigurationViolationRuleCOMPATIBLEWITH ConfigurationViolationRule = "COMPATIBLE_WITH"
+	ConfigurationViolationRuleUNKNOWNPART    ConfigurationViolationRule = "UNKNOWN_PART"
+)
+
+// AllValues returns all ConfigurationViolationRule values.
+func (ConfigurationViolationRule) AllValues() []ConfigurationViolationRule {
+	return []ConfigurationViolationRule{
+		ConfigurationViolationRuleREQUIRES,
+		ConfigurationViolationRuleEXCLUDES,
+		ConfigurationViolationRuleCOMPATIBLEWITH,
+		ConfigurationViolationRuleUNKNOWNPART,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ConfigurationViolationRule) MarshalText() ([]byte, error) {
+	switch s {
+	case ConfigurationViolationRuleREQUIRES:
+		return []byte(s), nil
+	case ConfigurationViolationRuleEXCLUDES:
+		return []byte(s), nil
+	case ConfigurationViolationRuleCOMPATIBLEWITH:
+		return []byte(s), nil
+	case ConfigurationViolationRuleUNKNOWNPART:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ConfigurationViolationRule) UnmarshalText(data []byte) error {
+	switch ConfigurationViolationRule(data) {
+	case ConfigurationViolationRuleREQUIRES:
+		*s = ConfigurationViolationRuleREQUIRES
+		return nil
+	case ConfigurationViolationRuleEXCLUDES:
+		*s = ConfigurationViolationRuleEXCLUDES
+		return nil
+	case ConfigurationViolationRuleCOMPATIBLEWITH:
+		*s = ConfigurationViolationRuleCOMPATIBLEWITH
+		return nil
+	case ConfigurationViolationRuleUNKNOWNPART:
+		*s = ConfigurationViolationRuleUNKNOWNPART
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateOrderBadRequest Error
+
+func (*CreateOrderBadRequest) createOrderRes() {}
+
+type CreateOrderConflict Error
+
+func (*CreateOrderConflict) createOrderRes() {}
+
+type CreateOrderInternalServerError Error
+
+func (*CreateOrderInternalServerError) createOrderRes() {}
+
+type CreateOrderNotFound Error
+
+func (*CreateOrderNotFound) createOrderRes() {}
+
+// Ref: #/components/schemas/CreateOrderRequest
+type CreateOrderRequest struct {
+	UserUUID string                        `json:"user_uuid"`
+	Items    []CreateOrderRequestItemsItem `json:"items"`
+	// Промокод; при ошибке возвращается 400 с кодом PROMO_*.
+	PromoCode OptString `json:"promo_code"`
+	// Страна покупателя для расчёта налогов; по умолчанию
+	// страна из конфигурации.
+	BuyerCountry OptString `json:"buyer_country"`
+	// Код варианта доставки из /api/v1/shipping/quotes; доставка
+	// считается в страну адреса доставки, без адреса — в
+	// страну покупателя.
+	ShippingOption  OptString  `json:"shipping_option"`
+	DeliveryAddress OptAddress `json:"delivery_address"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *CreateOrderRequest) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *CreateOrderRequest) GetItems() []CreateOrderRequestItemsItem {
+	return s.Items
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *CreateOrderRequest) GetPromoCode() OptString {
+	return s.PromoCode
+}
+
+// GetBuyerCountry returns the value of BuyerCountry.
+func (s *CreateOrderRequest) GetBuyerCountry() OptString {
+	return s.BuyerCountry
+}
+
+// GetShippingOption returns the value of ShippingOption.
+func (s *CreateOrderRequest) GetShippingOption() OptString {
+	return s.ShippingOption
+}
+
+// GetDeliveryAddress returns the value of DeliveryAddress.
+func (s *CreateOrderRequest) GetDeliveryAddress() OptAddress {
+	return s.DeliveryAddress
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *CreateOrderRequest) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetItems sets the value of Items.
+func (s *CreateOrderRequest) SetItems(val []CreateOrderRequestItemsItem) {
+	s.Items = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *CreateOrderRequest) SetPromoCode(val OptString) {
+	s.PromoCode = val
+}
+
+// SetBuyerCountry sets the value of BuyerCountry.
+func (s *CreateOrderRequest) SetBuyerCountry(val OptString) {
+	s.BuyerCountry = val
+}
+
+// SetShippingOption sets the value of ShippingOption.
+func (s *CreateOrderRequest) SetShippingOption(val OptString) {
+	s.ShippingOption = val
+}
+
+// SetDeliveryAddress sets the value of DeliveryAddress.
+func (s *CreateOrderRequest) SetDeliveryAddress(val OptAddress) {
+	s.DeliveryAddress = val
+}
+
+type CreateOrderRequestItemsItem struct {
+	PartUUID string  `json:"part_uuid"`
+	Quantity float64 `json:"quantity"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *CreateOrderRequestItemsItem) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderRequestItemsItem) GetQuantity() float64 {
+	return s.Quantity
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *CreateOrderRequestItemsItem) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderRequestItemsItem) SetQuantity(val float64) {
+	s.Quantity = val
+}
+
+// Ref: #/components/schemas/CreateOrderResponse
+type CreateOrderResponse struct {
+	OrderUUID     string  `json:"order_uuid"`
+	Subtotal      float64 `json:"subtotal"`
+	DiscountTotal float64 `json:"discount_total"`
+	TaxTotal      float64 `json:"tax_total"`
+	DutyTotal     float64 `json:"duty_total"`
+	ShippingCost  float64 `json:"shipping_cost"`
+	TotalPrice    float64 `json:"total_price"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *CreateOrderResponse) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *CreateOrderResponse) GetSubtotal() float64 {
+	return s.Subtotal
+}
+
+// GetDiscountTotal returns the value of DiscountTotal.
+func (s *CreateOrderResponse) GetDiscountTotal() float64 {
+	return s.DiscountTotal
+}
+
+// GetTaxTotal returns the value of TaxTotal.
+func (s *CreateOrderResponse) GetTaxTotal() float64 {
+	return s.TaxTotal
+}
+
+// GetDutyTotal returns the value of DutyTotal.
+func (s *CreateOrderResponse) GetDutyTotal() float64 {
+	return s.DutyTotal
+}
+
+// GetShippingCost returns the value of ShippingCost.
+func (s *CreateOrderResponse) GetShippingCost() float64 {
+	return s.ShippingCost
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *CreateOrderResponse) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *CreateOrderResponse) SetOrderUUID(val string) {
+	s.OrderUUID = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *CreateOrderResponse) SetSubtotal(val float64) {
+	s.Subtotal = val
+}
+
+// SetDiscountTotal sets the value of DiscountTotal.
+func (s *CreateOrderResponse) SetDiscountTotal(val float64) {
+	s.DiscountTotal = val
+}
+
+// SetTaxTotal sets the value of TaxTotal.
+func (s *CreateOrderResponse) SetTaxTotal(val float64) {
+	s.TaxTotal = val
+}
+
+// SetDutyTotal sets the value of DutyTotal.
+func (s *CreateOrderResponse) SetDutyTotal(val float64) {
+	s.DutyTotal = val
+}
+
+// SetShippingCost sets the value of ShippingCost.
+func (s *CreateOrderResponse) SetShippingCost(val float64) {
+	s.ShippingCost = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *CreateOrderResponse) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+func (*CreateOrderResponse) acceptQuoteRes()  {}
+func (*CreateOrderResponse) checkoutCartRes() {}
+func (*CreateOrderResponse) createOrderRes()  {}
+
+type CreatePromoCodeBadRequest Error
+
+func (*CreatePromoCodeBadRequest) createPromoCodeRes() {}
+
+type CreatePromoCodeConflict Error
+
+func (*CreatePromoCodeConflict) createPromoCodeRes() {}
+
+// Value — процент скидки для PERCENTAGE, CATEGORY_PERCENTAGE и BUY_X_GET_Y или
+// сумма для FIXED_AMOUNT.
+// CATEGORY_PERCENTAGE требует category. BUY_X_GET_Y за каждые buy_quantity
+// деталей category
+// даёт скидку value процентов на reward_quantity самых дешёвых
+// деталей reward_category
+// (по умолчанию — той же category). Незаданные лимиты и
+// сроки не ограничены.
+// Ref: #/components/schemas/CreatePromoCodeRequest
+type CreatePromoCodeRequest struct {
+	Code           string        `json:"code"`
+	RuleType       PromoRuleType `json:"rule_type"`
+	Value          float64       `json:"value"`
+	Category       OptString     `json:"category"`
+	BuyQuantity    OptInt        `json:"buy_quantity"`
+	RewardCategory OptString     `json:"reward_category"`
+	RewardQuantity OptInt        `json:"reward_quantity"`
+	MinSubtotal    OptFloat64    `json:"min_subtotal"`
+	ValidFrom      OptDateTime   `json:"valid_from"`
+	ValidTo        OptDateTime   `json:"valid_to"`
+	MaxUses        OptInt        `json:"max_uses"`
+	PerUserLimit   OptInt        `json:"per_user_limit"`
+}
+
+// GetCode returns the value of Code.
+func (s *CreatePromoCodeRequest) GetCode() string {
+	return s.Code
+}
+
+// GetRuleType returns the value of RuleType.
+func (s *CreatePromoCodeRequest) GetRuleType() PromoRuleType {
+	return s.RuleType
+}
+
+// GetValue returns the value of Value.
+func (s *CreatePromoCodeRequest) GetValue() float64 {
+	return s.Value
+}
+
+// GetCategory returns the value of Category.
+func (s *CreatePromoCodeRequest) GetCategory() OptString {
+	return s.Category
+}
+
+// GetBuyQuantity returns the value of BuyQuantity.
+func (s *CreatePromoCodeRequest) GetBuyQuantity() OptInt {
+	return s.BuyQuantity
+}
+
+// GetRewardCategory returns the value of RewardCategory.
+func (s *CreatePromoCodeRequest) GetRewardCategory() OptString {
+	return s.RewardCategory
+}
+
+// GetRewardQuantity returns the value of RewardQuantity.
+func (s *CreatePromoCodeRequest) GetRewardQuantity() OptInt {
+	return s.RewardQuantity
+}
+
+// GetMinSubtotal returns the value of MinSubtotal.
+func (s *CreatePromoCodeRequest) GetMinSubtotal() OptFloat64 {
+	return s.MinSubtotal
+}
+
+// GetValidFrom returns the value of ValidFrom.
+func (s *CreatePromoCodeRequest) GetValidFrom() OptDateTime {
+	return s.ValidFrom
+}
+
+// GetValidTo returns the value of ValidTo.
+func (s *CreatePromoCodeRequest) GetValidTo() OptDateTime {
+	return s.ValidTo
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *CreatePromoCodeRequest) GetMaxUses() OptInt {
+	return s.MaxUses
+}
+
+// GetPerUserLimit returns the value of PerUserLimit.
+func (s *CreatePromoCodeRequest) GetPerUserLimit() OptInt {
+	return s.PerUserLimit
+}
+
+// SetCode sets the value of Code.
+func (s *CreatePromoCodeRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetRuleType sets the value of RuleType.
+func (s *CreatePromoCodeRequest) SetRuleType(val PromoRuleType) {
+	s.RuleType = val
+}
+
+// SetValue sets the value of Value.
+func (s *CreatePromoCodeRequest) SetValue(val float64) {
+	s.Value = val
+}
+
+// SetCategory sets the value of Category.
+func (s *CreatePromoCodeRequest) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetBuyQuantity sets the value of BuyQuantity.
+func (s *CreatePromoCodeRequest) SetBuyQuantity(val OptInt) {
+	s.BuyQuantity = val
+}
+
+// SetRewardCategory sets the value of RewardCategory.
+func (s *CreatePromoCodeRequest) SetRewardCategory(val OptString) {
+	s.RewardCategory = val
+}
+
+// SetRewardQuantity sets the value of RewardQuantity.
+func (s *CreatePromoCodeRequest) SetRewardQuantity(val OptInt) {
+	s.RewardQuantity = val
+}
+
+// SetMinSubtotal sets the value of MinSubtotal.
+func (s *CreatePromoCodeRequest) SetMinSubtotal(val OptFloat64) {
+	s.MinSubtotal = val
+}
+
+// SetValidFrom sets the value of ValidFrom.
+func (s *CreatePromoCodeRequest) SetValidFrom(val OptDateTime) {
+	s.ValidFrom = val
+}
+
+// SetValidTo sets the value of ValidTo.
+func (s *CreatePromoCodeRequest) SetValidTo(val OptDateTime) {
+	s.ValidTo = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *CreatePromoCodeRequest) SetMaxUses(val OptInt) {
+	s.MaxUses = val
+}
+
+// SetPerUserLimit sets the value of PerUserLimit.
+func (s *CreatePromoCodeRequest) SetPerUserLimit(val OptInt) {
+	s.PerUserLimit = val
+}
+
+type CreateQuoteBadRequest Error
+
+func (*CreateQuoteBadRequest) createQuoteRes() {}
+
+type CreateQuoteNotFound Error
+
+func (*CreateQuoteNotFound) createQuoteRes() {}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Message string    `json:"message"`
+	Code    OptString `json:"code"`
+	// Ошибки по отдельным строкам запроса.
+	Details []LineError `json:"details"`
+	// Нарушенные правила совместимости деталей.
+	Violations []ConfigurationViolation `json:"violations"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// GetCode returns the value of Code.
+func (s *Error) GetCode() OptString {
+	return s.Code
+}
+
+// GetDetails returns the value of Details.
+func (s *Error) GetDetails() []LineError {
+	return s.Details
+}
+
+// GetViolations returns the value of Violations.
+func (s *Error) GetViolations() []ConfigurationViolation {
+	return s.Violations
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetCode sets the value of Code.
+func (s *Error) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetDetails sets the value of Details.
+func (s *Error) SetDetails(val []LineError) {
+	s.Details = val
+}
+
+// SetViolations sets the value of Violations.
+func (s *Error) SetViolations(val []ConfigurationViolation) {
+	s.Violations = val
+}
+
+func (*Error) deactivatePromoCodeRes() {}
+func (*Error) getQuoteRes()            {}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+type GetCartBadRequest Error
+
+func (*GetCartBadRequest) getCartRes() {}
+
+type GetCartInternalServerError Error
+
+func (*GetCartInternalServerError) getCartRes() {}
+
+type GetOrderHistoryInternalServerError Error
+
+func (*GetOrderHistoryInternalServerError) getOrderHistoryRes() {}
+
+type GetOrderHistoryNotFound Error
+
+func (*GetOrderHistoryNotFound) getOrderHistoryRes() {}
+
+type GetOrderInternalServerError Error
+
+func (*GetOrderInternalServerError) getOrderRes() {}
+
+type GetOrderNotFound Error
+
+func (*GetOrderNotFound) getOrderRes() {}
+
+type GetOrderShipmentInternalServerError Error
+
+func (*GetOrderShipmentInternalServerError) getOrderShipmentRes() {}
+
+type GetOrderShipmentNotFound Error
+
+func (*GetOrderShipmentNotFound) getOrderShipmentRes() {}
+
+// Ref: #/components/schemas/LineError
+type LineError struct {
+	// Индекс строки в запросе, начиная с 0.
+	Line     int           `json:"line"`
+	PartUUID OptString     `json:"part_uuid"`
+	Code     LineErrorCode `json:"code"`
+	Message  string        `json:"message"`
+}
+
+// GetLine returns the value of Line.
+func (s *LineError) GetLine() int {
+	return s.Line
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *LineError) GetPartUUID() OptString {
+	return s.PartUUID
+}
+
+// GetCode returns the value of Code.
+func (s *LineError) GetCode() LineErrorCode {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *LineError) GetMessage() string {
+	return s.Message
+}
+
+// SetLine sets the value of Line.
+func (s *LineError) SetLine(val int) {
+	s.Line = val
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *LineError) SetPartUUID(val OptString) {
+	s.PartUUID = val
+}
+
+// SetCode sets the value of Code.
+func (s *LineError) SetCode(val LineErrorCode) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *LineError) SetMessage(val string) {
+	s.Message = val
+}
+
+type LineErrorCode string
+
+const (
+	LineErrorCodeINVALIDPARTUUID LineErrorCode = "INVALID_PART_UUID"
+	LineErrorCodeINVALIDQUANTITY LineErrorCode = "INVALID_QUANTITY"
+	LineErrorCodePARTNOTFOUND    LineErrorCode = "PART_NOT_FOUND"
+	LineErrorCodeNOTENOUGHSTOCK  LineErrorCode = "NOT_ENOUGH_STOCK"
+)
+
+// AllValues returns all LineErrorCode values.
+func (LineErrorCode) AllValues() []LineErrorCode {
+	return []LineErrorCode{
+		LineErrorCodeINVALIDPARTUUID,
+		LineErrorCodeINVALIDQUANTITY,
+		LineErrorCodePARTNOTFOUND,
+		LineErrorCodeNOTENOUGHSTOCK,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LineErrorCode) MarshalText() ([]byte, error) {
+	switch s {
+	case LineErrorCodeINVALIDPARTUUID:
+		return []byte(s), nil
+	case LineErrorCodeINVALIDQUANTITY:
+		return []byte(s), nil
+	case LineErrorCodePARTNOTFOUND:
+		return []byte(s), nil
+	case LineErrorCodeNOTENOUGHSTOCK:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LineErrorCode) UnmarshalText(data []byte) error {
+	switch LineErrorCode(data) {
+	case LineErrorCodeINVALIDPARTUUID:
+		*s = LineErrorCodeINVALIDPARTUUID
+		return nil
+	case LineErrorCodeINVALIDQUANTITY:
+		*s = LineErrorCodeINVALIDQUANTITY
+		return nil
+	case LineErrorCodePARTNOTFOUND:
+		*s = LineErrorCodePARTNOTFOUND
+		return nil
+	case LineErrorCodeNOTENOUGHSTOCK:
+		*s = LineErrorCodeNOTENOUGHSTOCK
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewOptAddress returns new OptAddress with value set to v.
+func NewOptAddress(v Address) OptAddress {
+	return OptAddress{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddress is optional Address.
+type OptAddress struct {
+	Value Address
+	Set   bool
+}
+
+// IsSet returns true if OptAddress was set.
+func (o OptAddress) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddress) Reset() {
+	var v Address
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddress) SetTo(v Address) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddress) Get() (v Address, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddress) Or(d Address) Address {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCancelOrderRequest returns new OptCancelOrderRequest with value set to v.
+func NewOptCancelOrderRequest(v CancelOrderRequest) OptCancelOrderRequest {
+	return OptCancelOrderRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCancelOrderRequest is optional CancelOrderRequest.
+type OptCancelOrderRequest struct {
+	Value CancelOrderRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCancelOrderRequest was set.
+func (o OptCancelOrderRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCancelOrderRequest) Reset() {
+	var v CancelOrderRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCancelOrderRequest) SetTo(v CancelOrderRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCancelOrderRequest) Get() (v CancelOrderRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCancelOrderRequest) Or(d CancelOrderRequest) CancelOrderRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCancelReason returns new OptCancelReason with value set to v.
+func NewOptCancelReason(v CancelReason) OptCancelReason {
+	return OptCancelReason{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCancelReason is optional CancelReason.
+type OptCancelReason struct {
+	Value CancelReason
+	Set   bool
+}
+
+// IsSet returns true if OptCancelReason was set.
+func (o OptCancelReason) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCancelReason) Reset() {
+	var v CancelReason
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCancelReason) SetTo(v CancelReason) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCancelReason) Get() (v CancelReason, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCancelReason) Or(d CancelReason) CancelReason {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCaptureOrderRequest returns new OptCaptureOrderRequest with value set to v.
+func NewOptCaptureOrderRequest(v CaptureOrderRequest) OptCaptureOrderRequest {
+	return OptCaptureOrderRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCaptureOrderRequest is optional CaptureOrderRequest.
+type OptCaptureOrderRequest struct {
+	Value CaptureOrderRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCaptureOrderRequest was set.
+func (o OptCaptureOrderRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCaptureOrderRequest) Reset() {
+	var v CaptureOrderRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCaptureOrderRequest) SetTo(v CaptureOrderRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCaptureOrderRequest) Get() (v CaptureOrderRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCaptureOrderRequest) Or(d CaptureOrderRequest) CaptureOrderRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCheckoutRequest returns new OptCheckoutRequest with value set to v.
+func NewOptCheckoutRequest(v CheckoutRequest) OptCheckoutRequest {
+	return OptCheckoutRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCheckoutRequest is optional CheckoutRequest.
+type OptCheckoutRequest struct {
+	Value CheckoutRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCheckoutRequest was set.
+func (o OptCheckoutRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCheckoutRequest) Reset() {
+	var v CheckoutRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCheckoutRequest) SetTo(v CheckoutRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCheckoutRequest) Get() (v CheckoutRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCheckoutRequest) Or(d CheckoutRequest) CheckoutRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilAddress returns new OptNilAddress with value set to v.
+func NewOptNilAddress(v Address) OptNilAddress {
+	return OptNilAddress{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilAddress is optional nullable Address.
+type OptNilAddress struct {
+	Value Address
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilAddress was set.
+func (o OptNilAddress) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilAddress) Reset() {
+	var v Address
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilAddress) SetTo(v Address) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilAddress) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilAddress) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v Address
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilAddress) Get() (v Address, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilAddress) Or(d Address) Address {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrderPaymentMethod returns new OptNilOrderPaymentMethod with value set to v.
+func NewOptNilOrderPaymentMethod(v OrderPaymentMethod) OptNilOrderPaymentMethod {
+	return OptNilOrderPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrderPaymentMethod is optional nullable OrderPaymentMethod.
+type OptNilOrderPaymentMethod struct {
+	Value OrderPaymentMethod
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrderPaymentMethod was set.
+func (o OptNilOrderPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrderPaymentMethod) Reset() {
+	var v OrderPaymentMethod
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrderPaymentMethod) SetTo(v OrderPaymentMethod) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilOrderPaymentMethod) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilOrderPaymentMethod) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v OrderPaymentMethod
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrderPaymentMethod) Get() (v OrderPaymentMethod, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrderPaymentMethod) Or(d OrderPaymentMethod) OrderPaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrderStatus returns new OptNilOrderStatus with value set to v.
+func NewOptNilOrderStatus(v OrderStatus) OptNilOrderStatus {
+	return OptNilOrderStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrderStatus is optional nullable OrderStatus.
+type OptNilOrderStatus struct {
+	Value OrderStatus
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrderStatus was set.
+func (o OptNilOrderStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrderStatus) Reset() {
+	var v OrderStatus
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrderStatus) SetTo(v OrderStatus) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilOrderStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilOrderStatus) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v OrderStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrderStatus) Get() (v OrderStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrderStatus) Or(d OrderStatus) OrderStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Order
+type Order struct {
+	OrderUUID string      `json:"order_uuid"`
+	UserUUID  string      `json:"user_uuid"`
+	Items     []OrderItem `json:"items"`
+	// Сумма заказа без скидок.
+	Subtotal      float64 `json:"subtotal"`
+	DiscountTotal float64 `json:"discount_total"`
+	TaxTotal      float64 `json:"tax_total"`
+	DutyTotal     float64 `json:"duty_total"`
+	ShippingCost  float64 `json:"shipping_cost"`
+	// Итого к оплате subtotal - discount_total + tax_total + duty_total + shipping_cost.
+	TotalPrice      float64                  `json:"total_price"`
+	PromoCode       OptNilString             `json:"promo_code"`
+	BuyerCountry    OptNilString             `json:"buyer_country"`
+	ShippingCarrier OptNilString             `json:"shipping_carrier"`
+	ShippingOption  OptNilString             `json:"shipping_option"`
+	DeliveryAddress OptNilAddress            `json:"delivery_address"`
+	TransactionUUID OptNilString             `json:"transaction_uuid"`
+	PaymentMethod   OptNilOrderPaymentMethod `json:"payment_method"`
+	Status          OrderStatus              `json:"status"`
+	// Заблокированная сумма при двухэтапной оплате.
+	AuthorizedAmount OptNilFloat64 `json:"authorized_amount"`
+	// Списанная из заблокированной сумма.
+	CapturedAmount         OptNilFloat64  `json:"captured_amount"`
+	AuthorizationExpiresAt OptNilDateTime `json:"authorization_expires_at"`
+	// До какого времени ожидается подтверждение оплаты
+	// (PAYMENT_PENDING).
+	PaymentDeadline OptNilDateTime `json:"payment_deadline"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Order) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Order) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetItems returns the value of Items.
+func (s *Order) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *Order) GetSubtotal() float64 {
+	return s.Subtotal
+}
+
+// GetDiscountTotal returns the value of DiscountTotal.
+func (s *Order) GetDiscountTotal() float64 {
+	return s.DiscountTotal
+}
+
+// GetTaxTotal returns the value of TaxTotal.
+func (s *Order) GetTaxTotal() float64 {
+	return s.TaxTotal
+}
+
+// GetDutyTotal returns the value of DutyTotal.
+func (s *Order) GetDutyTotal() float64 {
+	return s.DutyTotal
+}
+
+// GetShippingCost returns the value of ShippingCost.
+func (s *Order) GetShippingCost() float64 {
+	return s.ShippingCost
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Order) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *Order) GetPromoCode() OptNilString {
+	return s.PromoCode
+}
+
+// GetBuyerCountry returns the value of BuyerCountry.
+func (s *Order) GetBuyerCountry() OptNilString {
+	return s.BuyerCountry
+}
+
+// GetShippingCarrier returns the value of ShippingCarrier.
+func (s *Order) GetShippingCarrier() OptNilString {
+	return s.ShippingCarrier
+}
+
+// GetShippingOption returns the value of ShippingOption.
+func (s *Order) GetShippingOption() OptNilString {
+	return s.ShippingOption
+}
+
+// GetDeliveryAddress returns the value of DeliveryAddress.
+func (s *Order) GetDeliveryAddress() OptNilAddress {
+	return s.DeliveryAddress
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *Order) GetTransactionUUID() OptNilString {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *Order) GetPaymentMethod() OptNilOrderPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetStatus returns the value of Status.
+func (s *Order) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// GetAuthorizedAmount returns the value of AuthorizedAmount.
+func (s *Order) GetAuthorizedAmount() OptNilFloat64 {
+	return s.AuthorizedAmount
+}
+
+// GetCapturedAmount returns the value of CapturedAmount.
+func (s *Order) GetCapturedAmount() OptNilFloat64 {
+	return s.CapturedAmount
+}
+
+// GetAuthorizationExpiresAt returns the value of AuthorizationExpiresAt.
+func (s *Order) GetAuthorizationExpiresAt() OptNilDateTime {
+	return s.AuthorizationExpiresAt
+}
+
+// GetPaymentDeadline returns the value of PaymentDeadline.
+func (s *Order) GetPaymentDeadline() OptNilDateTime {
+	return s.PaymentDeadline
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Order) SetOrderUUID(val string) {
+	s.OrderUUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Order) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetItems sets the value of Items.
+func (s *Order) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *Order) SetSubtotal(val float64) {
+	s.Subtotal = val
+}
+
+// SetDiscountTotal sets the value of DiscountTotal.
+func (s *Order) SetDiscountTotal(val float64) {
+	s.DiscountTotal = val
+}
+
+// SetTaxTotal sets the value of TaxTotal.
+func (s *Order) SetTaxTotal(val float64) {
+	s.TaxTotal = val
+}
+
+// SetDutyTotal sets the value of DutyTotal.
+func (s *Order) SetDutyTotal(val float64) {
+	s.DutyTotal = val
+}
+
+// SetShippingCost sets the value of ShippingCost.
+func (s *Order) SetShippingCost(val float64) {
+	s.ShippingCost = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *Order) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *Order) SetPromoCode(val OptNilString) {
+	s.PromoCode = val
+}
+
+// SetBuyerCountry sets the value of BuyerCountry.
+func (s *Order) SetBuyerCountry(val OptNilString) {
+	s.BuyerCountry = val
+}
+
+// SetShippingCarrier sets the value of ShippingCarrier.
+func (s *Order) SetShippingCarrier(val OptNilString) {
+	s.ShippingCarrier = val
+}
+
+// SetShippingOption sets the value of ShippingOption.
+func (s *Order) SetShippingOption(val OptNilString) {
+	s.ShippingOption = val
+}
+
+// SetDeliveryAddress sets the value of DeliveryAddress.
+func (s *Order) SetDeliveryAddress(val OptNilAddress) {
+	s.DeliveryAddress = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *Order) SetTransactionUUID(val OptNilString) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *Order) SetPaymentMethod(val OptNilOrderPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Order) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// SetAuthorizedAmount sets the value of AuthorizedAmount.
+func (s *Order) SetAuthorizedAmount(val OptNilFloat64) {
+	s.AuthorizedAmount = val
+}
+
+// SetCapturedAmount sets the value of CapturedAmount.
+func (s *Order) SetCapturedAmount(val OptNilFloat64) {
+	s.CapturedAmount = val
+}
+
+// SetAuthorizationExpiresAt sets the value of AuthorizationExpiresAt.
+func (s *Order) SetAuthorizationExpiresAt(val OptNilDateTime) {
+	s.AuthorizationExpiresAt = val
+}
+
+// SetPaymentDeadline sets the value of PaymentDeadline.
+func (s *Order) SetPaymentDeadline(val OptNilDateTime) {
+	s.PaymentDeadline = val
+}
+
+func (*Order) authorizeOrderRes()   {}
+func (*Order) captureOrderRes()     {}
+func (*Order) getOrderRes()         {}
+func (*Order) paymentCallbackRes()  {}
+func (*Order) updateOrderItemsRes() {}
+
+// Ref: #/components/schemas/OrderHistory
+type OrderHistory struct {
+	OrderUUID   string         `json:"order_uuid"`
+	Status      OrderStatus    `json:"status"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	PaidAt      OptNilDateTime `json:"paid_at"`
+	CancelledAt OptNilDateTime `json:"cancelled_at"`
+	Entries     []StatusChange `json:"entries"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *OrderHistory) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *OrderHistory) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrderHistory) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OrderHistory) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *OrderHistory) GetPaidAt() OptNilDateTime {
+	return s.PaidAt
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *OrderHistory) GetCancelledAt() OptNilDateTime {
+	return s.CancelledAt
+}
+
+// GetEntries returns the value of Entries.
+func (s *OrderHistory) GetEntries() []StatusChange {
+	return s.Entries
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *OrderHistory) SetOrderUUID(val string) {
+	s.OrderUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *OrderHistory) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrderHistory) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OrderHistory) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *OrderHistory) SetPaidAt(val OptNilDateTime) {
+	s.PaidAt = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *OrderHistory) SetCancelledAt(val OptNilDateTime) {
+	s.CancelledAt = val
+}
+
+// SetEntries sets the value of Entries.
+func (s *OrderHistory) SetEntries(val []StatusChange) {
+	s.Entries = val
+}
+
+func (*OrderHistory) getOrderHistoryRes() {}
+
+// Ref: #/components/schemas/OrderItem
+type OrderItem struct {
+	PartUUID            string    `json:"part_uuid"`
+	Quantity            float64   `json:"quantity"`
+	Price               float64   `json:"price"`
+	Name                string    `json:"name"`
+	Category            OptString `json:"category"`
+	ManufacturerCountry OptString `json:"manufacturer_country"`
+	// Скидка на всю строку по промокоду.
+	Discount float64 `json:"discount"`
+	// Налог на строку после скидки (с учётом пошлины).
+	Tax float64 `json:"tax"`
+	// Ввозная пошлина, если страна производителя
+	// отличается от страны покупателя.
+	Duty float64 `json:"duty"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *OrderItem) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OrderItem) GetQuantity() float64 {
+	return s.Quantity
+}
+
+// GetPrice returns the value of Price.
+func (s *OrderItem) GetPrice() float64 {
+	return s.Price
+}
+
+// GetName returns the value of Name.
+func (s *OrderItem) GetName() string {
+	return s.Name
+}
+
+// GetCategory returns the value of Category.
+func (s *OrderItem) GetCategory() OptString {
+	return s.Category
+}
+
+// GetManufacturerCountry returns the value of ManufacturerCountry.
+func (s *OrderItem) GetManufacturerCountry() OptString {
+	return s.ManufacturerCountry
+}
+
+// GetDiscount returns the value of Discount.
+func (s *OrderItem) GetDiscount() float64 {
+	return s.Discount
+}
+
+// GetTax returns the value of Tax.
+func (s *OrderItem) GetTax() float64 {
+	return s.Tax
+}
+
+// GetDuty returns the value of Duty.
+func (s *OrderItem) GetDuty() float64 {
+	return s.Duty
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *OrderItem) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OrderItem) SetQuantity(val float64) {
+	s.Quantity = val
+}
+
+// SetPrice sets the value of Price.
+func (s *OrderItem) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetName sets the value of Name.
+func (s *OrderItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OrderItem) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetManufacturerCountry sets the value of ManufacturerCountry.
+func (s *OrderItem) SetManufacturerCountry(val OptString) {
+	s.ManufacturerCountry = val
+}
+
+// SetDiscount sets the value of Discount.
+func (s *OrderItem) SetDiscount(val float64) {
+	s.Discount = val
+}
+
+// SetTax sets the value of Tax.
+func (s *OrderItem) SetTax(val float64) {
+	s.Tax = val
+}
+
+// SetDuty sets the value of Duty.
+func (s *OrderItem) SetDuty(val float64) {
+	s.Duty = val
+}
+
+type OrderPaymentMethod string
+
+const (
+	OrderPaymentMethodCARD          OrderPaymentMethod = "CARD"
+	OrderPaymentMethodSBP           OrderPaymentMethod = "SBP"
+	OrderPaymentMethodCREDITCARD    OrderPaymentMethod = "CREDIT_CARD"
+	OrderPaymentMethodINVESTORMONEY OrderPaymentMethod = "INVESTOR_MONEY"
+)
+
+// AllValues returns all OrderPaymentMethod values.
+func (OrderPaymentMethod) AllValues() []OrderPaymentMethod {
+	return []OrderPaymentMethod{
+		OrderPaymentMethodCARD,
+		OrderPaymentMethodSBP,
+		OrderPaymentMethodCREDITCARD,
+		OrderPaymentMethodINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderPaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderPaymentMethodCARD:
+		return []byte(s), nil
+	case OrderPaymentMethodSBP:
+		return []byte(s), nil
+	case OrderPaymentMethodCREDITCARD:
+		return []byte(s), nil
+	case OrderPaymentMethodINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderPaymentMethod) UnmarshalText(data []byte) error {
+	switch OrderPaymentMethod(data) {
+	case OrderPaymentMethodCARD:
+		*s = OrderPaymentMethodCARD
+		return nil
+	case OrderPaymentMethodSBP:
+		*s = OrderPaymentMethodSBP
+		return nil
+	case OrderPaymentMethodCREDITCARD:
+		*s = OrderPaymentMethodCREDITCARD
+		return nil
+	case OrderPaymentMethodINVESTORMONEY:
+		*s = OrderPaymentMethodINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/OrderStatus
+type OrderStatus string
+
+const (
+	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAYMENTPENDING OrderStatus = "PAYMENT_PENDING"
+	OrderStatusAUTHORIZED     OrderStatus = "AUTHORIZED"
+	OrderStatusPAID           OrderStatus = "PAID"
+	OrderStatusASSEMBLING     OrderStatus = "ASSEMBLING"
+	OrderStatusSHIPPED        OrderStatus = "SHIPPED"
+	OrderStatusDELIVERED      OrderStatus = "DELIVERED"
+	OrderStatusCANCELLING     OrderStatus = "CANCELLING"
+	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+)
+
+// AllValues returns all OrderStatus values.
+func (OrderStatus) AllValues() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAYMENTPENDING,
+		OrderStatusAUTHORIZED,
+		OrderStatusPAID,
+		OrderStatusASSEMBLING,
+		OrderStatusSHIPPED,
+		OrderStatusDELIVERED,
+		OrderStatusCANCELLING,
+		OrderStatusCANCELLED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderStatusPENDINGPAYMENT:
+		return []byte(s), nil
+	case OrderStatusPAYMENTPENDING:
+		return []byte(s), nil
+	case OrderStatusAUTHORIZED:
+		return []byte(s), nil
+	case OrderStatusPAID:
+		return []byte(s), nil
+	case OrderStatusASSEMBLING:
+		return []byte(s), nil
+	case OrderStatusSHIPPED:
+		return []byte(s), nil
+	case OrderStatusDELIVERED:
+		return []byte(s), nil
+	case OrderStatusCANCELLING:
+		return []byte(s), nil
+	case OrderStatusCANCELLED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderStatus) UnmarshalText(data []byte) error {
+	switch OrderStatus(data) {
+	case OrderStatusPENDINGPAYMENT:
+		*s = OrderStatusPENDINGPAYMENT
+		return nil
+	case OrderStatusPAYMENTPENDING:
+		*s = OrderStatusPAYMENTPENDING
+		return nil
+	case OrderStatusAUTHORIZED:
+		*s = OrderStatusAUTHORIZED
+		return nil
+	case OrderStatusPAID:
+		*s = OrderStatusPAID
+		return nil
+	case OrderStatusASSEMBLING:
+		*s = OrderStatusASSEMBLING
+		return nil
+	case OrderStatusSHIPPED:
+		*s = OrderStatusSHIPPED
+		return nil
+	case OrderStatusDELIVERED:
+		*s = OrderStatusDELIVERED
+		return nil
+	case OrderStatusCANCELLING:
+		*s = OrderStatusCANCELLING
+		return nil
+	case OrderStatusCANCELLED:
+		*s = OrderStatusCANCELLED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PayOrderBadRequest Error
+
+func (*PayOrderBadRequest) payOrderRes() {}
+
+type PayOrderConflict Error
+
+func (*PayOrderConflict) payOrderRes() {}
+
+type PayOrderInternalServerError Error
+
+func (*PayOrderInternalServerError) payOrderRes() {}
+
+type PayOrderNotFound Error
+
+func (*PayOrderNotFound) payOrderRes() {}
+
+type PayOrderPaymentRequired Error
+
+func (*PayOrderPaymentRequired) payOrderRes() {}
+
+// Ref: #/components/schemas/PayOrderRequest
+type PayOrderRequest struct {
+	PaymentMethod PayOrderRequestPaymentMethod `json:"payment_method"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *PayOrderRequest) GetPaymentMethod() PayOrderRequestPaymentMethod {
+	return s.PaymentMethod
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *PayOrderRequest) SetPaymentMethod(val PayOrderRequestPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+type PayOrderRequestPaymentMethod string
+
+const (
+	PayOrderRequestPaymentMethodCARD          PayOrderRequestPaymentMethod = "CARD"
+	PayOrderRequestPaymentMethodSBP           PayOrderRequestPaymentMethod = "SBP"
+	PayOrderRequestPaymentMethodCREDITCARD    PayOrderRequestPaymentMethod = "CREDIT_CARD"
+	PayOrderRequestPaymentMethodINVESTORMONEY PayOrderRequestPaymentMethod = "INVESTOR_MONEY"
+)
+
+// AllValues returns all PayOrderRequestPaymentMethod values.
+func (PayOrderRequestPaymentMethod) AllValues() []PayOrderRequestPaymentMethod {
+	return []PayOrderRequestPaymentMethod{
+		PayOrderRequestPaymentMethodCARD,
+		PayOrderRequestPaymentMethodSBP,
+		PayOrderRequestPaymentMethodCREDITCARD,
+		PayOrderRequestPaymentMethodINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PayOrderRequestPaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case PayOrderRequestPaymentMethodCARD:
+		return []byte(s), nil
+	case PayOrderRequestPaymentMethodSBP:
+		return []byte(s), nil
+	case PayOrderRequestPaymentMethodCREDITCARD:
+		return []byte(s), nil
+	case PayOrderRequestPaymentMethodINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PayOrderRequestPaymentMethod) UnmarshalText(data []byte) error {
+	switch PayOrderRequestPaymentMethod(data) {
+	case PayOrderRequestPaymentMethodCARD:
+		*s = PayOrderRequestPaymentMethodCARD
+		return nil
+	case PayOrderRequestPaymentMethodSBP:
+		*s = PayOrderRequestPaymentMethodSBP
+		return nil
+	case PayOrderRequestPaymentMethodCREDITCARD:
+		*s = PayOrderRequestPaymentMethodCREDITCARD
+		return nil
+	case PayOrderRequestPaymentMethodINVESTORMONEY:
+		*s = PayOrderRequestPaymentMethodINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PayOrderResponse
+type PayOrderResponse struct {
+	TransactionUUID string                 `json:"transaction_uuid"`
+	Status          PayOrderResponseStatus `json:"status"`
+	// Ссылка для завершения оплаты (QR-код СБП), если status PENDING.
+	PaymentURL OptString `json:"payment_url"`
+	// До какого времени можно завершить оплату.
+	ExpiresAt OptDateTime `json:"expires_at"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *PayOrderResponse) GetTransactionUUID() string {
+	return s.TransactionUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *PayOrderResponse) GetStatus() PayOrderResponseStatus {
+	return s.Status
+}
+
+// GetPaymentURL returns the value of PaymentURL.
+func (s *PayOrderResponse) GetPaymentURL() OptString {
+	return s.PaymentURL
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *PayOrderResponse) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *PayOrderResponse) SetTransactionUUID(val string) {
+	s.TransactionUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PayOrderResponse) SetStatus(val PayOrderResponseStatus) {
+	s.Status = val
+}
+
+// SetPaymentURL sets the value of PaymentURL.
+func (s *PayOrderResponse) SetPaymentURL(val OptString) {
+	s.PaymentURL = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *PayOrderResponse) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+func (*PayOrderResponse) payOrderRes() {}
+
+type PayOrderResponseStatus string
+
+const (
+	PayOrderResponseStatusPAID    PayOrderResponseStatus = "PAID"
+	PayOrderResponseStatusPENDING PayOrderResponseStatus = "PENDING"
+)
+
+// AllValues returns all PayOrderResponseStatus values.
+func (PayOrderResponseStatus) AllValues() []PayOrderResponseStatus {
+	return []PayOrderResponseStatus{
+		PayOrderResponseStatusPAID,
+		PayOrderResponseStatusPENDING,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PayOrderResponseStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PayOrderResponseStatusPAID:
+		return []byte(s), nil
+	case PayOrderResponseStatusPENDING:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PayOrderResponseStatus) UnmarshalText(data []byte) error {
+	switch PayOrderResponseStatus(data) {
+	case PayOrderResponseStatusPAID:
+		*s = PayOrderResponseStatusPAID
+		return nil
+	case PayOrderResponseStatusPENDING:
+		*s = PayOrderResponseStatusPENDING
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PaymentCallbackConflict Error
+
+func (*PaymentCallbackConflict) paymentCallbackRes() {}
+
+type PaymentCallbackNotFound Error
+
+func (*PaymentCallbackNotFound) paymentCallbackRes() {}
+
+// Ref: #/components/schemas/PaymentCallbackRequest
+type PaymentCallbackRequest struct {
+	TransactionUUID string `json:"transaction_uuid"`
+	OrderUUID       string `json:"order_uuid"`
+	// Статус транзакции по данным payment-service, только для
+	// информации.
+	Status OptString `json:"status"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *PaymentCallbackRequest) GetTransactionUUID() string {
+	return s.TransactionUUID
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *PaymentCallbackRequest) GetOrderUUID() string {
+	return s.OrderUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *PaymentCallbackRequest) GetStatus() OptString {
+	return s.Status
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *PaymentCallbackRequest) SetTransactionUUID(val string) {
+	s.TransactionUUID = val
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *PaymentCallbackRequest) SetOrderUUID(val string) {
+	s.OrderUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PaymentCallbackRequest) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// Ref: #/components/schemas/PromoCode
+type PromoCode struct {
+	Code           string        `json:"code"`
+	RuleType       PromoRuleType `json:"rule_type"`
+	Value          float64       `json:"value"`
+	Category       OptString     `json:"category"`
+	BuyQuantity    OptInt        `json:"buy_quantity"`
+	RewardCategory OptString     `json:"reward_category"`
+	RewardQuantity OptInt        `json:"reward_quantity"`
+	MinSubtotal    float64       `json:"min_subtotal"`
+	ValidFrom      OptDateTime   `json:"valid_from"`
+	ValidTo        OptDateTime   `json:"valid_to"`
+	MaxUses        OptInt        `json:"max_uses"`
+	PerUserLimit   OptInt        `json:"per_user_limit"`
+	// Сколько неотменённых заказов использует промокод.
+	UsedCount int       `json:"used_count"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetCode returns the value of Code.
+func (s *PromoCode) GetCode() string {
+	return s.Code
+}
+
+// GetRuleType returns the value of RuleType.
+func (s *PromoCode) GetRuleType() PromoRuleType {
+	return s.RuleType
+}
+
+// GetValue returns the value of Value.
+func (s *PromoCode) GetValue() float64 {
+	return s.Value
+}
+
+// GetCategory returns the value of Category.
+func (s *PromoCode) GetCategory() OptString {
+	return s.Category
+}
+
+// GetBuyQuantity returns the value of BuyQuantity.
+func (s *PromoCode) GetBuyQuantity() OptInt {
+	return s.BuyQuantity
+}
+
+// GetRewardCategory returns the value of RewardCategory.
+func (s *PromoCode) GetRewardCategory() OptString {
+	return s.RewardCategory
+}
+
+// GetRewardQuantity returns the value of RewardQuantity.
+func (s *PromoCode) GetRewardQuantity() OptInt {
+	return s.RewardQuantity
+}
+
+// GetMinSubtotal returns the value of MinSubtotal.
+func (s *PromoCode) GetMinSubtotal() float64 {
+	return s.MinSubtotal
+}
+
+// GetValidFrom returns the value of ValidFrom.
+func (s *PromoCode) GetValidFrom() OptDateTime {
+	return s.ValidFrom
+}
+
+// GetValidTo returns the value of ValidTo.
+func (s *PromoCode) GetValidTo() OptDateTime {
+	return s.ValidTo
+}
+
+// GetMaxUses returns the value of MaxUses.
+func (s *PromoCode) GetMaxUses() OptInt {
+	return s.MaxUses
+}
+
+// GetPerUserLimit returns the value of PerUserLimit.
+func (s *PromoCode) GetPerUserLimit() OptInt {
+	return s.PerUserLimit
+}
+
+// GetUsedCount returns the value of UsedCount.
+func (s *PromoCode) GetUsedCount() int {
+	return s.UsedCount
+}
+
+// GetActive returns the value of Active.
+func (s *PromoCode) GetActive() bool {
+	return s.Active
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PromoCode) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetCode sets the value of Code.
+func (s *PromoCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetRuleType sets the value of RuleType.
+func (s *PromoCode) SetRuleType(val PromoRuleType) {
+	s.RuleType = val
+}
+
+// SetValue sets the value of Value.
+func (s *PromoCode) SetValue(val float64) {
+	s.Value = val
+}
+
+// SetCategory sets the value of Category.
+func (s *PromoCode) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetBuyQuantity sets the value of BuyQuantity.
+func (s *PromoCode) SetBuyQuantity(val OptInt) {
+	s.BuyQuantity = val
+}
+
+// SetRewardCategory sets the value of RewardCategory.
+func (s *PromoCode) SetRewardCategory(val OptString) {
+	s.RewardCategory = val
+}
+
+// SetRewardQuantity sets the value of RewardQuantity.
+func (s *PromoCode) SetRewardQuantity(val OptInt) {
+	s.RewardQuantity = val
+}
+
+// SetMinSubtotal sets the value of MinSubtotal.
+func (s *PromoCode) SetMinSubtotal(val float64) {
+	s.MinSubtotal = val
+}
+
+// SetValidFrom sets the value of ValidFrom.
+func (s *PromoCode) SetValidFrom(val OptDateTime) {
+	s.ValidFrom = val
+}
+
+// SetValidTo sets the value of ValidTo.
+func (s *PromoCode) SetValidTo(val OptDateTime) {
+	s.ValidTo = val
+}
+
+// SetMaxUses sets the value of MaxUses.
+func (s *PromoCode) SetMaxUses(val OptInt) {
+	s.MaxUses = val
+}
+
+// SetPerUserLimit sets the value of PerUserLimit.
+func (s *PromoCode) SetPerUserLimit(val OptInt) {
+	s.PerUserLimit = val
+}
+
+// SetUsedCount sets the value of UsedCount.
+func (s *PromoCode) SetUsedCount(val int) {
+	s.UsedCount = val
+}
+
+// SetActive sets the value of Active.
+func (s *PromoCode) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PromoCode) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*PromoCode) createPromoCodeRes()     {}
+func (*PromoCode) deactivatePromoCodeRes() {}
+
+// Ref: #/components/schemas/PromoCodeList
+type PromoCodeList struct {
+	PromoCodes []PromoCode `json:"promo_codes"`
+}
+
+// GetPromoCodes returns the value of PromoCodes.
+func (s *PromoCodeList) GetPromoCodes() []PromoCode {
+	return s.PromoCodes
+}
+
+// SetPromoCodes sets the value of PromoCodes.
+func (s *PromoCodeList) SetPromoCodes(val []PromoCode) {
+	s.PromoCodes = val
+}
+
+// Ref: #/components/schemas/PromoRuleType
+type PromoRuleType string
+
+const (
+	PromoRuleTypePERCENTAGE         PromoRuleType = "PERCENTAGE"
+	PromoRuleTypeFIXEDAMOUNT        PromoRuleType = "FIXED_AMOUNT"
+	PromoRuleTypeCATEGORYPERCENTAGE PromoRuleType = "CATEGORY_PERCENTAGE"
+	PromoRuleTypeBUYXGETY           PromoRuleType = "BUY_X_GET_Y"
+)
+
+// AllValues returns all PromoRuleType values.
+func (PromoRuleType) AllValues() []PromoRuleType {
+	return []PromoRuleType{
+		PromoRuleTypePERCENTAGE,
+		PromoRuleTypeFIXEDAMOUNT,
+		PromoRuleTypeCATEGORYPERCENTAGE,
+		PromoRuleTypeBUYXGETY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PromoRuleType) MarshalText() ([]byte, error) {
+	switch s {
+	case PromoRuleTypePERCENTAGE:
+		return []byte(s), nil
+	case PromoRuleTypeFIXEDAMOUNT:
+		return []byte(s), nil
+	case PromoRuleTypeCATEGORYPERCENTAGE:
+		return []byte(s), nil
+	case PromoRuleTypeBUYXGETY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PromoRuleType) UnmarshalText(data []byte) error {
+	switch PromoRuleType(data) {
+	case PromoRuleTypePERCENTAGE:
+		*s = PromoRuleTypePERCENTAGE
+		return nil
+	case PromoRuleTypeFIXEDAMOUNT:
+		*s = PromoRuleTypeFIXEDAMOUNT
+		return nil
+	case PromoRuleTypeCATEGORYPERCENTAGE:
+		*s = PromoRuleTypeCATEGORYPERCENTAGE
+		return nil
+	case PromoRuleTypeBUYXGETY:
+		*s = PromoRuleTypeBUYXGETY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Коммерческое предложение с зафиксированными ценами.
+// Ref: #/components/schemas/Quote
+type Quote struct {
+	QuoteUUID       string         `json:"quote_uuid"`
+	UserUUID        string         `json:"user_uuid"`
+	Status          QuoteStatus    `json:"status"`
+	Items           []OrderItem    `json:"items"`
+	Subtotal        float64        `json:"subtotal"`
+	DiscountTotal   float64        `json:"discount_total"`
+	TaxTotal        float64        `json:"tax_total"`
+	DutyTotal       float64        `json:"duty_total"`
+	ShippingCost    float64        `json:"shipping_cost"`
+	TotalPrice      float64        `json:"total_price"`
+	PromoCode       OptNilString   `json:"promo_code"`
+	BuyerCountry    OptNilString   `json:"buyer_country"`
+	ShippingCarrier OptNilString   `json:"shipping_carrier"`
+	ShippingOption  OptNilString   `json:"shipping_option"`
+	DeliveryAddress OptNilAddress  `json:"delivery_address"`
+	ExpiresAt       time.Time      `json:"expires_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	AcceptedAt      OptNilDateTime `json:"accepted_at"`
+	// Заказ, созданный при принятии предложения.
+	OrderUUID OptNilString `json:"order_uuid"`
+}
+
+// GetQuoteUUID returns the value of QuoteUUID.
+func (s *Quote) GetQuoteUUID() string {
+	return s.QuoteUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Quote) GetUserUUID() string {
+	return s.UserUUID
+}
+
+// GetStatus returns the value of Status.
+func (s *Quote) GetStatus() QuoteStatus {
+	return s.Status
+}
+
+// GetItems returns the value of Items.
+func (s *Quote) GetItems() []OrderItem {
+	return s.Items
+}
+
+// GetSubtotal returns the value of Subtotal.
+func (s *Quote) GetSubtotal() float64 {
+	return s.Subtotal
+}
+
+// GetDiscountTotal returns the value of DiscountTotal.
+func (s *Quote) GetDiscountTotal() float64 {
+	return s.DiscountTotal
+}
+
+// GetTaxTotal returns the value of TaxTotal.
+func (s *Quote) GetTaxTotal() float64 {
+	return s.TaxTotal
+}
+
+// GetDutyTotal returns the value of DutyTotal.
+func (s *Quote) GetDutyTotal() float64 {
+	return s.DutyTotal
+}
+
+// GetShippingCost returns the value of ShippingCost.
+func (s *Quote) GetShippingCost() float64 {
+	return s.ShippingCost
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Quote) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// GetPromoCode returns the value of PromoCode.
+func (s *Quote) GetPromoCode() OptNilString {
+	return s.PromoCode
+}
+
+// GetBuyerCountry returns the value of BuyerCountry.
+func (s *Quote) GetBuyerCountry() OptNilString {
+	return s.BuyerCountry
+}
+
+// GetShippingCarrier returns the value of ShippingCarrier.
+func (s *Quote) GetShippingCarrier() OptNilString {
+	return s.ShippingCarrier
+}
+
+// GetShippingOption returns the value of ShippingOption.
+func (s *Quote) GetShippingOption() OptNilString {
+	return s.ShippingOption
+}
+
+// GetDeliveryAddress returns the value of DeliveryAddress.
+func (s *Quote) GetDeliveryAddress() OptNilAddress {
+	return s.DeliveryAddress
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Quote) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Quote) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetAcceptedAt returns the value of AcceptedAt.
+func (s *Quote) GetAcceptedAt() OptNilDateTime {
+	return s.AcceptedAt
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Quote) GetOrderUUID() OptNilString {
+	return s.OrderUUID
+}
+
+// SetQuoteUUID sets the value of QuoteUUID.
+func (s *Quote) SetQuoteUUID(val string) {
+	s.QuoteUUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Quote) SetUserUUID(val string) {
+	s.UserUUID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Quote) SetStatus(val QuoteStatus) {
+	s.Status = val
+}
+
+// SetItems sets the value of Items.
+func (s *Quote) SetItems(val []OrderItem) {
+	s.Items = val
+}
+
+// SetSubtotal sets the value of Subtotal.
+func (s *Quote) SetSubtotal(val float64) {
+	s.Subtotal = val
+}
+
+// SetDiscountTotal sets the value of DiscountTotal.
+func (s *Quote) SetDiscountTotal(val float64) {
+	s.DiscountTotal = val
+}
+
+// SetTaxTotal sets the value of TaxTotal.
+func (s *Quote) SetTaxTotal(val float64) {
+	s.TaxTotal = val
+}
+
+// SetDutyTotal sets the value of DutyTotal.
+func (s *Quote) SetDutyTotal(val float64) {
+	s.DutyTotal = val
+}
+
+// SetShippingCost sets the value of ShippingCost.
+func (s *Quote) SetShippingCost(val float64) {
+	s.ShippingCost = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *Quote) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+// SetPromoCode sets the value of PromoCode.
+func (s *Quote) SetPromoCode(val OptNilString) {
+	s.PromoCode = val
+}
+
+// SetBuyerCountry sets the value of BuyerCountry.
+func (s *Quote) SetBuyerCountry(val OptNilString) {
+	s.BuyerCountry = val
+}
+
+// SetShippingCarrier sets the value of ShippingCarrier.
+func (s *Quote) SetShippingCarrier(val OptNilString) {
+	s.ShippingCarrier = val
+}
+
+// SetShippingOption sets the value of ShippingOption.
+func (s *Quote) SetShippingOption(val OptNilString) {
+	s.ShippingOption = val
+}
+
+// SetDeliveryAddress sets the value of DeliveryAddress.
+func (s *Quote) SetDeliveryAddress(val OptNilAddress) {
+	s.DeliveryAddress = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Quote) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Quote) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetAcceptedAt sets the value of AcceptedAt.
+func (s *Quote) SetAcceptedAt(val OptNilDateTime) {
+	s.AcceptedAt = val
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Quote) SetOrderUUID(val OptNilString) {
+	s.OrderUUID = val
+}
+
+func (*Quote) createQuoteRes() {}
+func (*Quote) getQuoteRes()    {}
+
+type QuoteShippingBadRequest Error
+
+func (*QuoteShippingBadRequest) quoteShippingRes() {}
+
+type QuoteShippingInternalServerError Error
+
+func (*QuoteShippingInternalServerError) quoteShippingRes() {}
+
+type QuoteShippingNotFound Error
+
+func (*QuoteShippingNotFound) quoteShippingRes() {}
+
+// Ref: #/components/schemas/QuoteStatus
+type QuoteStatus string
+
+const (
+	QuoteStatusOPEN     QuoteStatus = "OPEN"
+	QuoteStatusACCEPTED QuoteStatus = "ACCEPTED"
+	QuoteStatusEXPIRED  QuoteStatus = "EXPIRED"
+)
+
+// AllValues returns all QuoteStatus values.
+func (QuoteStatus) AllValues() []QuoteStatus {
+	return []QuoteStatus{
+		QuoteStatusOPEN,
+		QuoteStatusACCEPTED,
+		QuoteStatusEXPIRED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s QuoteStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case QuoteStatusOPEN:
+		return []byte(s), nil
+	case QuoteStatusACCEPTED:
+		return []byte(s), nil
+	case QuoteStatusEXPIRED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *QuoteStatus) UnmarshalText(data []byte) error {
+	switch QuoteStatus(data) {
+	case QuoteStatusOPEN:
+		*s = QuoteStatusOPEN
+		return nil
+	case QuoteStatusACCEPTED:
+		*s = QuoteStatusACCEPTED
+		return nil
+	case QuoteStatusEXPIRED:
+		*s = QuoteStatusEXPIRED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RemoveCartItemBadRequest Error
+
+func (*RemoveCartItemBadRequest) removeCartItemRes() {}
+
+type RemoveCartItemInternalServerError Error
+
+func (*RemoveCartItemInternalServerError) removeCartItemRes() {}
+
+type RemoveCartItemNotFound Error
+
+func (*RemoveCartItemNotFound) removeCartItemRes() {}
+
+// Ref: #/components/schemas/Shipment
+type Shipment struct {
+	OrderUUID       string          `json:"order_uuid"`
+	Status          OrderStatus     `json:"status"`
+	Carrier         OptNilString    `json:"carrier"`
+	TrackingNumber  OptNilString    `json:"tracking_number"`
+	DeliveryAddress OptNilAddress   `json:"delivery_address"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	Events          []ShipmentEvent `json:"events"`
+}
 
-func (*CreateOrderInternalServerError) createOrderRes() {}
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Shipment) GetOrderUUID() string {
+	return s.OrderUUID
+}
 
-// Ref: #/components/schemas/CreateOrderRequest
-type CreateOrderRequest struct {
-	UserUUID string                        `json:"user_uuid"`
-	Items    []CreateOrderRequestItemsItem `json:"items"`
+// GetStatus returns the value of Status.
+func (s *Shipment) GetStatus() OrderStatus {
+	return s.Status
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() string {
-	return s.UserUUID
+// GetCarrier returns the value of Carrier.
+func (s *Shipment) GetCarrier() OptNilString {
+	return s.Carrier
 }
 
-// GetItems returns the value of Items.
-func (s *CreateOrderRequest) GetItems() []CreateOrderRequestItemsItem {
-	return s.Items
+// GetTrackingNumber returns the value of TrackingNumber.
+func (s *Shipment) GetTrackingNumber() OptNilString {
+	return s.TrackingNumber
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val string) {
-	s.UserUUID = val
+// GetDeliveryAddress returns the value of DeliveryAddress.
+func (s *Shipment) GetDeliveryAddress() OptNilAddress {
+	return s.DeliveryAddress
 }
 
-// SetItems sets the value of Items.
-func (s *CreateOrderRequest) SetItems(val []CreateOrderRequestItemsItem) {
-	s.Items = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Shipment) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-type CreateOrderRequestItemsItem struct {
-	PartUUID string  `json:"part_uuid"`
-	Quantity float64 `json:"quantity"`
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Shipment) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// GetPartUUID returns the value of PartUUID.
-func (s *CreateOrderRequestItemsItem) GetPartUUID() string {
-	return s.PartUUID
+// GetEvents returns the value of Events.
+func (s *Shipment) GetEvents() []ShipmentEvent {
+	return s.Events
 }
 
-// GetQuantity returns the value of Quantity.
-func (s *CreateOrderRequestItemsItem) GetQuantity() float64 {
-	return s.Quantity
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Shipment) SetOrderUUID(val string) {
+	s.OrderUUID = val
 }
 
-// SetPartUUID sets the value of PartUUID.
-func (s *CreateOrderRequestItemsItem) SetPartUUID(val string) {
-	s.PartUUID = val
+// SetStatus sets the value of Status.
+func (s *Shipment) SetStatus(val OrderStatus) {
+	s.Status = val
 }
 
-// SetQuantity sets the value of Quantity.
-func (s *CreateOrderRequestItemsItem) SetQuantity(val float64) {
-	s.Quantity = val
+// SetCarrier sets the value of Carrier.
+func (s *Shipment) SetCarrier(val OptNilString) {
+	s.Carrier = val
 }
 
-// Ref: #/components/schemas/CreateOrderResponse
-type CreateOrderResponse struct {
-	OrderUUID  string  `json:"order_uuid"`
-	TotalPrice float64 `json:"total_price"`
+// SetTrackingNumber sets the value of TrackingNumber.
+func (s *Shipment) SetTrackingNumber(val OptNilString) {
+	s.TrackingNumber = val
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *CreateOrderResponse) GetOrderUUID() string {
-	return s.OrderUUID
+// SetDeliveryAddress sets the value of DeliveryAddress.
+func (s *Shipment) SetDeliveryAddress(val OptNilAddress) {
+	s.DeliveryAddress = val
 }
 
-// GetTotalPrice returns the value of TotalPrice.
-func (s *CreateOrderResponse) GetTotalPrice() float64 {
-	return s.TotalPrice
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Shipment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *CreateOrderResponse) SetOrderUUID(val string) {
-	s.OrderUUID = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Shipment) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// SetTotalPrice sets the value of TotalPrice.
-func (s *CreateOrderResponse) SetTotalPrice(val float64) {
-	s.TotalPrice = val
+// SetEvents sets the value of Events.
+func (s *Shipment) SetEvents(val []ShipmentEvent) {
+	s.Events = val
 }
 
-func (*CreateOrderResponse) createOrderRes() {}
+func (*Shipment) addShipmentEventRes() {}
+func (*Shipment) getOrderShipmentRes() {}
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	Message string    `json:"message"`
-	Code    OptString `json:"code"`
+// Ref: #/components/schemas/ShipmentEvent
+type ShipmentEvent struct {
+	Type        ShipmentEventType `json:"type"`
+	Location    string            `json:"location"`
+	Description string            `json:"description"`
+	Actor       string            `json:"actor"`
+	CreatedAt   time.Time         `json:"created_at"`
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// GetType returns the value of Type.
+func (s *ShipmentEvent) GetType() ShipmentEventType {
+	return s.Type
 }
 
-// GetCode returns the value of Code.
-func (s *Error) GetCode() OptString {
-	return s.Code
+// GetLocation returns the value of Location.
+func (s *ShipmentEvent) GetLocation() string {
+	return s.Location
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// GetDescription returns the value of Description.
+func (s *ShipmentEvent) GetDescription() string {
+	return s.Description
 }
 
-// SetCode sets the value of Code.
-func (s *Error) SetCode(val OptString) {
-	s.Code = val
+// GetActor returns the value of Actor.
+func (s *ShipmentEvent) GetActor() string {
+	return s.Actor
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ShipmentEvent) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetType sets the value of Type.
+func (s *ShipmentEvent) SetType(val ShipmentEventType) {
+	s.Type = val
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+// SetLocation sets the value of Location.
+func (s *ShipmentEvent) SetLocation(val string) {
+	s.Location = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetDescription sets the value of Description.
+func (s *ShipmentEvent) SetDescription(val string) {
+	s.Description = val
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// SetActor sets the value of Actor.
+func (s *ShipmentEvent) SetActor(val string) {
+	s.Actor = val
 }
 
-type GetOrderInternalServerError Error
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ShipmentEvent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-func (*GetOrderInternalServerError) getOrderRes() {}
+// Ref: #/components/schemas/ShipmentEventRequest
+type ShipmentEventRequest struct {
+	Type ShipmentEventType `json:"type"`
+	// Перевозчик; по умолчанию выбранный при заказе.
+	Carrier        OptString `json:"carrier"`
+	TrackingNumber OptString `json:"tracking_number"`
+	Location       OptString `json:"location"`
+	Description    OptString `json:"description"`
+}
 
-type GetOrderNotFound Error
+// GetType returns the value of Type.
+func (s *ShipmentEventRequest) GetType() ShipmentEventType {
+	return s.Type
+}
 
-func (*GetOrderNotFound) getOrderRes() {}
+// GetCarrier returns the value of Carrier.
+func (s *ShipmentEventRequest) GetCarrier() OptString {
+	return s.Carrier
+}
 
-// NewOptNilOrderPaymentMethod returns new OptNilOrderPaymentMethod with value set to v.
-func NewOptNilOrderPaymentMethod(v OrderPaymentMethod) OptNilOrderPaymentMethod {
-	return OptNilOrderPaymentMethod{
-		Value: v,
-		Set:   true,
-	}
+// GetTrackingNumber returns the value of TrackingNumber.
+func (s *ShipmentEventRequest) GetTrackingNumber() OptString {
+	return s.TrackingNumber
 }
 
-// OptNilOrderPaymentMethod is optional nullable OrderPaymentMethod.
-type OptNilOrderPaymentMethod struct {
-	Value OrderPaymentMethod
-	Set   bool
-	Null  bool
+// GetLocation returns the value of Location.
+func (s *ShipmentEventRequest) GetLocation() OptString {
+	return s.Location
 }
 
-// IsSet returns true if OptNilOrderPaymentMethod was set.
-func (o OptNilOrderPaymentMethod) IsSet() bool { return o.Set }
+// GetDescription returns the value of Description.
+func (s *ShipmentEventRequest) GetDescription() OptString {
+	return s.Description
+}
 
-// Reset unsets value.
-func (o *OptNilOrderPaymentMethod) Reset() {
-	var v OrderPaymentMethod
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetType sets the value of Type.
+func (s *ShipmentEventRequest) SetType(val ShipmentEventType) {
+	s.Type = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilOrderPaymentMethod) SetTo(v OrderPaymentMethod) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetCarrier sets the value of Carrier.
+func (s *ShipmentEventRequest) SetCarrier(val OptString) {
+	s.Carrier = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilOrderPaymentMethod) IsNull() bool { return o.Null }
+// SetTrackingNumber sets the value of TrackingNumber.
+func (s *ShipmentEventRequest) SetTrackingNumber(val OptString) {
+	s.TrackingNumber = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilOrderPaymentMethod) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v OrderPaymentMethod
-	o.Value = v
+// SetLocation sets the value of Location.
+func (s *ShipmentEventRequest) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilOrderPaymentMethod) Get() (v OrderPaymentMethod, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDescription sets the value of Description.
+func (s *ShipmentEventRequest) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilOrderPaymentMethod) Or(d OrderPaymentMethod) OrderPaymentMethod {
-	if v, ok := o.Get(); ok {
-		return v
+// Ref: #/components/schemas/ShipmentEventType
+type ShipmentEventType string
+
+const (
+	ShipmentEventTypeASSEMBLING ShipmentEventType = "ASSEMBLING"
+	ShipmentEventTypeSHIPPED    ShipmentEventType = "SHIPPED"
+	ShipmentEventTypeINTRANSIT  ShipmentEventType = "IN_TRANSIT"
+	ShipmentEventTypeDELIVERED  ShipmentEventType = "DELIVERED"
+)
+
+// AllValues returns all ShipmentEventType values.
+func (ShipmentEventType) AllValues() []ShipmentEventType {
+	return []ShipmentEventType{
+		ShipmentEventTypeASSEMBLING,
+		ShipmentEventTypeSHIPPED,
+		ShipmentEventTypeINTRANSIT,
+		ShipmentEventTypeDELIVERED,
 	}
-	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s ShipmentEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case ShipmentEventTypeASSEMBLING:
+		return []byte(s), nil
+	case ShipmentEventTypeSHIPPED:
+		return []byte(s), nil
+	case ShipmentEventTypeINTRANSIT:
+		return []byte(s), nil
+	case ShipmentEventTypeDELIVERED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ShipmentEventType) UnmarshalText(data []byte) error {
+	switch ShipmentEventType(data) {
+	case ShipmentEventTypeASSEMBLING:
+		*s = ShipmentEventTypeASSEMBLING
+		return nil
+	case ShipmentEventTypeSHIPPED:
+		*s = ShipmentEventTypeSHIPPED
+		return nil
+	case ShipmentEventTypeINTRANSIT:
+		*s = ShipmentEventTypeINTRANSIT
+		return nil
+	case ShipmentEventTypeDELIVERED:
+		*s = ShipmentEventTypeDELIVERED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// Ref: #/components/schemas/ShippingQuote
+type ShippingQuote struct {
+	Carrier string `json:"carrier"`
+	// Код, который передаётся в shipping_option при создании заказа.
+	Option  string  `json:"option"`
+	Name    string  `json:"name"`
+	Cost    float64 `json:"cost"`
+	DaysMin int     `json:"days_min"`
+	DaysMax int     `json:"days_max"`
+	// Объёмный вес по коэффициенту перевозчика, кг.
+	VolumetricWeight float64 `json:"volumetric_weight"`
+	// Оплачиваемый вес — больший из фактического и
+	// объёмного, кг.
+	ChargeableWeight float64 `json:"chargeable_weight"`
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetCarrier returns the value of Carrier.
+func (s *ShippingQuote) GetCarrier() string {
+	return s.Carrier
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// GetOption returns the value of Option.
+func (s *ShippingQuote) GetOption() string {
+	return s.Option
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// GetName returns the value of Name.
+func (s *ShippingQuote) GetName() string {
+	return s.Name
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCost returns the value of Cost.
+func (s *ShippingQuote) GetCost() float64 {
+	return s.Cost
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetDaysMin returns the value of DaysMin.
+func (s *ShippingQuote) GetDaysMin() int {
+	return s.DaysMin
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetDaysMax returns the value of DaysMax.
+func (s *ShippingQuote) GetDaysMax() int {
+	return s.DaysMax
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetVolumetricWeight returns the value of VolumetricWeight.
+func (s *ShippingQuote) GetVolumetricWeight() float64 {
+	return s.VolumetricWeight
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetChargeableWeight returns the value of ChargeableWeight.
+func (s *ShippingQuote) GetChargeableWeight() float64 {
+	return s.ChargeableWeight
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetCarrier sets the value of Carrier.
+func (s *ShippingQuote) SetCarrier(val string) {
+	s.Carrier = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetOption sets the value of Option.
+func (s *ShippingQuote) SetOption(val string) {
+	s.Option = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetName sets the value of Name.
+func (s *ShippingQuote) SetName(val string) {
+	s.Name = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCost sets the value of Cost.
+func (s *ShippingQuote) SetCost(val float64) {
+	s.Cost = val
 }
 
-// Ref: #/components/schemas/Order
-type Order struct {
-	OrderUUID       string                   `json:"order_uuid"`
-	UserUUID        string                   `json:"user_uuid"`
-	Items           []OrderItemsItem         `json:"items"`
-	TotalPrice      float64                  `json:"total_price"`
-	TransactionUUID OptNilString             `json:"transaction_uuid"`
-	PaymentMethod   OptNilOrderPaymentMethod `json:"payment_method"`
-	Status          OrderStatus              `json:"status"`
+// SetDaysMin sets the value of DaysMin.
+func (s *ShippingQuote) SetDaysMin(val int) {
+	s.DaysMin = val
 }
 
-// GetOrderUUID returns the value of OrderUUID.
-func (s *Order) GetOrderUUID() string {
-	return s.OrderUUID
+// SetDaysMax sets the value of DaysMax.
+func (s *ShippingQuote) SetDaysMax(val int) {
+	s.DaysMax = val
 }
 
-// GetUserUUID returns the value of UserUUID.
-func (s *Order) GetUserUUID() string {
-	return s.UserUUID
+// SetVolumetricWeight sets the value of VolumetricWeight.
+func (s *ShippingQuote) SetVolumetricWeight(val float64) {
+	s.VolumetricWeight = val
+}
+
+// SetChargeableWeight sets the value of ChargeableWeight.
+func (s *ShippingQuote) SetChargeableWeight(val float64) {
+	s.ChargeableWeight = val
+}
+
+// Ref: #/components/schemas/ShippingQuoteRequest
+type ShippingQuoteRequest struct {
+	Items   []ShippingQuoteRequestItemsItem `json:"items"`
+	Address Address                         `json:"address"`
 }
 
 // GetItems returns the value of Items.
-func (s *Order) GetItems() []OrderItemsItem {
+func (s *ShippingQuoteRequest) GetItems() []ShippingQuoteRequestItemsItem {
 	return s.Items
 }
 
-// GetTotalPrice returns the value of TotalPrice.
-func (s *Order) GetTotalPrice() float64 {
-	return s.TotalPrice
+// GetAddress returns the value of Address.
+func (s *ShippingQuoteRequest) GetAddress() Address {
+	return s.Address
 }
 
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *Order) GetTransactionUUID() OptNilString {
-	return s.TransactionUUID
+// SetItems sets the value of Items.
+func (s *ShippingQuoteRequest) SetItems(val []ShippingQuoteRequestItemsItem) {
+	s.Items = val
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *Order) GetPaymentMethod() OptNilOrderPaymentMethod {
-	return s.PaymentMethod
+// SetAddress sets the value of Address.
+func (s *ShippingQuoteRequest) SetAddress(val Address) {
+	s.Address = val
 }
 
-// GetStatus returns the value of Status.
-func (s *Order) GetStatus() OrderStatus {
-	return s.Status
+type ShippingQuoteRequestItemsItem struct {
+	PartUUID string  `json:"part_uuid"`
+	Quantity float64 `json:"quantity"`
 }
 
-// SetOrderUUID sets the value of OrderUUID.
-func (s *Order) SetOrderUUID(val string) {
-	s.OrderUUID = val
+// GetPartUUID returns the value of PartUUID.
+func (s *ShippingQuoteRequestItemsItem) GetPartUUID() string {
+	return s.PartUUID
 }
 
-// SetUserUUID sets the value of UserUUID.
-func (s *Order) SetUserUUID(val string) {
-	s.UserUUID = val
+// GetQuantity returns the value of Quantity.
+func (s *ShippingQuoteRequestItemsItem) GetQuantity() float64 {
+	return s.Quantity
 }
 
-// SetItems sets the value of Items.
-func (s *Order) SetItems(val []OrderItemsItem) {
-	s.Items = val
+// SetPartUUID sets the value of PartUUID.
+func (s *ShippingQuoteRequestItemsItem) SetPartUUID(val string) {
+	s.PartUUID = val
 }
 
-// SetTotalPrice sets the value of TotalPrice.
-func (s *Order) SetTotalPrice(val float64) {
-	s.TotalPrice = val
+// SetQuantity sets the value of Quantity.
+func (s *ShippingQuoteRequestItemsItem) SetQuantity(val float64) {
+	s.Quantity = val
 }
 
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *Order) SetTransactionUUID(val OptNilString) {
-	s.TransactionUUID = val
+// Ref: #/components/schemas/ShippingQuoteResponse
+type ShippingQuoteResponse struct {
+	// Фактический вес, кг.
+	ActualWeight float64 `json:"actual_weight"`
+	// Объём, м³.
+	Volume  float64         `json:"volume"`
+	Options []ShippingQuote `json:"options"`
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *Order) SetPaymentMethod(val OptNilOrderPaymentMethod) {
-	s.PaymentMethod = val
+// GetActualWeight returns the value of ActualWeight.
+func (s *ShippingQuoteResponse) GetActualWeight() float64 {
+	return s.ActualWeight
 }
 
-// SetStatus sets the value of Status.
-func (s *Order) SetStatus(val OrderStatus) {
-	s.Status = val
+// GetVolume returns the value of Volume.
+func (s *ShippingQuoteResponse) GetVolume() float64 {
+	return s.Volume
 }
 
-func (*Order) getOrderRes() {}
+// GetOptions returns the value of Options.
+func (s *ShippingQuoteResponse) GetOptions() []ShippingQuote {
+	return s.Options
+}
 
-type OrderItemsItem struct {
-	PartUUID string  `json:"part_uuid"`
-	Quantity float64 `json:"quantity"`
-	Price    float64 `json:"price"`
-	Name     string  `json:"name"`
+// SetActualWeight sets the value of ActualWeight.
+func (s *ShippingQuoteResponse) SetActualWeight(val float64) {
+	s.ActualWeight = val
 }
 
-// GetPartUUID returns the value of PartUUID.
-func (s *OrderItemsItem) GetPartUUID() string {
-	return s.PartUUID
+// SetVolume sets the value of Volume.
+func (s *ShippingQuoteResponse) SetVolume(val float64) {
+	s.Volume = val
 }
 
-// GetQuantity returns the value of Quantity.
-func (s *OrderItemsItem) GetQuantity() float64 {
-	return s.Quantity
+// SetOptions sets the value of Options.
+func (s *ShippingQuoteResponse) SetOptions(val []ShippingQuote) {
+	s.Options = val
 }
 
-// GetPrice returns the value of Price.
-func (s *OrderItemsItem) GetPrice() float64 {
-	return s.Price
+func (*ShippingQuoteResponse) quoteShippingRes() {}
+
+// Ref: #/components/schemas/StatusChange
+type StatusChange struct {
+	FromStatus OptNilOrderStatus `json:"from_status"`
+	ToStatus   OrderStatus       `json:"to_status"`
+	Actor      string            `json:"actor"`
+	Reason     string            `json:"reason"`
+	CreatedAt  time.Time         `json:"created_at"`
 }
 
-// GetName returns the value of Name.
-func (s *OrderItemsItem) GetName() string {
-	return s.Name
+// GetFromStatus returns the value of FromStatus.
+func (s *StatusChange) GetFromStatus() OptNilOrderStatus {
+	return s.FromStatus
 }
 
-// SetPartUUID sets the value of PartUUID.
-func (s *OrderItemsItem) SetPartUUID(val string) {
-	s.PartUUID = val
+// GetToStatus returns the value of ToStatus.
+func (s *StatusChange) GetToStatus() OrderStatus {
+	return s.ToStatus
 }
 
-// SetQuantity sets the value of Quantity.
-func (s *OrderItemsItem) SetQuantity(val float64) {
-	s.Quantity = val
+// GetActor returns the value of Actor.
+func (s *StatusChange) GetActor() string {
+	return s.Actor
 }
 
-// SetPrice sets the value of Price.
-func (s *OrderItemsItem) SetPrice(val float64) {
-	s.Price = val
+// GetReason returns the value of Reason.
+func (s *StatusChange) GetReason() string {
+	return s.Reason
 }
 
-// SetName sets the value of Name.
-func (s *OrderItemsItem) SetName(val string) {
-	s.Name = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *StatusChange) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-type OrderPaymentMethod string
+// SetFromStatus sets the value of FromStatus.
+func (s *StatusChange) SetFromStatus(val OptNilOrderStatus) {
+	s.FromStatus = val
+}
 
-const (
-	OrderPaymentMethodCARD          OrderPaymentMethod = "CARD"
-	OrderPaymentMethodSBP           OrderPaymentMethod = "SBP"
-	OrderPaymentMethodCREDITCARD    OrderPaymentMethod = "CREDIT_CARD"
-	OrderPaymentMethodINVESTORMONEY OrderPaymentMethod = "INVESTOR_MONEY"
-)
+// SetToStatus sets the value of ToStatus.
+func (s *StatusChange) SetToStatus(val OrderStatus) {
+	s.ToStatus = val
+}
 
-// AllValues returns all OrderPaymentMethod values.
-func (OrderPaymentMethod) AllValues() []OrderPaymentMethod {
-	return []OrderPaymentMethod{
-		OrderPaymentMethodCARD,
-		OrderPaymentMethodSBP,
-		OrderPaymentMethodCREDITCARD,
-		OrderPaymentMethodINVESTORMONEY,
-	}
+// SetActor sets the value of Actor.
+func (s *StatusChange) SetActor(val string) {
+	s.Actor = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s OrderPaymentMethod) MarshalText() ([]byte, error) {
-	switch s {
-	case OrderPaymentMethodCARD:
-		return []byte(s), nil
-	case OrderPaymentMethodSBP:
-		return []byte(s), nil
-	case OrderPaymentMethodCREDITCARD:
-		return []byte(s), nil
-	case OrderPaymentMethodINVESTORMONEY:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetReason sets the value of Reason.
+func (s *StatusChange) SetReason(val string) {
+	s.Reason = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *OrderPaymentMethod) UnmarshalText(data []byte) error {
-	switch OrderPaymentMethod(data) {
-	case OrderPaymentMethodCARD:
-		*s = OrderPaymentMethodCARD
-		return nil
-	case OrderPaymentMethodSBP:
-		*s = OrderPaymentMethodSBP
-		return nil
-	case OrderPaymentMethodCREDITCARD:
-		*s = OrderPaymentMethodCREDITCARD
-		return nil
-	case OrderPaymentMethodINVESTORMONEY:
-		*s = OrderPaymentMethodINVESTORMONEY
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *StatusChange) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-type OrderStatus string
+type UpdateCartItemBadRequest Error
 
-const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-)
+func (*UpdateCartItemBadRequest) updateCartItemRes() {}
 
-// AllValues returns all OrderStatus values.
-func (OrderStatus) AllValues() []OrderStatus {
-	return []OrderStatus{
-		OrderStatusPENDINGPAYMENT,
-		OrderStatusPAID,
-		OrderStatusCANCELLED,
-	}
+type UpdateCartItemInternalServerError Error
+
+func (*UpdateCartItemInternalServerError) updateCartItemRes() {}
+
+type UpdateCartItemNotFound Error
+
+func (*UpdateCartItemNotFound) updateCartItemRes() {}
+
+// Ref: #/components/schemas/UpdateCartItemRequest
+type UpdateCartItemRequest struct {
+	Quantity int `json:"quantity"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s OrderStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case OrderStatusPENDINGPAYMENT:
-		return []byte(s), nil
-	case OrderStatusPAID:
-		return []byte(s), nil
-	case OrderStatusCANCELLED:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetQuantity returns the value of Quantity.
+func (s *UpdateCartItemRequest) GetQuantity() int {
+	return s.Quantity
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *OrderStatus) UnmarshalText(data []byte) error {
-	switch OrderStatus(data) {
-	case OrderStatusPENDINGPAYMENT:
-		*s = OrderStatusPENDINGPAYMENT
-		return nil
-	case OrderStatusPAID:
-		*s = OrderStatusPAID
-		return nil
-	case OrderStatusCANCELLED:
-		*s = OrderStatusCANCELLED
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetQuantity sets the value of Quantity.
+func (s *UpdateCartItemRequest) SetQuantity(val int) {
+	s.Quantity = val
 }
 
-type PayOrderBadRequest Error
+type UpdateOrderItemsBadRequest Error
 
-func (*PayOrderBadRequest) payOrderRes() {}
+func (*UpdateOrderItemsBadRequest) updateOrderItemsRes() {}
 
-type PayOrderConflict Error
+type UpdateOrderItemsConflict Error
 
-func (*PayOrderConflict) payOrderRes() {}
+func (*UpdateOrderItemsConflict) updateOrderItemsRes() {}
 
-type PayOrderInternalServerError Error
+type UpdateOrderItemsInternalServerError Error
 
-func (*PayOrderInternalServerError) payOrderRes() {}
+func (*UpdateOrderItemsInternalServerError) updateOrderItemsRes() {}
 
-type PayOrderNotFound Error
+type UpdateOrderItemsNotFound Error
 
-func (*PayOrderNotFound) payOrderRes() {}
+func (*UpdateOrderItemsNotFound) updateOrderItemsRes() {}
 
-// Ref: #/components/schemas/PayOrderRequest
-type PayOrderRequest struct {
-	PaymentMethod PayOrderRequestPaymentMethod `json:"payment_method"`
+// Ref: #/components/schemas/UpdateOrderItemsRequest
+type UpdateOrderItemsRequest struct {
+	Operations []UpdateOrderItemsRequestOperationsItem `json:"operations"`
 }
 
-// GetPaymentMethod returns the value of PaymentMethod.
-func (s *PayOrderRequest) GetPaymentMethod() PayOrderRequestPaymentMethod {
-	return s.PaymentMethod
+// GetOperations returns the value of Operations.
+func (s *UpdateOrderItemsRequest) GetOperations() []UpdateOrderItemsRequestOperationsItem {
+	return s.Operations
 }
 
-// SetPaymentMethod sets the value of PaymentMethod.
-func (s *PayOrderRequest) SetPaymentMethod(val PayOrderRequestPaymentMethod) {
-	s.PaymentMethod = val
+// SetOperations sets the value of Operations.
+func (s *UpdateOrderItemsRequest) SetOperations(val []UpdateOrderItemsRequestOperationsItem) {
+	s.Operations = val
 }
 
-type PayOrderRequestPaymentMethod string
+type UpdateOrderItemsRequestOperationsItem struct {
+	// ADD увеличивает количество или добавляет позицию,
+	// SET_QUANTITY задаёт количество, REMOVE удаляет позицию.
+	Op       UpdateOrderItemsRequestOperationsItemOp `json:"op"`
+	PartUUID string                                  `json:"part_uuid"`
+	// Не используется для REMOVE.
+	Quantity OptFloat64 `json:"quantity"`
+}
+
+// GetOp returns the value of Op.
+func (s *UpdateOrderItemsRequestOperationsItem) GetOp() UpdateOrderItemsRequestOperationsItemOp {
+	return s.Op
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *UpdateOrderItemsRequestOperationsItem) GetPartUUID() string {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *UpdateOrderItemsRequestOperationsItem) GetQuantity() OptFloat64 {
+	return s.Quantity
+}
+
+// SetOp sets the value of Op.
+func (s *UpdateOrderItemsRequestOperationsItem) SetOp(val UpdateOrderItemsRequestOperationsItemOp) {
+	s.Op = val
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *UpdateOrderItemsRequestOperationsItem) SetPartUUID(val string) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *UpdateOrderItemsRequestOperationsItem) SetQuantity(val OptFloat64) {
+	s.Quantity = val
+}
+
+// ADD увеличивает количество или добавляет позицию,
+// SET_QUANTITY задаёт количество, REMOVE удаляет позицию.
+type UpdateOrderItemsRequestOperationsItemOp string
 
 const (
-	PayOrderRequestPaymentMethodCARD          PayOrderRequestPaymentMethod = "CARD"
-	PayOrderRequestPaymentMethodSBP           PayOrderRequestPaymentMethod = "SBP"
-	PayOrderRequestPaymentMethodCREDITCARD    PayOrderRequestPaymentMethod = "CREDIT_CARD"
-	PayOrderRequestPaymentMethodINVESTORMONEY PayOrderRequestPaymentMethod = "INVESTOR_MONEY"
+	UpdateOrderItemsRequestOperationsItemOpADD         UpdateOrderItemsRequestOperationsItemOp = "ADD"
+	UpdateOrderItemsRequestOperationsItemOpREMOVE      UpdateOrderItemsRequestOperationsItemOp = "REMOVE"
+	UpdateOrderItemsRequestOperationsItemOpSETQUANTITY UpdateOrderItemsRequestOperationsItemOp = "SET_QUANTITY"
 )
 
-// AllValues returns all PayOrderRequestPaymentMethod values.
-func (PayOrderRequestPaymentMethod) AllValues() []PayOrderRequestPaymentMethod {
-	return []PayOrderRequestPaymentMethod{
-		PayOrderRequestPaymentMethodCARD,
-		PayOrderRequestPaymentMethodSBP,
-		PayOrderRequestPaymentMethodCREDITCARD,
-		PayOrderRequestPaymentMethodINVESTORMONEY,
+// AllValues returns all UpdateOrderItemsRequestOperationsItemOp values.
+func (UpdateOrderItemsRequestOperationsItemOp) AllValues() []UpdateOrderItemsRequestOperationsItemOp {
+	return []UpdateOrderItemsRequestOperationsItemOp{
+		UpdateOrderItemsRequestOperationsItemOpADD,
+		UpdateOrderItemsRequestOperationsItemOpREMOVE,
+		UpdateOrderItemsRequestOperationsItemOpSETQUANTITY,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PayOrderRequestPaymentMethod) MarshalText() ([]byte, error) {
+func (s UpdateOrderItemsRequestOperationsItemOp) MarshalText() ([]byte, error) {
 	switch s {
-	case PayOrderRequestPaymentMethodCARD:
+	case UpdateOrderItemsRequestOperationsItemOpADD:
 		return []byte(s), nil
-	case PayOrderRequestPaymentMethodSBP:
-		return []byte(s), nil
-	case PayOrderRequestPaymentMethodCREDITCARD:
+	case UpdateOrderItemsRequestOperationsItemOpREMOVE:
 		return []byte(s), nil
-	case PayOrderRequestPaymentMethodINVESTORMONEY:
+	case UpdateOrderItemsRequestOperationsItemOpSETQUANTITY:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -652,38 +4134,18 @@ func (s PayOrderRequestPaymentMethod) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PayOrderRequestPaymentMethod) UnmarshalText(data []byte) error {
-	switch PayOrderRequestPaymentMethod(data) {
-	case PayOrderRequestPaymentMethodCARD:
-		*s = PayOrderRequestPaymentMethodCARD
+func (s *UpdateOrderItemsRequestOperationsItemOp) UnmarshalText(data []byte) error {
+	switch UpdateOrderItemsRequestOperationsItemOp(data) {
+	case UpdateOrderItemsRequestOperationsItemOpADD:
+		*s = UpdateOrderItemsRequestOperationsItemOpADD
 		return nil
-	case PayOrderRequestPaymentMethodSBP:
-		*s = PayOrderRequestPaymentMethodSBP
-		return nil
-	case PayOrderRequestPaymentMethodCREDITCARD:
-		*s = PayOrderRequestPaymentMethodCREDITCARD
+	case UpdateOrderItemsRequestOperationsItemOpREMOVE:
+		*s = UpdateOrderItemsRequestOperationsItemOpREMOVE
 		return nil
-	case PayOrderRequestPaymentMethodINVESTORMONEY:
-		*s = PayOrderRequestPaymentMethodINVESTORMONEY
+	case UpdateOrderItemsRequestOperationsItemOpSETQUANTITY:
+		*s = UpdateOrderItemsRequestOperationsItemOpSETQUANTITY
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
-
-// Ref: #/components/schemas/PayOrderResponse
-type PayOrderResponse struct {
-	TransactionUUID string `json:"transaction_uuid"`
-}
-
-// GetTransactionUUID returns the value of TransactionUUID.
-func (s *PayOrderResponse) GetTransactionUUID() string {
-	return s.TransactionUUID
-}
-
-// SetTransactionUUID sets the value of TransactionUUID.
-func (s *PayOrderResponse) SetTransactionUUID(val string) {
-	s.TransactionUUID = val
-}
-
-func (*PayOrderResponse) payOrderRes() {}
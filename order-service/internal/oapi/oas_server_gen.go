@@ -8,30 +8,183 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AcceptQuote implements acceptQuote operation.
+	//
+	// Создаёт заказ по зафиксированным ценам, если детали
+	// есть в наличии.
+	//
+	// POST /api/v1/quotes/{quote_uuid}/accept
+	AcceptQuote(ctx context.Context, params AcceptQuoteParams) (AcceptQuoteRes, error)
+	// AddCartItem implements addCartItem operation.
+	//
+	// Добавить деталь в корзину.
+	//
+	// POST /api/v1/cart/items
+	AddCartItem(ctx context.Context, req *AddCartItemRequest, params AddCartItemParams) (AddCartItemRes, error)
+	// AddShipmentEvent implements addShipmentEvent operation.
+	//
+	// Служебный метод. ASSEMBLING переводит оплаченный заказ в
+	// сборку, SHIPPED — передаёт перевозчику
+	// (нужен tracking_number), IN_TRANSIT добавляет точку отслеживания,
+	// DELIVERED завершает заказ.
+	// При SHIPPED двухэтапная оплата списывается; истёкшая
+	// блокировка оформляется заново, а если провайдер
+	// отказывает, заказ отменяется и возвращается 409.
+	//
+	// POST /api/v1/admin/orders/{order_uuid}/shipment/events
+	AddShipmentEvent(ctx context.Context, req *ShipmentEventRequest, params AddShipmentEventParams) (AddShipmentEventRes, error)
+	// AuthorizeOrder implements authorizeOrder operation.
+	//
+	// Первый шаг двухэтапной оплаты: детали резервируются,
+	// сумма заказа блокируется у провайдера,
+	// заказ переходит в AUTHORIZED. Списание — при отправке
+	// заказа (SHIPPED) или методом capture.
+	// SBP двухэтапную оплату не поддерживает.
+	//
+	// POST /api/v1/orders/{order_uuid}/authorize
+	AuthorizeOrder(ctx context.Context, req *PayOrderRequest, params AuthorizeOrderParams) (AuthorizeOrderRes, error)
 	// CancelOrder implements cancelOrder operation.
 	//
-	// Отменить заказ.
+	// Оплаченный или заблокированный заказ сначала
+	// переходит в CANCELLING, затем оплата возвращается
+	// (или блокировка снимается) и заказ становится CANCELLED.
+	// Если вернуть оплату не удалось, заказ
+	// остаётся в CANCELLING; повторный вызов или фоновая
+	// проверка завершают отмену.
 	//
 	// POST /api/v1/orders/{order_uuid}/cancel
-	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
+	CancelOrder(ctx context.Context, req OptCancelOrderRequest, params CancelOrderParams) (CancelOrderRes, error)
+	// CaptureOrder implements captureOrder operation.
+	//
+	// Служебный метод. Списывает amount из заблокированной
+	// суммы (по умолчанию всю), остаток разблокируется.
+	// Заказ AUTHORIZED переходит в PAID, заказ в сборке остаётся
+	// ASSEMBLING.
+	// Если блокировка истекла — 409 с кодом AUTHORIZATION_EXPIRED.
+	//
+	// POST /api/v1/admin/orders/{order_uuid}/capture
+	CaptureOrder(ctx context.Context, req OptCaptureOrderRequest, params CaptureOrderParams) (CaptureOrderRes, error)
+	// CheckoutCart implements checkoutCart operation.
+	//
+	// Создаёт заказ по текущим ценам и очищает корзину в
+	// одной транзакции.
+	//
+	// POST /api/v1/cart/checkout
+	CheckoutCart(ctx context.Context, req OptCheckoutRequest, params CheckoutCartParams) (CheckoutCartRes, error)
 	// CreateOrder implements createOrder operation.
 	//
 	// Создать новый заказ.
 	//
 	// POST /api/v1/orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	// CreatePromoCode implements createPromoCode operation.
+	//
+	// Служебный метод. Новый промокод сразу активен.
+	//
+	// POST /api/v1/admin/promo-codes
+	CreatePromoCode(ctx context.Context, req *CreatePromoCodeRequest) (CreatePromoCodeRes, error)
+	// CreateQuote implements createQuote operation.
+	//
+	// Фиксирует текущие цены, налоги и доставку на время
+	// действия предложения.
+	//
+	// POST /api/v1/quotes
+	CreateQuote(ctx context.Context, req *CreateOrderRequest) (CreateQuoteRes, error)
+	// DeactivatePromoCode implements deactivatePromoCode operation.
+	//
+	// Служебный метод. Новые заказы с этим промокодом
+	// отклоняются с кодом PROMO_INACTIVE,
+	// скидки уже оформленных заказов сохраняются.
+	//
+	// POST /api/v1/admin/promo-codes/{code}/deactivate
+	DeactivatePromoCode(ctx context.Context, params DeactivatePromoCodeParams) (DeactivatePromoCodeRes, error)
+	// GetCart implements getCart operation.
+	//
+	// Получить корзину с актуальными ценами и остатками.
+	//
+	// GET /api/v1/cart
+	GetCart(ctx context.Context, params GetCartParams) (GetCartRes, error)
 	// GetOrder implements getOrder operation.
 	//
 	// Получить информацию о заказе.
 	//
 	// GET /api/v1/orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderHistory implements getOrderHistory operation.
+	//
+	// Получить историю статусов заказа.
+	//
+	// GET /api/v1/orders/{order_uuid}/history
+	GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (GetOrderHistoryRes, error)
+	// GetOrderShipment implements getOrderShipment operation.
+	//
+	// Получить информацию о доставке заказа.
+	//
+	// GET /api/v1/orders/{order_uuid}/shipment
+	GetOrderShipment(ctx context.Context, params GetOrderShipmentParams) (GetOrderShipmentRes, error)
+	// GetQuote implements getQuote operation.
+	//
+	// Получить коммерческое предложение.
+	//
+	// GET /api/v1/quotes/{quote_uuid}
+	GetQuote(ctx context.Context, params GetQuoteParams) (GetQuoteRes, error)
+	// ListPromoCodes implements listPromoCodes operation.
+	//
+	// Служебный метод. Возвращает все промокоды, включая
+	// отключённые, с числом использований.
+	//
+	// GET /api/v1/admin/promo-codes
+	ListPromoCodes(ctx context.Context) (*PromoCodeList, error)
 	// PayOrder implements payOrder operation.
 	//
-	// Оплатить заказ.
+	// Если провайдер подтверждает оплату позже (например,
+	// СБП по QR-коду), заказ переходит в PAYMENT_PENDING,
+	// в ответе status PENDING, payment_url и expires_at. Заказ становится PAID
+	// после подтверждения
+	// или возвращается в PENDING_PAYMENT, если оплата не прошла или
+	// не подтверждена вовремя.
+	// Оплата, отправленная проверкой рисков на ручную
+	// проверку, ожидается так же (status PENDING без payment_url)
+	// до решения оператора.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+	// PaymentCallback implements paymentCallback operation.
+	//
+	// Вызывается payment-service, когда ожидающая оплата
+	// завершилась. Исход не берётся из тела запроса,
+	// а проверяется через GetPaymentStatus; заказ в PAYMENT_PENDING
+	// становится PAID
+	// или возвращается в PENDING_PAYMENT.
+	//
+	// POST /api/v1/payments/callback
+	PaymentCallback(ctx context.Context, req *PaymentCallbackRequest) (PaymentCallbackRes, error)
+	// QuoteShipping implements quoteShipping operation.
+	//
+	// Считает фактический и объёмный вес посылки по
+	// габаритам деталей из inventory и цены всех перевозчиков
+	// для адреса доставки.
+	//
+	// POST /api/v1/shipping/quotes
+	QuoteShipping(ctx context.Context, req *ShippingQuoteRequest) (QuoteShippingRes, error)
+	// RemoveCartItem implements removeCartItem operation.
+	//
+	// Удалить деталь из корзины.
+	//
+	// DELETE /api/v1/cart/items/{part_uuid}
+	RemoveCartItem(ctx context.Context, params RemoveCartItemParams) (RemoveCartItemRes, error)
+	// UpdateCartItem implements updateCartItem operation.
+	//
+	// Изменить количество детали в корзине.
+	//
+	// PUT /api/v1/cart/items/{part_uuid}
+	UpdateCartItem(ctx context.Context, req *UpdateCartItemRequest, params UpdateCartItemParams) (UpdateCartItemRes, error)
+	// UpdateOrderItems implements updateOrderItems operation.
+	//
+	// Изменить состав неоплаченного заказа.
+	//
+	// PATCH /api/v1/orders/{order_uuid}/items
+	UpdateOrderItems(ctx context.Context, req *UpdateOrderItemsRequest, params UpdateOrderItemsParams) (UpdateOrderItemsRes, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
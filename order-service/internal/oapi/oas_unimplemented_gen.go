@@ -13,12 +13,87 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AcceptQuote implements acceptQuote operation.
+//
+// Создаёт заказ по зафиксированным ценам, если детали
+// есть в наличии.
+//
+// POST /api/v1/quotes/{quote_uuid}/accept
+func (UnimplementedHandler) AcceptQuote(ctx context.Context, params AcceptQuoteParams) (r AcceptQuoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddCartItem implements addCartItem operation.
+//
+// Добавить деталь в корзину.
+//
+// POST /api/v1/cart/items
+func (UnimplementedHandler) AddCartItem(ctx context.Context, req *AddCartItemRequest, params AddCartItemParams) (r AddCartItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddShipmentEvent implements addShipmentEvent operation.
+//
+// Служебный метод. ASSEMBLING переводит оплаченный заказ в
+// сборку, SHIPPED — передаёт перевозчику
+// (нужен tracking_number), IN_TRANSIT добавляет точку отслеживания,
+// DELIVERED завершает заказ.
+// При SHIPPED двухэтапная оплата списывается; истёкшая
+// блокировка оформляется заново, а если провайдер
+// отказывает, заказ отменяется и возвращается 409.
+//
+// POST /api/v1/admin/orders/{order_uuid}/shipment/events
+func (UnimplementedHandler) AddShipmentEvent(ctx context.Context, req *ShipmentEventRequest, params AddShipmentEventParams) (r AddShipmentEventRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AuthorizeOrder implements authorizeOrder operation.
+//
+// Первый шаг двухэтапной оплаты: детали резервируются,
+// сумма заказа блокируется у провайдера,
+// заказ переходит в AUTHORIZED. Списание — при отправке
+// заказа (SHIPPED) или методом capture.
+// SBP двухэтапную оплату не поддерживает.
+//
+// POST /api/v1/orders/{order_uuid}/authorize
+func (UnimplementedHandler) AuthorizeOrder(ctx context.Context, req *PayOrderRequest, params AuthorizeOrderParams) (r AuthorizeOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements cancelOrder operation.
 //
-// Отменить заказ.
+// Оплаченный или заблокированный заказ сначала
+// переходит в CANCELLING, затем оплата возвращается
+// (или блокировка снимается) и заказ становится CANCELLED.
+// Если вернуть оплату не удалось, заказ
+// остаётся в CANCELLING; повторный вызов или фоновая
+// проверка завершают отмену.
 //
 // POST /api/v1/orders/{order_uuid}/cancel
-func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
+func (UnimplementedHandler) CancelOrder(ctx context.Context, req OptCancelOrderRequest, params CancelOrderParams) (r CancelOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CaptureOrder implements captureOrder operation.
+//
+// Служебный метод. Списывает amount из заблокированной
+// суммы (по умолчанию всю), остаток разблокируется.
+// Заказ AUTHORIZED переходит в PAID, заказ в сборке остаётся
+// ASSEMBLING.
+// Если блокировка истекла — 409 с кодом AUTHORIZATION_EXPIRED.
+//
+// POST /api/v1/admin/orders/{order_uuid}/capture
+func (UnimplementedHandler) CaptureOrder(ctx context.Context, req OptCaptureOrderRequest, params CaptureOrderParams) (r CaptureOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CheckoutCart implements checkoutCart operation.
+//
+// Создаёт заказ по текущим ценам и очищает корзину в
+// одной транзакции.
+//
+// POST /api/v1/cart/checkout
+func (UnimplementedHandler) CheckoutCart(ctx context.Context, req OptCheckoutRequest, params CheckoutCartParams) (r CheckoutCartRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -31,6 +106,45 @@ func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderReq
 	return r, ht.ErrNotImplemented
 }
 
+// CreatePromoCode implements createPromoCode operation.
+//
+// Служебный метод. Новый промокод сразу активен.
+//
+// POST /api/v1/admin/promo-codes
+func (UnimplementedHandler) CreatePromoCode(ctx context.Context, req *CreatePromoCodeRequest) (r CreatePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateQuote implements createQuote operation.
+//
+// Фиксирует текущие цены, налоги и доставку на время
+// действия предложения.
+//
+// POST /api/v1/quotes
+func (UnimplementedHandler) CreateQuote(ctx context.Context, req *CreateOrderRequest) (r CreateQuoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeactivatePromoCode implements deactivatePromoCode operation.
+//
+// Служебный метод. Новые заказы с этим промокодом
+// отклоняются с кодом PROMO_INACTIVE,
+// скидки уже оформленных заказов сохраняются.
+//
+// POST /api/v1/admin/promo-codes/{code}/deactivate
+func (UnimplementedHandler) DeactivatePromoCode(ctx context.Context, params DeactivatePromoCodeParams) (r DeactivatePromoCodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCart implements getCart operation.
+//
+// Получить корзину с актуальными ценами и остатками.
+//
+// GET /api/v1/cart
+func (UnimplementedHandler) GetCart(ctx context.Context, params GetCartParams) (r GetCartRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrder implements getOrder operation.
 //
 // Получить информацию о заказе.
@@ -40,15 +154,111 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderHistory implements getOrderHistory operation.
+//
+// Получить историю статусов заказа.
+//
+// GET /api/v1/orders/{order_uuid}/history
+func (UnimplementedHandler) GetOrderHistory(ctx context.Context, params GetOrderHistoryParams) (r GetOrderHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrderShipment implements getOrderShipment operation.
+//
+// Получить информацию о доставке заказа.
+//
+// GET /api/v1/orders/{order_uuid}/shipment
+func (UnimplementedHandler) GetOrderShipment(ctx context.Context, params GetOrderShipmentParams) (r GetOrderShipmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetQuote implements getQuote operation.
+//
+// Получить коммерческое предложение.
+//
+// GET /api/v1/quotes/{quote_uuid}
+func (UnimplementedHandler) GetQuote(ctx context.Context, params GetQuoteParams) (r GetQuoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPromoCodes implements listPromoCodes operation.
+//
+// Служебный метод. Возвращает все промокоды, включая
+// отключённые, с числом использований.
+//
+// GET /api/v1/admin/promo-codes
+func (UnimplementedHandler) ListPromoCodes(ctx context.Context) (r *PromoCodeList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
-// Оплатить заказ.
+// Если провайдер подтверждает оплату позже (например,
+// СБП по QR-коду), заказ переходит в PAYMENT_PENDING,
+// в ответе status PENDING, payment_url и expires_at. Заказ становится PAID
+// после подтверждения
+// или возвращается в PENDING_PAYMENT, если оплата не прошла или
+// не подтверждена вовремя.
+// Оплата, отправленная проверкой рисков на ручную
+// проверку, ожидается так же (status PENDING без payment_url)
+// до решения оператора.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// PaymentCallback implements paymentCallback operation.
+//
+// Вызывается payment-service, когда ожидающая оплата
+// завершилась. Исход не берётся из тела запроса,
+// а проверяется через GetPaymentStatus; заказ в PAYMENT_PENDING
+// становится PAID
+// или возвращается в PENDING_PAYMENT.
+//
+// POST /api/v1/payments/callback
+func (UnimplementedHandler) PaymentCallback(ctx context.Context, req *PaymentCallbackRequest) (r PaymentCallbackRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// QuoteShipping implements quoteShipping operation.
+//
+// Считает фактический и объёмный вес посылки по
+// габаритам деталей из inventory и цены всех перевозчиков
+// для адреса доставки.
+//
+// POST /api/v1/shipping/quotes
+func (UnimplementedHandler) QuoteShipping(ctx context.Context, req *ShippingQuoteRequest) (r QuoteShippingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveCartItem implements removeCartItem operation.
+//
+// Удалить деталь из корзины.
+//
+// DELETE /api/v1/cart/items/{part_uuid}
+func (UnimplementedHandler) RemoveCartItem(ctx context.Context, params RemoveCartItemParams) (r RemoveCartItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateCartItem implements updateCartItem operation.
+//
+// Изменить количество детали в корзине.
+//
+// PUT /api/v1/cart/items/{part_uuid}
+func (UnimplementedHandler) UpdateCartItem(ctx context.Context, req *UpdateCartItemRequest, params UpdateCartItemParams) (r UpdateCartItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrderItems implements updateOrderItems operation.
+//
+// Изменить состав неоплаченного заказа.
+//
+// PATCH /api/v1/orders/{order_uuid}/items
+func (UnimplementedHandler) UpdateOrderItems(ctx context.Context, req *UpdateOrderItemsRequest, params UpdateOrderItemsParams) (r UpdateOrderItemsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
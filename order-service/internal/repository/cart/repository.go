@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Get loads the cart of a user and its items in the order they were added.
+// A user without a cart gets an empty one at version 0.
+func (r *Repository) Get(ctx context.Context, userId string) (*model.Cart, []model.CartItem, error) {
+	cart := model.Cart{UserUUID: userId}
+	err := r.pool.QueryRow(ctx, `SELECT version, updated_at FROM carts WHERE user_id = $1`, userId).Scan(&cart.Version, &cart.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &cart, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT part_id, quantity, added_price, added_at, updated_at FROM cart_items WHERE user_id = $1 ORDER BY added_at, part_id`, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var items []model.CartItem
+	for rows.Next() {
+		var item model.CartItem
+		if err := rows.Scan(&item.PartUUID, &item.Quantity, &item.AddedPrice, &item.AddedAt, &item.UpdatedAt); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return &cart, items, nil
+}
+
+// AddItem adds item.Quantity units of a part to the cart, creating the cart
+// if needed. Adding a part that is already in the cart increases its
+// quantity and refreshes the added price.
+func (r *Repository) AddItem(ctx context.Context, userId string, item model.CartItem) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := bumpVersion(ctx, tx, userId); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO cart_items (user_id, part_id, quantity, added_price) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, part_id) DO UPDATE SET
+			quantity = cart_items.quantity + EXCLUDED.quantity,
+			added_price = EXCLUDED.added_price,
+			updated_at = now()`,
+		userId, item.PartUUID, item.Quantity, item.AddedPrice)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// UpdateItem sets the quantity of a part already in the cart. ErrNotFound is
+// returned if it is not there.
+func (r *Repository) UpdateItem(ctx context.Context, userId, partId string, quantity int) error {
+	return r.changeItem(ctx, userId, `UPDATE cart_items SET quantity = $3, updated_at = now() WHERE user_id = $1 AND part_id = $2`, partId, quantity)
+}
+
+// RemoveItem deletes a part from the cart. ErrNotFound is returned if it is
+// not there.
+func (r *Repository) RemoveItem(ctx context.Context, userId, partId string) error {
+	return r.changeItem(ctx, userId, `DELETE FROM cart_items WHERE user_id = $1 AND part_id = $2`, partId)
+}
+
+func (r *Repository) changeItem(ctx context.Context, userId, query string, args ...any) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, query, append([]any{userId}, args...)...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	if err := bumpVersion(ctx, tx, userId); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func bumpVersion(ctx context.Context, tx pgx.Tx, userId string) error {
+	_, err := tx.Exec(ctx, `INSERT INTO carts (user_id) VALUES ($1)
+		ON CONFLICT (user_id) DO UPDATE SET version = carts.version + 1, updated_at = now()`, userId)
+	return err
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+// CartItem is a line of a cart as stored. AddedPrice is the unit price the
+// customer saw when the line was last added.
+type CartItem struct {
+	PartUUID   string
+	Quantity   int
+	AddedPrice float64
+	AddedAt    time.Time
+	UpdatedAt  time.Time
+}
+
+// Cart warning codes reported in CartWarning.
+const (
+	CodePriceChanged = "PRICE_CHANGED"
+)
+
+// CartLine is a cart item annotated with the current inventory data.
+// Available is false for parts that no longer exist in inventory.
+type CartLine struct {
+	CartItem
+	Name          string
+	Price         float64
+	StockQuantity int
+	Available     bool
+}
+
+// CartWarning tells the customer that a line changed since it was added or
+// cannot be ordered as is. Codes are CodePriceChanged, CodePartNotFound and
+// CodeNotEnoughStock.
+type CartWarning struct {
+	PartUUID string
+	Code     string
+	Message  string
+}
+
+// Cart is the persistent cart of a user. Version grows with every change and
+// lets checkout detect a cart modified while the order was being placed.
+type Cart struct {
+	UserUUID  string
+	Version   int
+	Lines     []CartLine
+	Subtotal  float64
+	Warnings  []CartWarning
+	UpdatedAt *time.Time
+}
+
+// CartRef names the cart an order is checked out from. The cart is deleted
+// together with storing the order, provided it is still at Version.
+type CartRef struct {
+	UserUUID string
+	Version  int
+}
+
+// CheckoutRequest carries the order options for checking out a cart; the
+// items come from the cart.
+type CheckoutRequest struct {
+	UserUUID        string
+	PromoCode       string
+	BuyerCountry    string
+	ShippingOption  string
+	DeliveryAddress *Address
+}
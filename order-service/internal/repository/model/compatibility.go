@@ -0,0 +1,39 @@
+package model
+
+import "fmt"
+
+// Compatibility rule kinds reported in ConfigurationViolation.Rule.
+const (
+	RuleRequires       = "REQUIRES"
+	RuleExcludes       = "EXCLUDES"
+	RuleCompatibleWith = "COMPATIBLE_WITH"
+	RuleUnknownPart    = "UNKNOWN_PART"
+)
+
+// ConfigurationViolation is a broken compatibility rule of one part of an
+// order. The target is either another part or a whole category.
+type ConfigurationViolation struct {
+	PartUUID       string
+	Rule           string
+	TargetPartUUID string
+	TargetCategory string
+	Message        string
+}
+
+// ConfigurationError rejects an order whose parts do not fit together. It
+// unwraps to ErrBadRequest.
+type ConfigurationError struct {
+	Violations []ConfigurationViolation
+}
+
+func (e *ConfigurationError) Error() string {
+	msg := "incompatible configuration"
+	for _, v := range e.Violations {
+		msg += fmt.Sprintf("; %s", v.Message)
+	}
+	return msg
+}
+
+func (e *ConfigurationError) Unwrap() error {
+	return ErrBadRequest
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"time"
 )
 
 type OrderStatus string
@@ -10,17 +13,115 @@ type PaymentMethod string
 
 const (
 	StatusPendingPayment OrderStatus = "PENDING_PAYMENT"
-	StatusPaid           OrderStatus = "PAID"
-	StatusCancelled      OrderStatus = "CANCELLED"
+	// StatusPaymentPending is an order whose payment was started but not
+	// confirmed by the provider yet, e.g. an unpaid SBP QR code.
+	StatusPaymentPending OrderStatus = "PAYMENT_PENDING"
+	// StatusAuthorized is an order whose payment is held by the provider
+	// and captured when the order ships.
+	StatusAuthorized OrderStatus = "AUTHORIZED"
+	StatusPaid       OrderStatus = "PAID"
+	// StatusCancelling is a paid or authorized order being cancelled whose
+	// payment is not given back yet.
+	StatusCancelling OrderStatus = "CANCELLING"
+	StatusCancelled  OrderStatus = "CANCELLED"
+	StatusAssembling OrderStatus = "ASSEMBLING"
+	StatusShipped    OrderStatus = "SHIPPED"
+	StatusDelivered  OrderStatus = "DELIVERED"
 )
 
+// transitions lists the statuses an order may move to from a given status.
+var transitions = map[OrderStatus][]OrderStatus{
+	StatusPendingPayment: {StatusPaid, StatusPaymentPending, StatusAuthorized, StatusCancelled},
+	StatusPaymentPending: {StatusPaid, StatusPendingPayment},
+	StatusAuthorized:     {StatusPaid, StatusAssembling, StatusCancelling},
+	StatusPaid:           {StatusAssembling, StatusCancelling},
+	StatusAssembling:     {StatusShipped, StatusCancelling},
+	StatusCancelling:     {StatusCancelled},
+	StatusShipped:        {StatusDelivered},
+}
+
+// IsPaid reports whether an order in this status has been paid and not
+// refunded.
+func (s OrderStatus) IsPaid() bool {
+	switch s {
+	case StatusPaid, StatusAssembling, StatusShipped, StatusDelivered:
+		return true
+	}
+	return false
+}
+
+// CanTransition reports whether an order in status from may move to status to.
+func CanTransition(from, to OrderStatus) bool {
+	for _, v := range transitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	ErrBadRequest       = errors.New("400 bad request")
 	ErrConflict         = errors.New("409 conflict")
 	ErrNotFound         = errors.New("404 not found")
 	ErrNotEnoughInStock = errors.New("400 not enough in stock")
+	// ErrOrderChanged is returned when an order was changed by someone else
+	// between reading and saving it; reading it again and retrying may help.
+	ErrOrderChanged = fmt.Errorf("%w: order was changed concurrently", ErrConflict)
+	// ErrAuthorizationExpired is returned when the payment hold of an
+	// authorized order ran out before it was captured.
+	ErrAuthorizationExpired = errors.New("409 payment authorization expired")
+	// ErrPaymentRequired is returned when the payment provider refuses the
+	// payment, e.g. for lack of funds; see PaymentRefusedError.
+	ErrPaymentRequired = errors.New("402 payment required")
 )
 
+// Line error codes reported in ValidationError.
+const (
+	CodeInvalidPartUUID = "INVALID_PART_UUID"
+	CodeInvalidQuantity = "INVALID_QUANTITY"
+	CodePartNotFound    = "PART_NOT_FOUND"
+	CodeNotEnoughStock  = "NOT_ENOUGH_STOCK"
+)
+
+// LineError describes a problem with a single line of an order request.
+// Line is the index of the line in the request as sent by the client.
+type LineError struct {
+	Line     int
+	PartUUID string
+	Code     string
+	Message  string
+}
+
+// ValidationError collects every rejected line of an order request instead
+// of stopping at the first one. It unwraps to ErrNotFound if any part is
+// unknown, to ErrNotEnoughInStock if only stock is short and to
+// ErrBadRequest otherwise, so callers can keep mapping it to a status code.
+type ValidationError struct {
+	Lines []LineError
+}
+
+func (e *ValidationError) Error() string {
+	msg := e.Unwrap().Error()
+	for _, v := range e.Lines {
+		msg += fmt.Sprintf("; line %d: %s", v.Line, v.Message)
+	}
+	return msg
+}
+
+func (e *ValidationError) Unwrap() error {
+	kind := ErrBadRequest
+	for _, v := range e.Lines {
+		switch v.Code {
+		case CodePartNotFound:
+			return ErrNotFound
+		case CodeNotEnoughStock:
+			kind = ErrNotEnoughInStock
+		}
+	}
+	return kind
+}
+
 const (
 	PaymentCard     PaymentMethod = "CARD"
 	PaymentSBP      PaymentMethod = "SBP"
@@ -28,15 +129,150 @@ const (
 	PaymentInvestor PaymentMethod = "INVESTOR_MONEY"
 )
 
+type CancelReason string
+
+const (
+	CancelReasonCustomerRequest  CancelReason = "CUSTOMER_REQUEST"
+	CancelReasonOrderedByMistake CancelReason = "ORDERED_BY_MISTAKE"
+	CancelReasonFoundCheaper     CancelReason = "FOUND_CHEAPER"
+	CancelReasonDeliveryTooLong  CancelReason = "DELIVERY_TOO_LONG"
+	CancelReasonOther            CancelReason = "OTHER"
+)
+
 type Order struct {
 	OrderUUID string `json:"order_uuid"`
 	UserUUID  string /* `json:"user_uuid"` */
 	/* PartUUIDs       []string `json:"part_uuids"` */
-	Items           []Item  `json:"items"`
-	TotalPrice      float64 `json:"total_price"`
+	Items           []Item   `json:"items"`
+	Subtotal        float64  `json:"subtotal"`
+	DiscountTotal   float64  `json:"discount_total"`
+	TaxTotal        float64  `json:"tax_total"`
+	DutyTotal       float64  `json:"duty_total"`
+	ShippingCost    float64  `json:"shipping_cost"`
+	TotalPrice      float64  `json:"total_price"`
+	PromoCode       *string  `json:"promo_code"`
+	BuyerCountry    *string  `json:"buyer_country"`
+	ShippingCarrier *string  `json:"shipping_carrier"`
+	ShippingOption  *string  `json:"shipping_option"`
+	DeliveryAddress *Address `json:"delivery_address"`
+	// Cart is set when the order is checked out from a cart. It is not
+	// stored with the order; the repository deletes the cart instead.
+	Cart *CartRef `json:"-"`
+	// QuoteUUID is set when the order is created from a quote. The
+	// repository marks the quote accepted together with the order insert.
+	QuoteUUID       *string `json:"-"`
 	TransactionUUID *string
 	PaymentMethod   *PaymentMethod `json:"payment_method"`
 	Status          OrderStatus    `json:"status"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	PaidAt          *time.Time
+	CancelledAt     *time.Time
+	CancelReason    *CancelReason
+	CancelComment   *string
+	RefundUUID      *string
+	// AuthorizedAmount is set when the order is paid in two steps: the
+	// amount held until AuthorizationExpiresAt. CapturedAmount is set once
+	// the payment is captured.
+	AuthorizedAmount       *float64
+	CapturedAmount         *float64
+	AuthorizationExpiresAt *time.Time
+	// PaymentDeadline is set while the order is PAYMENT_PENDING: the
+	// payment goes back to PENDING_PAYMENT if it is not confirmed by then.
+	PaymentDeadline *time.Time
+}
+
+// AwaitingCapture reports whether the payment of the order is authorized but
+// not captured yet.
+func (o *Order) AwaitingCapture() bool {
+	return o.AuthorizedAmount != nil && o.CapturedAmount == nil
+}
+
+// PaymentKey is the idempotency key of paying the order as it is now. Every
+// save bumps UpdatedAt, so retrying a payment the order did not record yet
+// repeats the key, while paying again after a failed attempt, which puts the
+// order back to PENDING_PAYMENT, uses a new one.
+func (o *Order) PaymentKey() string {
+	return fmt.Sprintf("%s-%d", o.OrderUUID, o.UpdatedAt.UnixMicro())
+}
+
+// Authorization is a payment hold made for an order.
+type Authorization struct {
+	TransactionUUID string
+	ExpiresAt       time.Time
+}
+
+// PaymentState is the outcome of a payment as reported by payment-service.
+type PaymentState string
+
+const (
+	PaymentSucceeded PaymentState = "SUCCEEDED"
+	// PaymentPending is a payment waiting for the customer or the provider,
+	// its outcome arrives later.
+	PaymentPending PaymentState = "PENDING"
+	PaymentFailed  PaymentState = "FAILED"
+)
+
+// Payment is a charge made for an order.
+type Payment struct {
+	TransactionUUID string
+	State           PaymentState
+	// PaymentURL is where the customer completes a pending payment, e.g.
+	// the SBP QR code link.
+	PaymentURL string
+	// ExpiresAt is until when a pending payment can be completed, zero if
+	// the provider does not say.
+	ExpiresAt time.Time
+	// FailureCode is the provider code of a failed payment.
+	FailureCode string
+}
+
+// PaymentRefusedError is a payment the provider refused. Reason is the
+// provider failure code, such as INSUFFICIENT_FUNDS or LIMIT_EXCEEDED, and
+// Message its explanation. It unwraps to ErrPaymentRequired.
+type PaymentRefusedError struct {
+	Reason  string
+	Message string
+}
+
+func (e *PaymentRefusedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPaymentRequired, e.Message)
+}
+
+func (e *PaymentRefusedError) Unwrap() error {
+	return ErrPaymentRequired
+}
+
+// StatusChange is a single entry of the order status history.
+// From is empty for the entry written when the order is created.
+type StatusChange struct {
+	From      OrderStatus
+	To        OrderStatus
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// OrderRequest is what the customer asks for when placing an order.
+type OrderRequest struct {
+	UserUUID  string
+	Items     []Item
+	PromoCode string
+	// BuyerCountry selects the tax rates; empty means the configured default.
+	BuyerCountry string
+	// ShippingOption is the code of the chosen delivery option, see
+	// ShippingQuote.Option. Empty means no delivery.
+	ShippingOption string
+	// DeliveryAddress is where the order is shipped to. Its country is the
+	// shipping destination; without an address BuyerCountry is used.
+	DeliveryAddress *Address
+	// Cart is the cart the items were taken from, if any.
+	Cart *CartRef
+}
+
+type OrderHistory struct {
+	Order   *Order
+	Changes []StatusChange
 }
 
 type Part struct {
@@ -44,11 +280,86 @@ type Part struct {
 	Price    float64
 	Quantity int
 	Name     string
+	Category string
+	// ManufacturerCountry is the country the part is made in, as stored in
+	// inventory.
+	ManufacturerCountry string
+	Dimensions          *Dimensions
 }
 
+// Item is an order line. Price is the unit price; Discount, Tax and Duty are
+// amounts for the whole line.
 type Item struct {
-	PartUUID string  `json:"part_uuid"`
-	Quantity int     `json:"quantity"`
-	Price    float64 `json:"price"`
-	Name     string  `json:"name"`
+	PartUUID            string  `json:"part_uuid"`
+	Quantity            int     `json:"quantity"`
+	Price               float64 `json:"price"`
+	Name                string  `json:"name"`
+	Category            string  `json:"category"`
+	ManufacturerCountry string  `json:"manufacturer_country"`
+	Discount            float64 `json:"discount"`
+	Tax                 float64 `json:"tax"`
+	Duty                float64 `json:"duty"`
+	// Dimensions of one unit, taken from inventory when the line is priced.
+	// They are not stored with the order.
+	Dimensions *Dimensions `json:"-"`
+}
+
+// Amount is the line price before discounts.
+func (i Item) Amount() float64 {
+	return i.Price * float64(i.Quantity)
+}
+
+// Recalculate sums up the order lines and the shipping cost into the order
+// totals.
+func (o *Order) Recalculate() {
+	o.Subtotal = 0
+	o.DiscountTotal = 0
+	o.TaxTotal = 0
+	o.DutyTotal = 0
+	for _, v := range o.Items {
+		o.Subtotal += v.Amount()
+		o.DiscountTotal += v.Discount
+		o.TaxTotal += v.Tax
+		o.DutyTotal += v.Duty
+	}
+	o.Subtotal = RoundMoney(o.Subtotal)
+	o.DiscountTotal = RoundMoney(o.DiscountTotal)
+	o.TaxTotal = RoundMoney(o.TaxTotal)
+	o.DutyTotal = RoundMoney(o.DutyTotal)
+	o.ShippingCost = RoundMoney(o.ShippingCost)
+	o.TotalPrice = RoundMoney(o.Subtotal - o.DiscountTotal + o.TaxTotal + o.DutyTotal + o.ShippingCost)
+}
+
+// RoundMoney rounds an amount to whole cents.
+func RoundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+type ItemOperationType string
+
+const (
+	ItemOpAdd         ItemOperationType = "ADD"
+	ItemOpRemove      ItemOperationType = "REMOVE"
+	ItemOpSetQuantity ItemOperationType = "SET_QUANTITY"
+)
+
+// ItemOperation is a single change to the items of a pending order.
+// Quantity is ignored for ItemOpRemove.
+type ItemOperation struct {
+	Op       ItemOperationType
+	PartUUID string
+	Quantity int
+}
+
+// Dimensions of a part as stored in inventory: metres and kilograms.
+type Dimensions struct {
+	Length float64
+	Width  float64
+	Height float64
+	Weight float64
+}
+
+// Volume is the part volume in cubic metres.
+func (d Dimensions) Volume() float64 {
+	return d.Length * d.Width * d.Height
 }
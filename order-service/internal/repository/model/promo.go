@@ -0,0 +1,71 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+type PromoRuleType string
+
+const (
+	// PromoPercentage takes Value percent off every line.
+	PromoPercentage PromoRuleType = "PERCENTAGE"
+	// PromoFixedAmount takes Value off the order, spread over the lines in
+	// proportion to their amount.
+	PromoFixedAmount PromoRuleType = "FIXED_AMOUNT"
+	// PromoCategoryPercentage takes Value percent off the lines of Category.
+	PromoCategoryPercentage PromoRuleType = "CATEGORY_PERCENTAGE"
+	// PromoBuyXGetY discounts GetQuantity units of GetCategory by Value
+	// percent for every BuyQuantity units of Category in the order.
+	PromoBuyXGetY PromoRuleType = "BUY_X_GET_Y"
+)
+
+// PromoCode is a promotion customers can apply when placing an order.
+// Nil limits and validity bounds mean "unlimited".
+type PromoCode struct {
+	Code         string
+	RuleType     PromoRuleType
+	Value        float64
+	Category     string
+	BuyQuantity  int
+	GetCategory  string
+	GetQuantity  int
+	MinSubtotal  float64
+	ValidFrom    *time.Time
+	ValidTo      *time.Time
+	MaxUses      *int
+	PerUserLimit *int
+	UsedCount    int
+	Active       bool
+	CreatedAt    time.Time
+}
+
+// Promo error codes reported in PromoError.
+const (
+	CodePromoNotFound      = "PROMO_NOT_FOUND"
+	CodePromoInactive      = "PROMO_INACTIVE"
+	CodePromoNotStarted    = "PROMO_NOT_STARTED"
+	CodePromoExpired       = "PROMO_EXPIRED"
+	CodePromoUsageLimit    = "PROMO_USAGE_LIMIT"
+	CodePromoUserLimit     = "PROMO_USER_LIMIT"
+	CodePromoNotApplicable = "PROMO_NOT_APPLICABLE"
+)
+
+// PromoError explains why a promo code was rejected. It unwraps to
+// ErrBadRequest.
+type PromoError struct {
+	Code    string
+	Message string
+}
+
+func NewPromoError(code, format string, args ...any) *PromoError {
+	return &PromoError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *PromoError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrBadRequest, e.Message)
+}
+
+func (e *PromoError) Unwrap() error {
+	return ErrBadRequest
+}
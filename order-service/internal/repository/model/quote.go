@@ -0,0 +1,32 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+type QuoteStatus string
+
+const (
+	QuoteOpen     QuoteStatus = "OPEN"
+	QuoteAccepted QuoteStatus = "ACCEPTED"
+	// QuoteExpired is not stored: an open quote past ExpiresAt is reported
+	// as expired.
+	QuoteExpired QuoteStatus = "EXPIRED"
+)
+
+var ErrQuoteExpired = errors.New("quote expired")
+
+// Quote is a priced order the customer may accept until ExpiresAt. Order
+// holds the lines and totals locked when the quote was made; its OrderUUID
+// and status are not used until the quote is accepted.
+type Quote struct {
+	QuoteUUID  string
+	Status     QuoteStatus
+	Order      Order
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	AcceptedAt *time.Time
+	// OrderUUID is the order created when the quote was accepted.
+	OrderUUID *string
+}
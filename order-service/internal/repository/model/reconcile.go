@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+// Statuses of PaymentTransaction, as payment-service names them.
+const (
+	TransactionPaid     = "PAID"
+	TransactionRefunded = "REFUNDED"
+)
+
+// PaymentTransaction is a transaction as payment-service records it.
+type PaymentTransaction struct {
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	Method          PaymentMethod
+	// Status is the payment-service status: PAID, REFUNDED, PENDING, ...
+	Status         string
+	Amount         float64
+	CapturedAmount float64
+	RefundUUID     string
+	CreatedAt      time.Time
+}
+
+type MismatchKind string
+
+const (
+	// MismatchMissingPayment is a paid order whose transaction
+	// payment-service does not know.
+	MismatchMissingPayment MismatchKind = "MISSING_PAYMENT"
+	// MismatchMissingOrder is a payment of an order that does not exist.
+	MismatchMissingOrder MismatchKind = "MISSING_ORDER"
+	// MismatchDuplicate is a second payment of an order that is paid by
+	// another transaction.
+	MismatchDuplicate     MismatchKind = "DUPLICATE"
+	MismatchAmountDiffers MismatchKind = "AMOUNT_DIFFERS"
+	// MismatchStatusDiffers is an order and its payment that disagree on
+	// whether the order is paid or refunded.
+	MismatchStatusDiffers MismatchKind = "STATUS_DIFFERS"
+)
+
+// Mismatch is a disagreement between an order and the payments of it.
+// Fixed is set when the order status was corrected from the payment side.
+type Mismatch struct {
+	Kind            MismatchKind `json:"kind"`
+	OrderUUID       string       `json:"order_uuid"`
+	TransactionUUID string       `json:"transaction_uuid,omitempty"`
+	OrderStatus     OrderStatus  `json:"order_status,omitempty"`
+	PaymentStatus   string       `json:"payment_status,omitempty"`
+	OrderAmount     float64      `json:"order_amount,omitempty"`
+	PaymentAmount   float64      `json:"payment_amount,omitempty"`
+	Detail          string       `json:"detail"`
+	Fixed           bool         `json:"fixed"`
+}
+
+// ReconciliationReport is the outcome of matching the orders paid in
+// [From, To) against the payments made in it.
+type ReconciliationReport struct {
+	From                time.Time  `json:"from"`
+	To                  time.Time  `json:"to"`
+	GeneratedAt         time.Time  `json:"generated_at"`
+	OrdersChecked       int        `json:"orders_checked"`
+	TransactionsChecked int        `json:"transactions_checked"`
+	Matched             int        `json:"matched"`
+	Mismatches          []Mismatch `json:"mismatches"`
+}
@@ -0,0 +1,98 @@
+package model
+
+import "time"
+
+// Address is where an order is delivered to.
+type Address struct {
+	Country    string `json:"country"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Street     string `json:"street"`
+}
+
+// ShippingQuoteRequest asks for delivery options of items to an address.
+type ShippingQuoteRequest struct {
+	Items   []Item
+	Address Address
+}
+
+// ShippingQuote is the price of one carrier option for a parcel. Carriers
+// convert volume into weight differently, so the volumetric and the
+// chargeable weight (the larger of actual and volumetric) are per quote.
+type ShippingQuote struct {
+	Carrier          string
+	Option           string
+	Name             string
+	Cost             float64
+	DaysMin          int
+	DaysMax          int
+	VolumetricWeight float64
+	ChargeableWeight float64
+}
+
+// ShippingQuotes lists the delivery options for a parcel of ActualWeight
+// kilograms and Volume cubic metres.
+type ShippingQuotes struct {
+	ActualWeight float64
+	Volume       float64
+	Options      []ShippingQuote
+}
+
+type ShipmentEventType string
+
+const (
+	// ShipmentAssembling starts assembling a paid order.
+	ShipmentAssembling ShipmentEventType = "ASSEMBLING"
+	// ShipmentShipped hands the order over to the carrier.
+	ShipmentShipped ShipmentEventType = "SHIPPED"
+	// ShipmentInTransit is a tracking checkpoint of a shipped order.
+	ShipmentInTransit ShipmentEventType = "IN_TRANSIT"
+	// ShipmentDelivered completes the order.
+	ShipmentDelivered ShipmentEventType = "DELIVERED"
+)
+
+// OrderStatus is the status an order moves to when the event is recorded.
+// IN_TRANSIT keeps the order SHIPPED.
+func (t ShipmentEventType) OrderStatus() (OrderStatus, bool) {
+	switch t {
+	case ShipmentAssembling:
+		return StatusAssembling, true
+	case ShipmentShipped, ShipmentInTransit:
+		return StatusShipped, true
+	case ShipmentDelivered:
+		return StatusDelivered, true
+	}
+	return "", false
+}
+
+// Shipment tracks the delivery of an order. An order has at most one
+// shipment; it is created when assembling starts.
+type Shipment struct {
+	OrderUUID      string
+	Status         OrderStatus
+	Carrier        *string
+	TrackingNumber *string
+	Address        *Address
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Events         []ShipmentEvent
+}
+
+type ShipmentEvent struct {
+	Type        ShipmentEventType
+	Location    string
+	Description string
+	Actor       string
+	CreatedAt   time.Time
+}
+
+// ShipmentUpdate is what staff report about the progress of a shipment.
+// Carrier and TrackingNumber are only taken into account on SHIPPED.
+type ShipmentUpdate struct {
+	Type           ShipmentEventType
+	Carrier        string
+	TrackingNumber string
+	Location       string
+	Description    string
+	Actor          string
+}
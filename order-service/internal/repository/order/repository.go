@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"order-service/internal/repository/model"
 	"time"
 
@@ -28,24 +29,57 @@ func (o *Repository) Create(ctx context.Context, order *model.Order) error {
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = tx.Exec(ctx, `INSERT INTO orders (id, user_id, status, total_price, created_at) VALUES ($1, $2, $3, $4, $5)`, order.OrderUUID, order.UserUUID, order.Status, order.TotalPrice, time.Now())
+	now := time.Now()
+	addr := addressColumns(order.DeliveryAddress)
+	_, err = tx.Exec(ctx, `INSERT INTO orders (id, user_id, status, subtotal, discount_total, tax_total, duty_total, shipping_cost, total_price, promo_code, buyer_country, shipping_carrier, shipping_option, delivery_country, delivery_city, delivery_postal_code, delivery_street, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $18)`,
+		order.OrderUUID, order.UserUUID, order.Status, order.Subtotal, order.DiscountTotal, order.TaxTotal, order.DutyTotal, order.ShippingCost, order.TotalPrice,
+		order.PromoCode, order.BuyerCountry, order.ShippingCarrier, order.ShippingOption, addr.Country, addr.City, addr.PostalCode, addr.Street, now)
 	if err != nil {
 		return err
 	}
+	order.CreatedAt = now
+	order.UpdatedAt = now
 
-	for _, items := range order.Items {
-		_, err := tx.Exec(ctx, `INSERT INTO order_items (order_id, part_id, quantity, price, name) VALUES ($1, $2, $3, $4, $5)`, order.OrderUUID, items.PartUUID, items.Quantity, items.Price, items.Name)
+	if order.PromoCode != nil {
+		if err := redeemPromo(ctx, tx, *order.PromoCode, order.UserUUID, order.OrderUUID); err != nil {
+			return err
+		}
+	}
+	if order.Cart != nil {
+		tag, err := tx.Exec(ctx, `DELETE FROM carts WHERE user_id = $1 AND version = $2`, order.Cart.UserUUID, order.Cart.Version)
 		if err != nil {
 			return err
 		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: cart was changed during checkout", model.ErrConflict)
+		}
+	}
+	if order.QuoteUUID != nil {
+		if err := acceptQuote(ctx, tx, *order.QuoteUUID, order.OrderUUID); err != nil {
+			return err
+		}
+	}
+
+	if err := insertItems(ctx, tx, order); err != nil {
+		return err
+	}
+
+	err = insertStatusChange(ctx, tx, order.OrderUUID, model.StatusChange{
+		To:     order.Status,
+		Actor:  order.UserUUID,
+		Reason: "order created",
+	})
+	if err != nil {
+		return err
 	}
 	return tx.Commit(ctx)
 }
 
 func (o *Repository) Get(ctx context.Context, orderId string) (*model.Order, error) {
-	row := o.pool.QueryRow(ctx, `SELECT id, user_id, payment_method, status, total_price, transaction_id FROM orders WHERE id = $1`, orderId)
+	row := o.pool.QueryRow(ctx, `SELECT id, user_id, payment_method, status, subtotal, discount_total, tax_total, duty_total, shipping_cost, total_price, promo_code, buyer_country, shipping_carrier, shipping_option, delivery_country, delivery_city, delivery_postal_code, delivery_street, transaction_id, created_at, updated_at, paid_at, cancelled_at, cancel_reason, cancel_comment, refund_transaction_id, authorized_amount, captured_amount, authorization_expires_at, payment_deadline FROM orders WHERE id = $1`, orderId)
 	var order model.Order
-	err := row.Scan(&order.OrderUUID, &order.UserUUID, &order.PaymentMethod, &order.Status, &order.TotalPrice, &order.TransactionUUID)
+	var addr deliveryAddress
+	err := row.Scan(&order.OrderUUID, &order.UserUUID, &order.PaymentMethod, &order.Status, &order.Subtotal, &order.DiscountTotal, &order.TaxTotal, &order.DutyTotal, &order.ShippingCost, &order.TotalPrice, &order.PromoCode, &order.BuyerCountry, &order.ShippingCarrier, &order.ShippingOption, &addr.Country, &addr.City, &addr.PostalCode, &addr.Street, &order.TransactionUUID, &order.CreatedAt, &order.UpdatedAt, &order.PaidAt, &order.CancelledAt, &order.CancelReason, &order.CancelComment, &order.RefundUUID, &order.AuthorizedAmount, &order.CapturedAmount, &order.AuthorizationExpiresAt, &order.PaymentDeadline)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -54,7 +88,7 @@ func (o *Repository) Get(ctx context.Context, orderId string) (*model.Order, err
 		return nil, err
 	}
 
-	rows, err := o.pool.Query(ctx, `SELECT part_id, quantity, name, price FROM order_items WHERE order_id = $1`, orderId)
+	rows, err := o.pool.Query(ctx, `SELECT part_id, quantity, name, price, category, manufacturer_country, discount, tax, duty FROM order_items WHERE order_id = $1`, orderId)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +97,7 @@ func (o *Repository) Get(ctx context.Context, orderId string) (*model.Order, err
 	var items []model.Item
 	for rows.Next() {
 		var item model.Item
-		if err := rows.Scan(&item.PartUUID, &item.Quantity, &item.Name, &item.Price); err != nil {
+		if err := rows.Scan(&item.PartUUID, &item.Quantity, &item.Name, &item.Price, &item.Category, &item.ManufacturerCountry, &item.Discount, &item.Tax, &item.Duty); err != nil {
 			return nil, err
 		}
 		items = append(items, item)
@@ -73,16 +107,315 @@ func (o *Repository) Get(ctx context.Context, orderId string) (*model.Order, err
 		return nil, err
 	}
 	order.Items = items
+	order.DeliveryAddress = addr.address()
 	return &order, nil
 }
 
-func (o *Repository) Update(ctx context.Context, order *model.Order) error {
-	tag, err := o.pool.Exec(ctx, `UPDATE orders SET transaction_id = $1, payment_method = $2, status = $3 WHERE id = $4`, order.TransactionUUID, order.PaymentMethod, order.Status, order.OrderUUID)
+// Update saves the order and records change in the status history within one
+// transaction. The order must still be in change.From, otherwise ErrConflict is
+// returned and nothing is written.
+func (o *Repository) Update(ctx context.Context, order *model.Order, change model.StatusChange) error {
+	tx, err := o.pool.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	if tag.RowsAffected() == 0 {
-		return model.ErrNotFound
+	defer tx.Rollback(ctx)
+
+	var current model.OrderStatus
+	err = tx.QueryRow(ctx, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, order.OrderUUID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrNotFound
+		}
+		return err
+	}
+	if current != change.From {
+		return model.ErrConflict
+	}
+
+	err = tx.QueryRow(ctx, `UPDATE orders SET
+		transaction_id = $1,
+		payment_method = $2,
+		status = $3,
+		updated_at = now(),
+		paid_at = CASE WHEN $3 = 'PAID' OR $9::numeric IS NOT NULL THEN COALESCE(paid_at, now()) ELSE paid_at END,
+		cancelled_at = CASE WHEN $3 = 'CANCELLED' THEN now() ELSE cancelled_at END,
+		cancel_reason = $5,
+		cancel_comment = $6,
+		refund_transaction_id = $7,
+		authorized_amount = $8,
+		captured_amount = $9,
+		authorization_expires_at = $10,
+		payment_deadline = $11
+		WHERE id = $4
+		RETURNING updated_at, paid_at, cancelled_at`,
+		order.TransactionUUID, order.PaymentMethod, order.Status, order.OrderUUID,
+		order.CancelReason, order.CancelComment, order.RefundUUID,
+		order.AuthorizedAmount, order.CapturedAmount, order.AuthorizationExpiresAt,
+		order.PaymentDeadline,
+	).Scan(&order.UpdatedAt, &order.PaidAt, &order.CancelledAt)
+	if err != nil {
+		return err
+	}
+
+	if change.From != change.To {
+		if err := insertStatusChange(ctx, tx, order.OrderUUID, change); err != nil {
+			return err
+		}
+	}
+	if change.To == model.StatusCancelled && order.PromoCode != nil {
+		if err := releasePromo(ctx, tx, *order.PromoCode, order.OrderUUID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ListPaid returns the orders paid in [from, to) with the columns
+// reconciliation compares, oldest payment first.
+func (o *Repository) ListPaid(ctx context.Context, from, to time.Time) ([]*model.Order, error) {
+	rows, err := o.pool.Query(ctx, `SELECT id, user_id, payment_method, status, total_price, transaction_id, paid_at, refund_transaction_id, captured_amount FROM orders WHERE paid_at >= $1 AND paid_at < $2 ORDER BY paid_at`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orders []*model.Order
+	for rows.Next() {
+		var order model.Order
+		if err := rows.Scan(&order.OrderUUID, &order.UserUUID, &order.PaymentMethod, &order.Status, &order.TotalPrice, &order.TransactionUUID, &order.PaidAt, &order.RefundUUID, &order.CapturedAmount); err != nil {
+			return nil, err
+		}
+		orders = append(orders, &order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// ListPaymentPending returns the ids of orders waiting for their payment to
+// be confirmed, the ones past their deadline first.
+func (o *Repository) ListPaymentPending(ctx context.Context) ([]string, error) {
+	rows, err := o.pool.Query(ctx, `SELECT id FROM orders WHERE status = $1 ORDER BY payment_deadline`, model.StatusPaymentPending)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDs(rows)
+}
+
+// ListCancelling returns the ids of orders whose cancellation is waiting for
+// their payment to be given back, the oldest first.
+func (o *Repository) ListCancelling(ctx context.Context) ([]string, error) {
+	rows, err := o.pool.Query(ctx, `SELECT id FROM orders WHERE status = $1 ORDER BY updated_at`, model.StatusCancelling)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDs(rows)
+}
+
+func scanIDs(rows pgx.Rows) ([]string, error) {
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ReplaceItems swaps the whole item list of a pending order and updates its
+// total in one transaction. Old lines are deleted first, so the new list may
+// contain parts that were already in the order without hitting the
+// (order_id, part_id) primary key. ErrConflict is returned if the order is no
+// longer PENDING_PAYMENT and ErrOrderChanged if it was saved after
+// order.UpdatedAt.
+func (o *Repository) ReplaceItems(ctx context.Context, order *model.Order) error {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var current model.OrderStatus
+	var updatedAt time.Time
+	err = tx.QueryRow(ctx, `SELECT status, updated_at FROM orders WHERE id = $1 FOR UPDATE`, order.OrderUUID).Scan(&current, &updatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrNotFound
+		}
+		return err
+	}
+	if current != model.StatusPendingPayment {
+		return model.ErrConflict
+	}
+	if !updatedAt.Equal(order.UpdatedAt) {
+		return model.ErrOrderChanged
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM order_items WHERE order_id = $1`, order.OrderUUID)
+	if err != nil {
+		return err
+	}
+	if err := insertItems(ctx, tx, order); err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, `UPDATE orders SET subtotal = $1, discount_total = $2, tax_total = $3, duty_total = $4, shipping_cost = $5, total_price = $6, updated_at = now() WHERE id = $7 RETURNING updated_at`,
+		order.Subtotal, order.DiscountTotal, order.TaxTotal, order.DutyTotal, order.ShippingCost, order.TotalPrice, order.OrderUUID).Scan(&order.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (o *Repository) History(ctx context.Context, orderId string) ([]model.StatusChange, error) {
+	rows, err := o.pool.Query(ctx, `SELECT COALESCE(from_status, ''), to_status, actor, reason, created_at FROM order_status_history WHERE order_id = $1 ORDER BY id`, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []model.StatusChange
+	for rows.Next() {
+		var c model.StatusChange
+		if err := rows.Scan(&c.From, &c.To, &c.Actor, &c.Reason, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func insertItems(ctx context.Context, tx pgx.Tx, order *model.Order) error {
+	for _, items := range order.Items {
+		_, err := tx.Exec(ctx, `INSERT INTO order_items (order_id, part_id, quantity, price, name, category, manufacturer_country, discount, tax, duty) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`, order.OrderUUID, items.PartUUID, items.Quantity, items.Price, items.Name, items.Category, items.ManufacturerCountry, items.Discount, items.Tax, items.Duty)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+func insertStatusChange(ctx context.Context, tx pgx.Tx, orderId string, change model.StatusChange) error {
+	var from *model.OrderStatus
+	if change.From != "" {
+		from = &change.From
+	}
+	_, err := tx.Exec(ctx, `INSERT INTO order_status_history (order_id, from_status, to_status, actor, reason) VALUES ($1, $2, $3, $4, $5)`, orderId, from, change.To, change.Actor, change.Reason)
+	return err
+}
+
+// redeemPromo records the use of a promo code by an order. The promo row is
+// locked, so concurrent orders cannot push it over its usage limits.
+func redeemPromo(ctx context.Context, tx pgx.Tx, code, userId, orderId string) error {
+	var maxUses, perUserLimit *int
+	var usedCount int
+	err := tx.QueryRow(ctx, `SELECT max_uses, per_user_limit, used_count FROM promo_codes WHERE code = $1 FOR UPDATE`, code).Scan(&maxUses, &perUserLimit, &usedCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.NewPromoError(model.CodePromoNotFound, "promo code %s not found", code)
+		}
+		return err
+	}
+	if maxUses != nil && usedCount >= *maxUses {
+		return model.NewPromoError(model.CodePromoUsageLimit, "promo code %s has been used up", code)
+	}
+	if perUserLimit != nil {
+		var userCount int
+		err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM promo_redemptions WHERE code = $1 AND user_id = $2`, code, userId).Scan(&userCount)
+		if err != nil {
+			return err
+		}
+		if userCount >= *perUserLimit {
+			return model.NewPromoError(model.CodePromoUserLimit, "promo code %s has already been used the maximum number of times", code)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO promo_redemptions (order_id, code, user_id) VALUES ($1, $2, $3)`, orderId, code, userId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE promo_codes SET used_count = used_count + 1 WHERE code = $1`, code)
+	return err
+}
+
+// releasePromo gives the use of a promo code back when its order is cancelled.
+func releasePromo(ctx context.Context, tx pgx.Tx, code, orderId string) error {
+	tag, err := tx.Exec(ctx, `DELETE FROM promo_redemptions WHERE order_id = $1`, orderId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+	_, err = tx.Exec(ctx, `UPDATE promo_codes SET used_count = used_count - 1 WHERE code = $1 AND used_count > 0`, code)
+	return err
+}
+
+// deliveryAddress maps model.Address onto the nullable delivery_* columns of
+// orders.
+type deliveryAddress struct {
+	Country    *string
+	City       *string
+	PostalCode *string
+	Street     *string
+}
+
+func addressColumns(a *model.Address) deliveryAddress {
+	if a == nil {
+		return deliveryAddress{}
+	}
+	return deliveryAddress{
+		Country:    &a.Country,
+		City:       &a.City,
+		PostalCode: &a.PostalCode,
+		Street:     &a.Street,
+	}
+}
+
+func (d deliveryAddress) address() *model.Address {
+	if d.Country == nil {
+		return nil
+	}
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return &model.Address{
+		Country:    *d.Country,
+		City:       deref(d.City),
+		PostalCode: deref(d.PostalCode),
+		Street:     deref(d.Street),
+	}
+}
+
+// acceptQuote links the quote to the order being created. Only an open quote
+// that has not expired yet can be accepted, and only once.
+func acceptQuote(ctx context.Context, tx pgx.Tx, quoteID, orderID string) error {
+	var expired bool
+	err := tx.QueryRow(ctx, `SELECT expires_at <= now() FROM quotes WHERE id = $1 AND status = 'OPEN' FOR UPDATE`, quoteID).Scan(&expired)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: quote is not open", model.ErrConflict)
+		}
+		return err
+	}
+	if expired {
+		return model.ErrQuoteExpired
+	}
+	_, err = tx.Exec(ctx, `UPDATE quotes SET status = 'ACCEPTED', accepted_at = now(), order_id = $2 WHERE id = $1`, quoteID, orderID)
+	return err
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveShipment records a shipment event in one transaction: the order moves
+// along change, the shipment row is created or updated with the carrier and
+// tracking number of shipment, and event is appended to its tracking log. The
+// order must still be in change.From, otherwise ErrConflict is returned.
+func (o *Repository) SaveShipment(ctx context.Context, order *model.Order, change model.StatusChange, shipment *model.Shipment, event model.ShipmentEvent) error {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var current model.OrderStatus
+	err = tx.QueryRow(ctx, `SELECT status FROM orders WHERE id = $1 FOR UPDATE`, order.OrderUUID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrNotFound
+		}
+		return err
+	}
+	if current != change.From {
+		return model.ErrConflict
+	}
+
+	if change.From != change.To {
+		err = tx.QueryRow(ctx, `UPDATE orders SET status = $1, updated_at = now() WHERE id = $2 RETURNING updated_at`, change.To, order.OrderUUID).Scan(&order.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		if err := insertStatusChange(ctx, tx, order.OrderUUID, change); err != nil {
+			return err
+		}
+	}
+
+	err = tx.QueryRow(ctx, `INSERT INTO shipments (order_id, carrier, tracking_number) VALUES ($1, $2, $3)
+		ON CONFLICT (order_id) DO UPDATE SET carrier = EXCLUDED.carrier, tracking_number = EXCLUDED.tracking_number, updated_at = now()
+		RETURNING created_at, updated_at`,
+		order.OrderUUID, shipment.Carrier, shipment.TrackingNumber,
+	).Scan(&shipment.CreatedAt, &shipment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, `INSERT INTO shipment_events (order_id, type, location, description, actor) VALUES ($1, $2, $3, $4, $5) RETURNING created_at`,
+		order.OrderUUID, event.Type, event.Location, event.Description, event.Actor,
+	).Scan(&event.CreatedAt)
+	if err != nil {
+		return err
+	}
+	shipment.Events = append(shipment.Events, event)
+	return tx.Commit(ctx)
+}
+
+// GetShipment loads the shipment of an order with its tracking events.
+// ErrNotFound is returned if the order has not been shipped yet.
+func (o *Repository) GetShipment(ctx context.Context, orderId string) (*model.Shipment, error) {
+	shipment := model.Shipment{OrderUUID: orderId}
+	err := o.pool.QueryRow(ctx, `SELECT carrier, tracking_number, created_at, updated_at FROM shipments WHERE order_id = $1`, orderId).
+		Scan(&shipment.Carrier, &shipment.TrackingNumber, &shipment.CreatedAt, &shipment.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := o.pool.Query(ctx, `SELECT type, location, description, actor, created_at FROM shipment_events WHERE order_id = $1 ORDER BY id`, orderId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e model.ShipmentEvent
+		if err := rows.Scan(&e.Type, &e.Location, &e.Description, &e.Actor, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		shipment.Events = append(shipment.Events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &shipment, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"order-service/internal/repository/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Get loads a promo code. Usage limits are enforced again when the order is
+// stored, see the order repository.
+func (r *Repository) Get(ctx context.Context, code string) (*model.PromoCode, error) {
+	p, err := scanPromo(r.pool.QueryRow(ctx, `SELECT `+promoColumns+` FROM promo_codes WHERE code = $1`, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// Create stores a new promo code with no uses yet. Optional rule fields left
+// at their zero value are stored as NULL.
+func (r *Repository) Create(ctx context.Context, promo *model.PromoCode) error {
+	err := r.pool.QueryRow(ctx, `INSERT INTO promo_codes (code, rule_type, value, category, buy_quantity, get_category, get_quantity, min_subtotal, valid_from, valid_to, max_uses, per_user_limit, active)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, 0), NULLIF($6, ''), NULLIF($7, 0), $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (code) DO NOTHING
+		RETURNING used_count, created_at`,
+		promo.Code, promo.RuleType, promo.Value, promo.Category, promo.BuyQuantity, promo.GetCategory, promo.GetQuantity,
+		promo.MinSubtotal, promo.ValidFrom, promo.ValidTo, promo.MaxUses, promo.PerUserLimit, promo.Active,
+	).Scan(&promo.UsedCount, &promo.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: promo code %s already exists", model.ErrConflict, promo.Code)
+	}
+	return err
+}
+
+// Deactivate stops the code from being applied to new orders. Orders that
+// already redeemed it keep their discounts.
+func (r *Repository) Deactivate(ctx context.Context, code string) error {
+	tag, err := r.pool.Exec(ctx, `UPDATE promo_codes SET active = false WHERE code = $1`, code)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// List returns every promo code, the newest first.
+func (r *Repository) List(ctx context.Context) ([]*model.PromoCode, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+promoColumns+` FROM promo_codes ORDER BY created_at DESC, code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promos []*model.PromoCode
+	for rows.Next() {
+		p, err := scanPromo(rows)
+		if err != nil {
+			return nil, err
+		}
+		promos = append(promos, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return promos, nil
+}
+
+const promoColumns = `code, rule_type, value, COALESCE(category, ''), COALESCE(buy_quantity, 0), COALESCE(get_category, ''), COALESCE(get_quantity, 0), min_subtotal, valid_from, valid_to, max_uses, per_user_limit, used_count, active, created_at`
+
+func scanPromo(row pgx.Row) (*model.PromoCode, error) {
+	var p model.PromoCode
+	err := row.Scan(&p.Code, &p.RuleType, &p.Value, &p.Category, &p.BuyQuantity, &p.GetCategory, &p.GetQuantity, &p.MinSubtotal, &p.ValidFrom, &p.ValidTo, &p.MaxUses, &p.PerUserLimit, &p.UsedCount, &p.Active, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
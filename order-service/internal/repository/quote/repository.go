@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{pool: pool}
+}
+
+// Create stores a new open quote. The lines are kept as a JSON snapshot, so
+// the quote does not change when inventory data does.
+func (r *Repository) Create(ctx context.Context, quote *model.Quote) error {
+	o := quote.Order
+	err := r.pool.QueryRow(ctx, `INSERT INTO quotes (id, user_id, status, items, subtotal, discount_total, tax_total, duty_total, shipping_cost, total_price, promo_code, buyer_country, shipping_carrier, shipping_option, delivery_address, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING created_at`,
+		quote.QuoteUUID, o.UserUUID, quote.Status, o.Items, o.Subtotal, o.DiscountTotal, o.TaxTotal, o.DutyTotal, o.ShippingCost, o.TotalPrice,
+		o.PromoCode, o.BuyerCountry, o.ShippingCarrier, o.ShippingOption, o.DeliveryAddress, quote.ExpiresAt).Scan(&quote.CreatedAt)
+	return err
+}
+
+func (r *Repository) Get(ctx context.Context, quoteID string) (*model.Quote, error) {
+	var quote model.Quote
+	o := &quote.Order
+	err := r.pool.QueryRow(ctx, `SELECT id, user_id, status, items, subtotal, discount_total, tax_total, duty_total, shipping_cost, total_price, promo_code, buyer_country, shipping_carrier, shipping_option, delivery_address, expires_at, created_at, accepted_at, order_id FROM quotes WHERE id = $1`, quoteID).
+		Scan(&quote.QuoteUUID, &o.UserUUID, &quote.Status, &o.Items, &o.Subtotal, &o.DiscountTotal, &o.TaxTotal, &o.DutyTotal, &o.ShippingCost, &o.TotalPrice,
+			&o.PromoCode, &o.BuyerCountry, &o.ShippingCarrier, &o.ShippingOption, &o.DeliveryAddress, &quote.ExpiresAt, &quote.CreatedAt, &quote.AcceptedAt, &quote.OrderUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrNotFound
+		}
+		return nil, err
+	}
+	return &quote, nil
+}
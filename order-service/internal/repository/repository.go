@@ -3,10 +3,45 @@ package repository
 import (
 	"context"
 	"order-service/internal/repository/model"
+	"time"
 )
 
 type OrderRepository interface {
 	Create(ctx context.Context, order *model.Order) error
 	Get(ctx context.Context, orderID string) (*model.Order, error)
-	Update(ctx context.Context, order *model.Order) error
+	Update(ctx context.Context, order *model.Order, change model.StatusChange) error
+	ReplaceItems(ctx context.Context, order *model.Order) error
+	History(ctx context.Context, orderID string) ([]model.StatusChange, error)
+	SaveShipment(ctx context.Context, order *model.Order, change model.StatusChange, shipment *model.Shipment, event model.ShipmentEvent) error
+	GetShipment(ctx context.Context, orderID string) (*model.Shipment, error)
+	// ListPaymentPending returns the ids of PAYMENT_PENDING orders.
+	ListPaymentPending(ctx context.Context) ([]string, error)
+	// ListCancelling returns the ids of CANCELLING orders.
+	ListCancelling(ctx context.Context) ([]string, error)
+	// ListPaid returns the orders paid in [from, to), without their items.
+	ListPaid(ctx context.Context, from, to time.Time) ([]*model.Order, error)
+}
+
+type PromoRepository interface {
+	Get(ctx context.Context, code string) (*model.PromoCode, error)
+	// Create returns model.ErrConflict if the code is taken.
+	Create(ctx context.Context, promo *model.PromoCode) error
+	// Deactivate returns model.ErrNotFound for an unknown code.
+	Deactivate(ctx context.Context, code string) error
+	List(ctx context.Context) ([]*model.PromoCode, error)
+}
+
+// CartRepository stores carts. Every change bumps the cart version.
+type CartRepository interface {
+	Get(ctx context.Context, userID string) (*model.Cart, []model.CartItem, error)
+	AddItem(ctx context.Context, userID string, item model.CartItem) error
+	UpdateItem(ctx context.Context, userID, partID string, quantity int) error
+	RemoveItem(ctx context.Context, userID, partID string) error
+}
+
+// QuoteRepository stores quotes. Quotes are accepted by creating an order
+// with Order.QuoteUUID set, see OrderRepository.Create.
+type QuoteRepository interface {
+	Create(ctx context.Context, quote *model.Quote) error
+	Get(ctx context.Context, quoteID string) (*model.Quote, error)
 }
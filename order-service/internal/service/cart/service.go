@@ -0,0 +1,197 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+	"order-service/internal/repository"
+	"order-service/internal/repository/model"
+	"order-service/internal/service"
+	"strings"
+)
+
+type Service struct {
+	repo   repository.CartRepository
+	inv    service.InventoryService
+	orders service.OrderService
+}
+
+func NewService(repo repository.CartRepository, inv service.InventoryService, orders service.OrderService) *Service {
+	return &Service{repo: repo, inv: inv, orders: orders}
+}
+
+// GetCart returns the cart of a user annotated with current prices and stock.
+func (s *Service) GetCart(ctx context.Context, userID string) (*model.Cart, error) {
+	userID, err := requireUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	cart, items, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.annotate(ctx, cart, items); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+// AddItem puts quantity units of a part into the cart, remembering its
+// current price. Stock is not reserved; shortages show up as warnings.
+func (s *Service) AddItem(ctx context.Context, userID, partID string, quantity int) (*model.Cart, error) {
+	userID, err := requireUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	partID = strings.TrimSpace(partID)
+	if partID == "" {
+		return nil, fmt.Errorf("%w: part_uuid is required", model.ErrBadRequest)
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be greater than 0", model.ErrBadRequest)
+	}
+
+	parts, err := s.inv.ListParts(ctx, []string{partID})
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("%w: part %s not found", model.ErrNotFound, partID)
+	}
+
+	err = s.repo.AddItem(ctx, userID, model.CartItem{
+		PartUUID:   partID,
+		Quantity:   quantity,
+		AddedPrice: parts[0].Price,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, userID)
+}
+
+// UpdateItem sets the quantity of a part in the cart. The price it was added
+// at is kept, so price changes are still reported.
+func (s *Service) UpdateItem(ctx context.Context, userID, partID string, quantity int) (*model.Cart, error) {
+	userID, err := requireUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be greater than 0", model.ErrBadRequest)
+	}
+	if err := s.repo.UpdateItem(ctx, userID, partID, quantity); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, userID)
+}
+
+func (s *Service) RemoveItem(ctx context.Context, userID, partID string) (*model.Cart, error) {
+	userID, err := requireUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.RemoveItem(ctx, userID, partID); err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, userID)
+}
+
+// Checkout places an order for the cart contents at current prices. The cart
+// is deleted in the same transaction the order is stored in; if the cart
+// changes in the meantime, nothing is ordered and ErrConflict is returned.
+func (s *Service) Checkout(ctx context.Context, req model.CheckoutRequest) (*model.Order, error) {
+	userID, err := requireUser(req.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	cart, items, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: cart is empty", model.ErrBadRequest)
+	}
+
+	orderItems := make([]model.Item, len(items))
+	for i, v := range items {
+		orderItems[i] = model.Item{PartUUID: v.PartUUID, Quantity: v.Quantity}
+	}
+	return s.orders.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:        userID,
+		Items:           orderItems,
+		PromoCode:       req.PromoCode,
+		BuyerCountry:    req.BuyerCountry,
+		ShippingOption:  req.ShippingOption,
+		DeliveryAddress: req.DeliveryAddress,
+		Cart:            &model.CartRef{UserUUID: userID, Version: cart.Version},
+	})
+}
+
+// annotate fills the cart lines with the current name, price and stock of
+// their parts and warns about changed prices, missing parts and shortages.
+func (s *Service) annotate(ctx context.Context, cart *model.Cart, items []model.CartItem) error {
+	cart.Lines = make([]model.CartLine, 0, len(items))
+	if len(items) == 0 {
+		return nil
+	}
+
+	partIDs := make([]string, len(items))
+	for i, v := range items {
+		partIDs[i] = v.PartUUID
+	}
+	parts, err := s.inv.ListParts(ctx, partIDs)
+	if err != nil {
+		return err
+	}
+	partMap := make(map[string]*model.Part, len(parts))
+	for _, v := range parts {
+		partMap[v.UUID] = v
+	}
+
+	var subtotal float64
+	for _, v := range items {
+		line := model.CartLine{CartItem: v}
+		part, ok := partMap[v.PartUUID]
+		if !ok {
+			cart.Warnings = append(cart.Warnings, model.CartWarning{
+				PartUUID: v.PartUUID,
+				Code:     model.CodePartNotFound,
+				Message:  fmt.Sprintf("part %s is no longer available", v.PartUUID),
+			})
+			cart.Lines = append(cart.Lines, line)
+			continue
+		}
+
+		line.Available = true
+		line.Name = part.Name
+		line.Price = part.Price
+		line.StockQuantity = part.Quantity
+		subtotal += part.Price * float64(v.Quantity)
+
+		if part.Price != v.AddedPrice {
+			cart.Warnings = append(cart.Warnings, model.CartWarning{
+				PartUUID: v.PartUUID,
+				Code:     model.CodePriceChanged,
+				Message:  fmt.Sprintf("price of %s changed from %.2f to %.2f", part.Name, v.AddedPrice, part.Price),
+			})
+		}
+		if part.Quantity < v.Quantity {
+			cart.Warnings = append(cart.Warnings, model.CartWarning{
+				PartUUID: v.PartUUID,
+				Code:     model.CodeNotEnoughStock,
+				Message:  fmt.Sprintf("part %s: requested %d, only %d in stock", v.PartUUID, v.Quantity, part.Quantity),
+			})
+		}
+		cart.Lines = append(cart.Lines, line)
+	}
+	cart.Subtotal = model.RoundMoney(subtotal)
+	return nil
+}
+
+func requireUser(userID string) (string, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return "", fmt.Errorf("%w: user_uuid is required", model.ErrBadRequest)
+	}
+	return userID, nil
+}
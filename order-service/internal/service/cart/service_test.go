@@ -0,0 +1,146 @@
+package cart
+
+import (
+	"context"
+	"order-service/internal/mocks"
+	"order-service/internal/repository/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartServiceTest struct {
+	suite.Suite
+
+	repo   *mocks.CartRepository
+	inv    *mocks.InventoryService
+	orders *mocks.OrderService
+
+	service *Service
+}
+
+func (s *CartServiceTest) SetupTest() {
+	s.repo = mocks.NewCartRepository(s.T())
+	s.inv = mocks.NewInventoryService(s.T())
+	s.orders = mocks.NewOrderService(s.T())
+
+	s.service = NewService(s.repo, s.inv, s.orders)
+}
+
+func TestCartServiceTest(t *testing.T) {
+	suite.Run(t, new(CartServiceTest))
+}
+
+func (s *CartServiceTest) TestGetCart_annotations() {
+	ctx := context.Background()
+	added := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	s.repo.On("Get", ctx, "user-1").Return(&model.Cart{UserUUID: "user-1", Version: 3}, []model.CartItem{
+		{PartUUID: "engine-1", Quantity: 2, AddedPrice: 100, AddedAt: added},
+		{PartUUID: "wing-1", Quantity: 4, AddedPrice: 50, AddedAt: added},
+		{PartUUID: "gone-1", Quantity: 1, AddedPrice: 10, AddedAt: added},
+	}, nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1", "wing-1", "gone-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 120, Quantity: 10},
+		{UUID: "wing-1", Name: "Wing", Price: 50, Quantity: 3},
+	}, nil)
+
+	cart, err := s.service.GetCart(ctx, "user-1")
+	s.Require().NoError(err)
+	s.Equal(3, cart.Version)
+	s.Require().Len(cart.Lines, 3)
+	s.Equal(float64(120), cart.Lines[0].Price)
+	s.Equal(float64(100), cart.Lines[0].AddedPrice)
+	s.True(cart.Lines[1].Available)
+	s.False(cart.Lines[2].Available)
+	s.Equal(float64(440), cart.Subtotal)
+
+	codes := make([]string, len(cart.Warnings))
+	for i, v := range cart.Warnings {
+		codes[i] = v.PartUUID + ":" + v.Code
+	}
+	s.Equal([]string{
+		"engine-1:" + model.CodePriceChanged,
+		"wing-1:" + model.CodeNotEnoughStock,
+		"gone-1:" + model.CodePartNotFound,
+	}, codes)
+}
+
+func (s *CartServiceTest) TestGetCart_requiresUser() {
+	_, err := s.service.GetCart(context.Background(), " ")
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *CartServiceTest) TestAddItem() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil)
+	s.repo.On("AddItem", ctx, "user-1", model.CartItem{PartUUID: "engine-1", Quantity: 2, AddedPrice: 100}).Return(nil)
+	s.repo.On("Get", ctx, "user-1").Return(&model.Cart{UserUUID: "user-1", Version: 1}, []model.CartItem{
+		{PartUUID: "engine-1", Quantity: 2, AddedPrice: 100},
+	}, nil)
+
+	cart, err := s.service.AddItem(ctx, "user-1", " engine-1 ", 2)
+	s.Require().NoError(err)
+	s.Require().Len(cart.Lines, 1)
+	s.Empty(cart.Warnings)
+	s.Equal(float64(200), cart.Subtotal)
+}
+
+func (s *CartServiceTest) TestAddItem_unknownPart() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"nope"}).Return([]*model.Part{}, nil)
+
+	_, err := s.service.AddItem(ctx, "user-1", "nope", 1)
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *CartServiceTest) TestAddItem_invalidQuantity() {
+	_, err := s.service.AddItem(context.Background(), "user-1", "engine-1", 0)
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *CartServiceTest) TestRemoveItem_notInCart() {
+	ctx := context.Background()
+
+	s.repo.On("RemoveItem", ctx, "user-1", "engine-1").Return(model.ErrNotFound)
+
+	_, err := s.service.RemoveItem(ctx, "user-1", "engine-1")
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *CartServiceTest) TestCheckout() {
+	ctx := context.Background()
+	order := &model.Order{OrderUUID: "order-1"}
+
+	s.repo.On("Get", ctx, "user-1").Return(&model.Cart{UserUUID: "user-1", Version: 7}, []model.CartItem{
+		{PartUUID: "engine-1", Quantity: 2, AddedPrice: 100},
+		{PartUUID: "wing-1", Quantity: 1, AddedPrice: 50},
+	}, nil)
+	s.orders.On("CreateOrder", ctx, model.OrderRequest{
+		UserUUID:     "user-1",
+		Items:        []model.Item{{PartUUID: "engine-1", Quantity: 2}, {PartUUID: "wing-1", Quantity: 1}},
+		PromoCode:    "SALE10",
+		BuyerCountry: "Russia",
+		Cart:         &model.CartRef{UserUUID: "user-1", Version: 7},
+	}).Return(order, nil)
+
+	res, err := s.service.Checkout(ctx, model.CheckoutRequest{UserUUID: "user-1", PromoCode: "SALE10", BuyerCountry: "Russia"})
+	s.Require().NoError(err)
+	s.Same(order, res)
+}
+
+func (s *CartServiceTest) TestCheckout_emptyCart() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "user-1").Return(&model.Cart{UserUUID: "user-1"}, nil, nil)
+
+	_, err := s.service.Checkout(ctx, model.CheckoutRequest{UserUUID: "user-1"})
+	s.ErrorIs(err, model.ErrBadRequest)
+	s.orders.AssertNotCalled(s.T(), "CreateOrder", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"order-service/internal/repository/model"
+)
+
+// AuthorizeOrder is the first step of a two-step payment: the ordered parts
+// are reserved and the order total is held by the payment provider. The
+// payment is captured when the order ships or by CaptureOrder. The
+// reservation is released again if the authorization fails.
+func (s *Service) AuthorizeOrder(ctx context.Context, orderID, actor string, pm *model.PaymentMethod) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !model.CanTransition(order.Status, model.StatusAuthorized) {
+		return nil, model.ErrConflict
+	}
+	if err := s.inv.ReserveStock(ctx, order.Items); err != nil {
+		return nil, err
+	}
+	auth, err := s.pay.Authorize(ctx, order.OrderUUID, order.UserUUID, order.TotalPrice, pm)
+	if err != nil {
+		if rerr := s.inv.ReleaseStock(ctx, order.Items); rerr != nil {
+			return nil, errors.Join(err, fmt.Errorf("release stock: %w", rerr))
+		}
+		return nil, err
+	}
+	amount := order.TotalPrice
+	order.PaymentMethod = pm
+	order.TransactionUUID = &auth.TransactionUUID
+	order.AuthorizedAmount = &amount
+	order.AuthorizationExpiresAt = &auth.ExpiresAt
+	err = s.transition(ctx, order, model.StatusAuthorized, actor, "payment authorized")
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// CaptureOrder captures amount of the authorized payment, the whole
+// authorization if amount is 0; the rest of the hold is released. An
+// AUTHORIZED order becomes PAID, an order already being assembled keeps its
+// status.
+func (s *Service) CaptureOrder(ctx context.Context, orderID, actor string, amount float64) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !order.AwaitingCapture() {
+		return nil, fmt.Errorf("%w: order has no payment to capture", model.ErrConflict)
+	}
+	to := order.Status
+	if to == model.StatusAuthorized {
+		to = model.StatusPaid
+	}
+	if err := s.capture(ctx, order, amount, to, actor); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// capture captures the authorized payment of order and saves the order in
+// status to. payment-service captures a transaction once and answers a
+// repeat with the amount it captured, so if the order could not be saved
+// after the capture, capturing it again records that capture instead of
+// failing. For the same reason expiry is left to payment-service: a payment
+// captured just before it expired is still captured.
+func (s *Service) capture(ctx context.Context, order *model.Order, amount float64, to model.OrderStatus, actor string) error {
+	if amount < 0 || amount > *order.AuthorizedAmount {
+		return fmt.Errorf("%w: capture amount must be between 0 and %.2f", model.ErrBadRequest, *order.AuthorizedAmount)
+	}
+	captured, err := s.pay.Capture(ctx, *order.TransactionUUID, amount)
+	if err != nil {
+		return err
+	}
+	order.CapturedAmount = &captured
+	if to == order.Status {
+		return s.repo.Update(ctx, order, model.StatusChange{From: order.Status, To: to})
+	}
+	return s.transition(ctx, order, to, actor, "payment captured")
+}
@@ -0,0 +1,303 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *OrderServiceTest) authorizedOrder(status model.OrderStatus) *model.Order {
+	tx := "tx-1"
+	pm := model.PaymentCard
+	authorized := 300.0
+	expires := s.service.now().Add(24 * time.Hour)
+	return &model.Order{
+		OrderUUID:              "id-1",
+		UserUUID:               "u-1",
+		Items:                  []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}},
+		TotalPrice:             300,
+		Status:                 status,
+		PaymentMethod:          &pm,
+		TransactionUUID:        &tx,
+		AuthorizedAmount:       &authorized,
+		AuthorizationExpiresAt: &expires,
+	}
+}
+
+func (s *OrderServiceTest) TestAuthorizeOrder() {
+	ctx := context.Background()
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 300, Status: model.StatusPendingPayment}
+	pm := model.PaymentCard
+	expires := s.service.now().Add(7 * 24 * time.Hour)
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("Authorize", ctx, "id-1", "u-1", 300.0, &pm).Return(&model.Authorization{TransactionUUID: "tx-1", ExpiresAt: expires}, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPendingPayment,
+		To:     model.StatusAuthorized,
+		Actor:  "u-1",
+		Reason: "payment authorized",
+	}).Return(nil)
+
+	got, err := s.service.AuthorizeOrder(ctx, "id-1", "u-1", &pm)
+	s.Require().NoError(err)
+	s.Equal(model.StatusAuthorized, got.Status)
+	s.Equal("tx-1", *got.TransactionUUID)
+	s.Equal(300.0, *got.AuthorizedAmount)
+	s.Equal(expires, *got.AuthorizationExpiresAt)
+	s.True(got.AwaitingCapture())
+}
+
+func (s *OrderServiceTest) TestAuthorizeOrder_failedReleasesStock() {
+	ctx := context.Background()
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 300, Status: model.StatusPendingPayment}
+	pm := model.PaymentSBP
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("Authorize", ctx, "id-1", "u-1", 300.0, &pm).Return(nil, errors.New("unsupported method"))
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+
+	_, err := s.service.AuthorizeOrder(ctx, "id-1", "u-1", &pm)
+	s.Error(err)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCaptureOrder_partial() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAuthorized)
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("Capture", ctx, "tx-1", 250.0).Return(250.0, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusAuthorized,
+		To:     model.StatusPaid,
+		Actor:  "ops-anna",
+		Reason: "payment captured",
+	}).Return(nil)
+
+	got, err := s.service.CaptureOrder(ctx, "id-1", "ops-anna", 250)
+	s.Require().NoError(err)
+	s.Equal(model.StatusPaid, got.Status)
+	s.Equal(250.0, *got.CapturedAmount)
+	s.False(got.AwaitingCapture())
+}
+
+func (s *OrderServiceTest) TestCaptureOrder_invalid() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAuthorized)
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+
+	_, err := s.service.CaptureOrder(ctx, "id-1", "admin", 300.01)
+	s.ErrorIs(err, model.ErrBadRequest)
+
+	captured := 300.0
+	order.CapturedAmount = &captured
+	_, err = s.service.CaptureOrder(ctx, "id-1", "admin", 0)
+	s.ErrorIs(err, model.ErrConflict)
+	s.pay.AssertNotCalled(s.T(), "Capture", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCaptureOrder_expired() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAuthorized)
+	expired := s.service.now()
+	order.AuthorizationExpiresAt = &expired
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(0.0, model.ErrAuthorizationExpired)
+
+	_, err := s.service.CaptureOrder(ctx, "id-1", "admin", 0)
+	s.ErrorIs(err, model.ErrAuthorizationExpired)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCaptureOrder_retryAfterFailedSave() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAuthorized)
+	change := model.StatusChange{
+		From:   model.StatusAuthorized,
+		To:     model.StatusPaid,
+		Actor:  "admin",
+		Reason: "payment captured",
+	}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil).Once()
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(300.0, nil)
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), change).Return(errors.New("connection reset")).Once()
+	_, err := s.service.CaptureOrder(ctx, "id-1", "admin", 0)
+	s.Error(err)
+
+	// The order was not saved, so it is still waiting for the capture the
+	// payment-service made, even past the end of the authorization.
+	retry := s.authorizedOrder(model.StatusAuthorized)
+	expired := s.service.now()
+	retry.AuthorizationExpiresAt = &expired
+	s.repo.On("Get", ctx, "id-1").Return(retry, nil).Once()
+	s.repo.On("Update", ctx, retry, change).Return(nil).Once()
+
+	got, err := s.service.CaptureOrder(ctx, "id-1", "admin", 0)
+	s.Require().NoError(err)
+	s.Equal(model.StatusPaid, got.Status)
+	s.Equal(300.0, *got.CapturedAmount)
+	s.pay.AssertNumberOfCalls(s.T(), "Capture", 2)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_shippedCapturesAuthorization() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAssembling)
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(300.0, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{From: model.StatusAssembling, To: model.StatusAssembling}).Return(nil)
+	s.repo.On("SaveShipment", ctx, order, mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.From == model.StatusAssembling && c.To == model.StatusShipped
+	}), mock.AnythingOfType("*model.Shipment"), mock.AnythingOfType("model.ShipmentEvent")).Return(nil)
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:           model.ShipmentShipped,
+		Carrier:        "FREIGHT",
+		TrackingNumber: "TRK-1",
+		Actor:          "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(300.0, *order.CapturedAmount)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_captureFailedKeepsOrder() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAssembling)
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(0.0, errors.New("payment service unavailable"))
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:           model.ShipmentShipped,
+		Carrier:        "FREIGHT",
+		TrackingNumber: "TRK-1",
+	})
+	s.Error(err)
+	s.Equal(model.StatusAssembling, order.Status)
+	s.pay.AssertNotCalled(s.T(), "Authorize", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.repo.AssertNotCalled(s.T(), "SaveShipment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_expiredAuthorizationRenewed() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAssembling)
+	expires := s.service.now().Add(7 * 24 * time.Hour)
+	unchanged := model.StatusChange{From: model.StatusAssembling, To: model.StatusAssembling}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(0.0, model.ErrAuthorizationExpired)
+	s.pay.On("Authorize", ctx, "id-1", "u-1", 300.0, order.PaymentMethod).Return(&model.Authorization{TransactionUUID: "tx-2", ExpiresAt: expires}, nil)
+	s.pay.On("Capture", ctx, "tx-2", 0.0).Return(300.0, nil)
+	s.repo.On("Update", ctx, order, unchanged).Return(nil).Twice()
+	s.repo.On("SaveShipment", ctx, order, mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.From == model.StatusAssembling && c.To == model.StatusShipped
+	}), mock.AnythingOfType("*model.Shipment"), mock.AnythingOfType("model.ShipmentEvent")).Return(nil)
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:           model.ShipmentShipped,
+		Carrier:        "FREIGHT",
+		TrackingNumber: "TRK-1",
+		Actor:          "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(model.StatusShipped, order.Status)
+	s.Equal("tx-2", *order.TransactionUUID)
+	s.Equal(expires, *order.AuthorizationExpiresAt)
+	s.Equal(300.0, *order.CapturedAmount)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_expiredAuthorizationRefused() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAssembling)
+	refused := &model.PaymentRefusedError{Reason: "INSUFFICIENT_FUNDS", Message: "insufficient funds"}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+	s.pay.On("Capture", ctx, "tx-1", 0.0).Return(0.0, model.ErrAuthorizationExpired)
+	s.pay.On("Authorize", ctx, "id-1", "u-1", 300.0, order.PaymentMethod).Return(nil, refused)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusAssembling,
+		To:     model.StatusCancelling,
+		Actor:  "admin",
+		Reason: "authorization expired",
+	}).Return(nil).Once()
+	s.pay.On("Void", ctx, "tx-1", "OTHER").Return(nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusCancelling,
+		To:     model.StatusCancelled,
+		Actor:  "admin",
+		Reason: "authorization voided",
+	}).Return(nil).Once()
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:           model.ShipmentShipped,
+		Carrier:        "FREIGHT",
+		TrackingNumber: "TRK-1",
+		Actor:          "admin",
+	})
+	s.ErrorIs(err, model.ErrAuthorizationExpired)
+	s.NotErrorIs(err, model.ErrPaymentRequired)
+	s.Equal(model.StatusCancelled, order.Status)
+	s.Equal(model.CancelReasonOther, *order.CancelReason)
+	s.repo.AssertNotCalled(s.T(), "SaveShipment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_refundsUnsavedCapture() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusCancelling)
+	reason := model.CancelReasonOther
+	order.CancelReason = &reason
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("Void", ctx, "tx-1", "OTHER").Return(errors.New("cannot void a PAID transaction"))
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil)
+	s.pay.On("Refund", ctx, "tx-1", "id-1", "u-1", "OTHER").Return("refund-1", nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusCancelling,
+		To:     model.StatusCancelled,
+		Actor:  "u-1",
+		Reason: "payment refunded",
+	}).Return(nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+
+	got, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.Require().NoError(err)
+	s.Equal(model.StatusCancelled, got.Status)
+	s.Equal("refund-1", *got.RefundUUID)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_voidsAuthorization() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusAuthorized)
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("Update", ctx, order, statusChange(model.StatusAuthorized, model.StatusCancelling)).Return(nil)
+	s.pay.On("Void", ctx, "tx-1", string(model.CancelReasonCustomerRequest)).Return(nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusCancelling,
+		To:     model.StatusCancelled,
+		Actor:  "u-1",
+		Reason: "authorization voided",
+	}).Return(nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+
+	got, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.Require().NoError(err)
+	s.Equal(model.StatusCancelled, got.Status)
+	s.Nil(got.RefundUUID)
+	s.pay.AssertNotCalled(s.T(), "Refund", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"fmt"
+	"order-service/internal/repository/model"
+)
+
+// applyItemOperations returns a copy of items with ops applied in order.
+// Lines keep their original position, added parts are appended at the end.
+func applyItemOperations(items []model.Item, ops []model.ItemOperation) ([]model.Item, error) {
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%w: operations required", model.ErrBadRequest)
+	}
+
+	res := make([]model.Item, len(items))
+	copy(res, items)
+	find := func(partID string) int {
+		for i, v := range res {
+			if v.PartUUID == partID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i, op := range ops {
+		if op.PartUUID == "" {
+			return nil, fmt.Errorf("%w: part_uuid is required for operation %d", model.ErrBadRequest, i)
+		}
+		idx := find(op.PartUUID)
+
+		switch op.Op {
+		case model.ItemOpAdd:
+			if op.Quantity <= 0 {
+				return nil, fmt.Errorf("%w: quantity must be greater than 0 for operation %d", model.ErrBadRequest, i)
+			}
+			if idx == -1 {
+				res = append(res, model.Item{PartUUID: op.PartUUID, Quantity: op.Quantity})
+				continue
+			}
+			res[idx].Quantity += op.Quantity
+
+		case model.ItemOpSetQuantity:
+			if op.Quantity <= 0 {
+				return nil, fmt.Errorf("%w: quantity must be greater than 0 for operation %d, use REMOVE to drop the line", model.ErrBadRequest, i)
+			}
+			if idx == -1 {
+				return nil, fmt.Errorf("%w: part %s is not in the order (operation %d)", model.ErrBadRequest, op.PartUUID, i)
+			}
+			res[idx].Quantity = op.Quantity
+
+		case model.ItemOpRemove:
+			if idx == -1 {
+				return nil, fmt.Errorf("%w: part %s is not in the order (operation %d)", model.ErrBadRequest, op.PartUUID, i)
+			}
+			res = append(res[:idx], res[idx+1:]...)
+
+		default:
+			return nil, fmt.Errorf("%w: unknown operation %q", model.ErrBadRequest, op.Op)
+		}
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%w: order must keep at least one item, cancel it instead", model.ErrBadRequest)
+	}
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package order
+
+import (
+	"fmt"
+	"order-service/internal/repository/model"
+	"strings"
+)
+
+// normalizeItems validates every request line and merges lines that refer to
+// the same part, so the order never contains duplicate (order_id, part_id)
+// rows. The merged lines keep the position of their first occurrence. The
+// returned map points each part to the request lines it was built from and is
+// used to report stock problems against the lines the client actually sent.
+func normalizeItems(items []model.Item) ([]model.Item, map[string][]int, error) {
+	var lineErrs []model.LineError
+	merged := make([]model.Item, 0, len(items))
+	lines := make(map[string][]int, len(items))
+
+	for i, v := range items {
+		partID := strings.TrimSpace(v.PartUUID)
+		if partID == "" {
+			lineErrs = append(lineErrs, model.LineError{
+				Line:    i,
+				Code:    model.CodeInvalidPartUUID,
+				Message: "part_uuid is required",
+			})
+			continue
+		}
+		if v.Quantity <= 0 {
+			lineErrs = append(lineErrs, model.LineError{
+				Line:     i,
+				PartUUID: partID,
+				Code:     model.CodeInvalidQuantity,
+				Message:  "quantity must be greater than 0",
+			})
+			continue
+		}
+
+		if _, seen := lines[partID]; !seen {
+			merged = append(merged, model.Item{PartUUID: partID})
+		}
+		lines[partID] = append(lines[partID], i)
+		for j := range merged {
+			if merged[j].PartUUID == partID {
+				merged[j].Quantity += v.Quantity
+				break
+			}
+		}
+	}
+
+	if len(lineErrs) > 0 {
+		return nil, nil, &model.ValidationError{Lines: lineErrs}
+	}
+	return merged, lines, nil
+}
+
+// lineErrors reports the same problem for every request line of a part.
+func lineErrors(lines []int, partID, code, msg string) []model.LineError {
+	res := make([]model.LineError, len(lines))
+	for i, line := range lines {
+		res[i] = model.LineError{
+			Line:     line,
+			PartUUID: partID,
+			Code:     code,
+			Message:  msg,
+		}
+	}
+	return res
+}
+
+func notEnoughStockMessage(partID string, requested, available int, lines []int) string {
+	if len(lines) > 1 {
+		return fmt.Sprintf("part %s: requested %d in total over lines %v, only %d in stock", partID, requested, lines, available)
+	}
+	return fmt.Sprintf("part %s: requested %d, only %d in stock", partID, requested, available)
+}
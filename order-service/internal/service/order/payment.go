@@ -0,0 +1,199 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"order-service/internal/repository/model"
+	"time"
+)
+
+// paymentActor is the actor recorded in the history when the outcome of a
+// pending payment moves the order.
+const paymentActor = "payment-service"
+
+// SyncPayment finalises a PAYMENT_PENDING order with the outcome of its
+// payment as reported by payment-service: a confirmed payment makes the
+// order PAID, a failed or timed out one releases the reserved parts and
+// returns the order to PENDING_PAYMENT so it can be paid again. A timed out
+// payment is voided in payment-service before that. Orders in any other
+// status are returned as they are.
+func (s *Service) SyncPayment(ctx context.Context, orderID string) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != model.StatusPaymentPending {
+		return order, nil
+	}
+	if order.TransactionUUID == nil {
+		return nil, fmt.Errorf("order %s is waiting for payment but has no transaction", order.OrderUUID)
+	}
+	payment, err := s.pay.PaymentStatus(ctx, *order.TransactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case payment.State == model.PaymentSucceeded:
+		order.PaymentDeadline = nil
+		err = s.transition(ctx, order, model.StatusPaid, paymentActor, "payment completed")
+	case payment.State == model.PaymentFailed:
+		err = s.abandonPayment(ctx, order, "payment failed: "+payment.FailureCode)
+	case order.PaymentDeadline != nil && !s.now().Before(*order.PaymentDeadline):
+		// The payment is expired in payment-service first, so that a late
+		// confirmation cannot charge for parts that are back in stock. If it
+		// was confirmed in the meantime, the next sync sees it paid.
+		if err = s.pay.Void(ctx, *order.TransactionUUID, "payment timed out"); err == nil {
+			err = s.abandonPayment(ctx, order, "payment timed out")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// abandonPayment returns the parts of a PAYMENT_PENDING order to stock and
+// the order to PENDING_PAYMENT. If the order cannot be saved, e.g. because
+// the outcome was already applied concurrently, the parts are reserved again.
+func (s *Service) abandonPayment(ctx context.Context, order *model.Order, reason string) error {
+	if err := s.inv.ReleaseStock(ctx, order.Items); err != nil {
+		return err
+	}
+	order.PaymentMethod = nil
+	order.TransactionUUID = nil
+	order.PaymentDeadline = nil
+	err := s.transition(ctx, order, model.StatusPendingPayment, paymentActor, reason)
+	if err != nil {
+		if rerr := s.inv.ReserveStock(ctx, order.Items); rerr != nil {
+			return errors.Join(err, fmt.Errorf("reserve stock back: %w", rerr))
+		}
+	}
+	return err
+}
+
+// reconcileActor is the actor recorded when reconciliation fixes an order.
+const reconcileActor = "reconciliation"
+
+// RecordPayment marks the order PAID with tx, a payment payment-service made
+// that the order missed, e.g. because the response to PayOrder was lost. A
+// PENDING_PAYMENT order gets its parts reserved again; a PAYMENT_PENDING one
+// must be waiting for tx. Orders in any other status are a conflict.
+func (s *Service) RecordPayment(ctx context.Context, orderID string, tx model.PaymentTransaction) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.OrderUUID != order.OrderUUID || tx.Status != model.TransactionPaid {
+		return nil, fmt.Errorf("%w: transaction %s is not a payment of order %s", model.ErrBadRequest, tx.TransactionUUID, order.OrderUUID)
+	}
+	reserve := false
+	switch order.Status {
+	case model.StatusPendingPayment:
+		reserve = true
+	case model.StatusPaymentPending:
+		if order.TransactionUUID != nil && *order.TransactionUUID != tx.TransactionUUID {
+			return nil, fmt.Errorf("%w: order is waiting for transaction %s", model.ErrConflict, *order.TransactionUUID)
+		}
+	default:
+		return nil, model.ErrConflict
+	}
+	if reserve {
+		if err := s.inv.ReserveStock(ctx, order.Items); err != nil {
+			return nil, err
+		}
+	}
+	method := tx.Method
+	order.PaymentMethod = &method
+	order.TransactionUUID = &tx.TransactionUUID
+	order.PaymentDeadline = nil
+	err = s.transition(ctx, order, model.StatusPaid, reconcileActor, "payment found by reconciliation")
+	if err != nil && reserve {
+		if rerr := s.inv.ReleaseStock(ctx, order.Items); rerr != nil {
+			return nil, errors.Join(err, fmt.Errorf("release stock: %w", rerr))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// SyncPendingPayments runs SyncPayment for every PAYMENT_PENDING order and
+// returns how many of them were finalised.
+func (s *Service) SyncPendingPayments(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListPaymentPending(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	var errs []error
+	for _, id := range ids {
+		order, err := s.SyncPayment(ctx, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("order %s: %w", id, err))
+			continue
+		}
+		if order.Status != model.StatusPaymentPending {
+			n++
+		}
+	}
+	return n, errors.Join(errs...)
+}
+
+// syncActor is the actor recorded when the background sync finishes an
+// order.
+const syncActor = "order-service"
+
+// FinishCancellations retries giving back the payments of CANCELLING orders
+// and returns how many of them were cancelled.
+func (s *Service) FinishCancellations(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListCancelling(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	var errs []error
+	for _, id := range ids {
+		order, err := s.repo.Get(ctx, id)
+		if err == nil && order.Status == model.StatusCancelling {
+			if err = s.finishCancel(ctx, order, syncActor); err == nil {
+				n++
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("order %s: %w", id, err))
+		}
+	}
+	return n, errors.Join(errs...)
+}
+
+// RunPaymentSync polls pending payments and unfinished cancellations every
+// interval until ctx is done, so orders are finalised even if the payment
+// callback never arrives or a refund failed.
+func (s *Service) RunPaymentSync(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.SyncPendingPayments(ctx)
+		if err != nil {
+			log.Printf("не удалось проверить ожидающие оплаты: %v", err)
+		}
+		if n > 0 {
+			log.Printf("обработано ожидающих оплат: %d", n)
+		}
+		n, err = s.FinishCancellations(ctx)
+		if err != nil {
+			log.Printf("не удалось завершить отмену заказов: %v", err)
+		}
+		if n > 0 {
+			log.Printf("завершена отмена заказов: %d", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *OrderServiceTest) pendingOrder() *model.Order {
+	tx := "tx-1"
+	pm := model.PaymentSBP
+	deadline := s.service.now().Add(15 * time.Minute)
+	return &model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}},
+		TotalPrice:      300,
+		Status:          model.StatusPaymentPending,
+		PaymentMethod:   &pm,
+		TransactionUUID: &tx,
+		PaymentDeadline: &deadline,
+	}
+}
+
+func (s *OrderServiceTest) TestPayOrder_pending() {
+	ctx := context.Background()
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 300, Status: model.StatusPendingPayment}
+	pm := model.PaymentSBP
+	payment := &model.Payment{TransactionUUID: "tx-1", State: model.PaymentPending, PaymentURL: "https://qr.nspk.ru/AS1"}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("MakePayment", ctx, "id-1", "u-1", 300.0, &pm, order.PaymentKey()).Return(payment, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPendingPayment,
+		To:     model.StatusPaymentPending,
+		Actor:  "u-1",
+		Reason: "waiting for payment confirmation",
+	}).Return(nil)
+
+	got, err := s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.Require().NoError(err)
+	s.Equal(payment, got)
+	s.Equal(model.StatusPaymentPending, order.Status)
+	s.Equal(s.service.now().Add(DefaultPaymentTimeout), *order.PaymentDeadline)
+
+	// A pending order cannot be paid again or cancelled.
+	_, err = s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.ErrorIs(err, model.ErrConflict)
+	_, err = s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *OrderServiceTest) TestSyncPayment_succeeded() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPaymentPending,
+		To:     model.StatusPaid,
+		Actor:  "payment-service",
+		Reason: "payment completed",
+	}).Return(nil)
+
+	got, err := s.service.SyncPayment(ctx, "id-1")
+	s.Require().NoError(err)
+	s.Equal(model.StatusPaid, got.Status)
+	s.Nil(got.PaymentDeadline)
+	s.Equal("tx-1", *got.TransactionUUID)
+}
+
+func (s *OrderServiceTest) TestSyncPayment_failedReleasesStock() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentFailed, FailureCode: "DECLINED"}, nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPaymentPending,
+		To:     model.StatusPendingPayment,
+		Actor:  "payment-service",
+		Reason: "payment failed: DECLINED",
+	}).Return(nil)
+
+	got, err := s.service.SyncPayment(ctx, "id-1")
+	s.Require().NoError(err)
+	s.Equal(model.StatusPendingPayment, got.Status)
+	s.Nil(got.TransactionUUID)
+	s.Nil(got.PaymentMethod)
+	s.Nil(got.PaymentDeadline)
+}
+
+func (s *OrderServiceTest) TestSyncPayment_timeout() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+	stillPending := &model.Payment{TransactionUUID: "tx-1", State: model.PaymentPending}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(stillPending, nil)
+
+	got, err := s.service.SyncPayment(ctx, "id-1")
+	s.Require().NoError(err)
+	s.Equal(model.StatusPaymentPending, got.Status)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+
+	deadline := s.service.now()
+	order.PaymentDeadline = &deadline
+	s.pay.On("Void", ctx, "tx-1", "payment timed out").Return(nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+	s.repo.On("Update", ctx, order, mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.To == model.StatusPendingPayment && c.Reason == "payment timed out"
+	})).Return(nil)
+
+	got, err = s.service.SyncPayment(ctx, "id-1")
+	s.Require().NoError(err)
+	s.Equal(model.StatusPendingPayment, got.Status)
+}
+
+func (s *OrderServiceTest) TestSyncPayment_timeoutConfirmedMeanwhile() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+	deadline := s.service.now()
+	order.PaymentDeadline = &deadline
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentPending}, nil)
+	s.pay.On("Void", ctx, "tx-1", "payment timed out").Return(errors.New("cannot void a PAID transaction"))
+
+	// The parts stay reserved until the next sync sees the payment.
+	_, err := s.service.SyncPayment(ctx, "id-1")
+	s.Error(err)
+	s.Equal(model.StatusPaymentPending, order.Status)
+	s.inv.AssertNotCalled(s.T(), "ReleaseStock", mock.Anything, mock.Anything)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestSyncPayment_conflictReservesBack() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentFailed, FailureCode: "EXPIRED"}, nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+	s.repo.On("Update", ctx, order, mock.Anything).Return(model.ErrConflict)
+	s.inv.On("ReserveStock", ctx, order.Items).Return(nil)
+
+	_, err := s.service.SyncPayment(ctx, "id-1")
+	s.ErrorIs(err, model.ErrConflict)
+	s.inv.AssertExpectations(s.T())
+}
+
+func (s *OrderServiceTest) TestSyncPendingPayments() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+
+	s.repo.On("ListPaymentPending", ctx).Return([]string{"id-1", "id-2"}, nil)
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("Get", ctx, "id-2").Return(nil, model.ErrNotFound)
+	s.pay.On("PaymentStatus", ctx, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil)
+	s.repo.On("Update", ctx, order, mock.Anything).Return(nil)
+
+	n, err := s.service.SyncPendingPayments(ctx)
+	s.Equal(1, n)
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *OrderServiceTest) TestFinishCancellations() {
+	ctx := context.Background()
+	order := s.authorizedOrder(model.StatusCancelling)
+	reason := model.CancelReasonOther
+	order.CancelReason = &reason
+
+	s.repo.On("ListCancelling", ctx).Return([]string{"id-1", "id-2"}, nil)
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("Get", ctx, "id-2").Return(&model.Order{OrderUUID: "id-2", Status: model.StatusCancelled}, nil)
+	s.pay.On("Void", ctx, "tx-1", string(reason)).Return(nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusCancelling,
+		To:     model.StatusCancelled,
+		Actor:  "order-service",
+		Reason: "authorization voided",
+	}).Return(nil)
+	s.inv.On("ReleaseStock", ctx, order.Items).Return(nil)
+
+	n, err := s.service.FinishCancellations(ctx)
+	s.Require().NoError(err)
+	s.Equal(1, n)
+}
+
+func (s *OrderServiceTest) TestRecordPayment() {
+	ctx := context.Background()
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 3, Price: 100}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 300, Status: model.StatusPendingPayment}
+	tx := model.PaymentTransaction{TransactionUUID: "tx-1", OrderUUID: "id-1", Method: model.PaymentCard, Status: model.TransactionPaid, Amount: 300}
+
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPendingPayment,
+		To:     model.StatusPaid,
+		Actor:  "reconciliation",
+		Reason: "payment found by reconciliation",
+	}).Return(nil)
+
+	got, err := s.service.RecordPayment(ctx, "id-1", tx)
+	s.Require().NoError(err)
+	s.Equal(model.StatusPaid, got.Status)
+	s.Equal("tx-1", *got.TransactionUUID)
+	s.Equal(model.PaymentCard, *got.PaymentMethod)
+
+	// A paid order is not touched again.
+	_, err = s.service.RecordPayment(ctx, "id-1", tx)
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *OrderServiceTest) TestRecordPayment_otherTransaction() {
+	ctx := context.Background()
+	order := s.pendingOrder()
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+
+	_, err := s.service.RecordPayment(ctx, "id-1", model.PaymentTransaction{TransactionUUID: "tx-2", OrderUUID: "id-1", Status: model.TransactionPaid})
+	s.ErrorIs(err, model.ErrConflict)
+	_, err = s.service.RecordPayment(ctx, "id-1", model.PaymentTransaction{TransactionUUID: "tx-1", OrderUUID: "id-2", Status: model.TransactionPaid})
+	s.ErrorIs(err, model.ErrBadRequest)
+	s.Equal(model.StatusPaymentPending, order.Status)
+}
@@ -0,0 +1,191 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/repository/model"
+	"sort"
+	"strings"
+	"time"
+)
+
+// applyPromo looks up code, checks that it can be used right now and writes
+// the discounts it grants into the order lines. Per-user limits are checked by
+// the repository when the order is stored.
+func (s *Service) applyPromo(ctx context.Context, code string, items []model.Item) error {
+	if s.promos == nil {
+		return model.NewPromoError(model.CodePromoNotFound, "promo code %s not found", code)
+	}
+	promo, err := s.promos.Get(ctx, code)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.NewPromoError(model.CodePromoNotFound, "promo code %s not found", code)
+		}
+		return err
+	}
+	if err := checkPromo(promo, subtotal(items), s.now()); err != nil {
+		return err
+	}
+	if discountItems(promo, items) == 0 {
+		return model.NewPromoError(model.CodePromoNotApplicable, "promo code %s does not apply to the ordered items", code)
+	}
+	return nil
+}
+
+// reapplyPromo recalculates the discounts of an order that already redeemed
+// code. Validity and usage limits are not checked again: the code was valid
+// when the order was placed. If the code no longer grants anything for the
+// new lines, the discounts simply drop to zero.
+func (s *Service) reapplyPromo(ctx context.Context, code string, items []model.Item) error {
+	if s.promos == nil {
+		return nil
+	}
+	promo, err := s.promos.Get(ctx, code)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if subtotal(items) < promo.MinSubtotal {
+		return nil
+	}
+	discountItems(promo, items)
+	return nil
+}
+
+func checkPromo(p *model.PromoCode, subtotal float64, now time.Time) error {
+	switch {
+	case !p.Active:
+		return model.NewPromoError(model.CodePromoInactive, "promo code %s is not active", p.Code)
+	case p.ValidFrom != nil && now.Before(*p.ValidFrom):
+		return model.NewPromoError(model.CodePromoNotStarted, "promo code %s is valid from %s", p.Code, p.ValidFrom.Format(time.RFC3339))
+	case p.ValidTo != nil && !now.Before(*p.ValidTo):
+		return model.NewPromoError(model.CodePromoExpired, "promo code %s expired at %s", p.Code, p.ValidTo.Format(time.RFC3339))
+	case p.MaxUses != nil && p.UsedCount >= *p.MaxUses:
+		return model.NewPromoError(model.CodePromoUsageLimit, "promo code %s has been used up", p.Code)
+	case subtotal < p.MinSubtotal:
+		return model.NewPromoError(model.CodePromoNotApplicable, "promo code %s requires an order of at least %.2f", p.Code, p.MinSubtotal)
+	}
+	return nil
+}
+
+// discountItems sets the discount of every line according to the promo rule
+// and returns the total discount. Discounts are rounded to cents and never
+// exceed the line amount.
+func discountItems(p *model.PromoCode, items []model.Item) float64 {
+	for i := range items {
+		items[i].Discount = 0
+	}
+
+	switch p.RuleType {
+	case model.PromoPercentage:
+		for i := range items {
+			items[i].Discount = percentOf(items[i].Amount(), p.Value)
+		}
+	case model.PromoCategoryPercentage:
+		for i := range items {
+			if sameCategory(items[i].Category, p.Category) {
+				items[i].Discount = percentOf(items[i].Amount(), p.Value)
+			}
+		}
+	case model.PromoFixedAmount:
+		spreadFixed(items, p.Value)
+	case model.PromoBuyXGetY:
+		buyXGetY(p, items)
+	}
+
+	var total float64
+	for _, v := range items {
+		total += v.Discount
+	}
+	return model.RoundMoney(total)
+}
+
+// spreadFixed splits amount over the lines in proportion to their amount. The
+// last discounted line takes the rounding remainder, so the line discounts add
+// up to exactly min(amount, subtotal).
+func spreadFixed(items []model.Item, amount float64) {
+	sub := subtotal(items)
+	if sub <= 0 || amount <= 0 {
+		return
+	}
+	amount = model.RoundMoney(min(amount, sub))
+	rest := amount
+	last := -1
+	for i := range items {
+		if items[i].Amount() <= 0 {
+			continue
+		}
+		items[i].Discount = model.RoundMoney(amount * items[i].Amount() / sub)
+		rest -= items[i].Discount
+		last = i
+	}
+	if last >= 0 {
+		items[last].Discount = model.RoundMoney(min(items[last].Discount+rest, items[last].Amount()))
+	}
+}
+
+// buyXGetY discounts the cheapest units of GetCategory by Value percent:
+// GetQuantity units for every BuyQuantity units of Category. When both
+// categories are the same, the discounted units cannot count towards the
+// purchase, so every BuyQuantity+GetQuantity units earn GetQuantity.
+func buyXGetY(p *model.PromoCode, items []model.Item) {
+	if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+		return
+	}
+	getCategory := p.GetCategory
+	if getCategory == "" {
+		getCategory = p.Category
+	}
+
+	var bought, eligible int
+	var candidates []int
+	for i, v := range items {
+		if sameCategory(v.Category, p.Category) {
+			bought += v.Quantity
+		}
+		if sameCategory(v.Category, getCategory) {
+			eligible += v.Quantity
+			candidates = append(candidates, i)
+		}
+	}
+
+	var free int
+	if sameCategory(getCategory, p.Category) {
+		free = bought / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+	} else {
+		free = min(bought/p.BuyQuantity*p.GetQuantity, eligible)
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return items[candidates[a]].Price < items[candidates[b]].Price
+	})
+	for _, i := range candidates {
+		if free == 0 {
+			break
+		}
+		units := min(free, items[i].Quantity)
+		items[i].Discount = percentOf(items[i].Price*float64(units), p.Value)
+		free -= units
+	}
+}
+
+func percentOf(amount, percent float64) float64 {
+	percent = max(0, min(percent, 100))
+	return model.RoundMoney(amount * percent / 100)
+}
+
+// sameCategory compares categories the way inventory names them; an empty
+// promo category never matches.
+func sameCategory(a, b string) bool {
+	return b != "" && strings.EqualFold(a, b)
+}
+
+func subtotal(items []model.Item) float64 {
+	var res float64
+	for _, v := range items {
+		res += v.Amount()
+	}
+	return model.RoundMoney(res)
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"order-service/internal/repository/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscountItems(t *testing.T) {
+	items := func() []model.Item {
+		return []model.Item{
+			{PartUUID: "engine-1", Price: 100, Quantity: 1, Category: "ENGINE"},
+			{PartUUID: "wing-1", Price: 30, Quantity: 2, Category: "WING"},
+			{PartUUID: "wing-2", Price: 10, Quantity: 1, Category: "WING"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		promo     model.PromoCode
+		discounts []float64
+	}{
+		{
+			name:      "percentage",
+			promo:     model.PromoCode{RuleType: model.PromoPercentage, Value: 15},
+			discounts: []float64{15, 9, 1.5},
+		},
+		{
+			name:      "fixed amount spread proportionally",
+			promo:     model.PromoCode{RuleType: model.PromoFixedAmount, Value: 17},
+			discounts: []float64{10, 6, 1},
+		},
+		{
+			name:      "fixed amount capped at subtotal",
+			promo:     model.PromoCode{RuleType: model.PromoFixedAmount, Value: 500},
+			discounts: []float64{100, 60, 10},
+		},
+		{
+			name:      "category percentage",
+			promo:     model.PromoCode{RuleType: model.PromoCategoryPercentage, Category: "wing", Value: 50},
+			discounts: []float64{0, 30, 5},
+		},
+		{
+			name:      "buy 2 get 1 within a category takes the cheapest unit",
+			promo:     model.PromoCode{RuleType: model.PromoBuyXGetY, Category: "WING", BuyQuantity: 2, GetQuantity: 1, Value: 100},
+			discounts: []float64{0, 0, 10},
+		},
+		{
+			name:      "buy an engine get wings at half price",
+			promo:     model.PromoCode{RuleType: model.PromoBuyXGetY, Category: "ENGINE", BuyQuantity: 1, GetCategory: "WING", GetQuantity: 2, Value: 50},
+			discounts: []float64{0, 15, 5},
+		},
+		{
+			name:      "buy x get y not reached",
+			promo:     model.PromoCode{RuleType: model.PromoBuyXGetY, Category: "ENGINE", BuyQuantity: 2, GetQuantity: 1, Value: 100},
+			discounts: []float64{0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := items()
+			discountItems(&tt.promo, lines)
+
+			got := make([]float64, len(lines))
+			for i, v := range lines {
+				got[i] = v.Discount
+			}
+			assert.Equal(t, tt.discounts, got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"order-service/internal/repository/model"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuote prices req exactly like CreateOrder would and stores the result
+// as a quote valid for the configured time. Stock is checked but not
+// reserved.
+func (s *Service) CreateQuote(ctx context.Context, req model.OrderRequest) (*model.Quote, error) {
+	if s.quotes == nil {
+		return nil, fmt.Errorf("%w: quotes are not available", model.ErrBadRequest)
+	}
+	order, err := s.buildOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	order.OrderUUID = ""
+	quote := &model.Quote{
+		QuoteUUID: uuid.New().String(),
+		Status:    model.QuoteOpen,
+		Order:     *order,
+		ExpiresAt: s.now().Add(s.quoteTTL),
+	}
+	if err := s.quotes.Create(ctx, quote); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+func (s *Service) GetQuote(ctx context.Context, quoteID string) (*model.Quote, error) {
+	if s.quotes == nil {
+		return nil, fmt.Errorf("%w: quotes are not available", model.ErrBadRequest)
+	}
+	if _, err := uuid.Parse(quoteID); err != nil {
+		return nil, model.ErrNotFound
+	}
+	quote, err := s.quotes.Get(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status == model.QuoteOpen && !s.now().Before(quote.ExpiresAt) {
+		quote.Status = model.QuoteExpired
+	}
+	return quote, nil
+}
+
+// AcceptQuote turns an open quote into an order at the locked prices, taxes
+// and shipping cost. The parts must still be in stock; current inventory
+// prices are ignored. A quote can be accepted only once.
+func (s *Service) AcceptQuote(ctx context.Context, quoteID string) (*model.Order, error) {
+	quote, err := s.GetQuote(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	switch quote.Status {
+	case model.QuoteExpired:
+		return nil, model.ErrQuoteExpired
+	case model.QuoteAccepted:
+		return nil, fmt.Errorf("%w: quote was already accepted", model.ErrConflict)
+	}
+
+	// Only the stock check of priceItems matters here, the fresh prices are
+	// thrown away.
+	if _, err := s.priceItems(ctx, quote.Order.Items, nil); err != nil {
+		return nil, err
+	}
+
+	order := quote.Order
+	order.OrderUUID = uuid.New().String()
+	order.Status = model.StatusPendingPayment
+	order.QuoteUUID = &quote.QuoteUUID
+	if err := s.repo.Create(ctx, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
@@ -0,0 +1,116 @@
+package order
+
+import (
+	"context"
+	"order-service/internal/mocks"
+	"order-service/internal/repository/model"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+const testQuoteID = "7d1f4a3e-2b7c-4c52-9d0e-3f6a1b2c4d5e"
+
+func (s *OrderServiceTest) quoteService() (*Service, *mocks.QuoteRepository) {
+	quotes := mocks.NewQuoteRepository(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithQuotes(quotes, 72*time.Hour))
+	svc.now = s.service.now
+	return svc, quotes
+}
+
+func (s *OrderServiceTest) openQuote(expiresAt time.Time) *model.Quote {
+	return &model.Quote{
+		QuoteUUID: testQuoteID,
+		Status:    model.QuoteOpen,
+		ExpiresAt: expiresAt,
+		Order: model.Order{
+			UserUUID:   "user-1",
+			Items:      []model.Item{{PartUUID: "engine-1", Quantity: 2, Price: 100, Name: "Engine"}},
+			Subtotal:   200,
+			TotalPrice: 200,
+		},
+	}
+}
+
+func (s *OrderServiceTest) TestCreateQuote() {
+	ctx := context.Background()
+	svc, quotes := s.quoteService()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+	quotes.On("Create", ctx, mock.AnythingOfType("*model.Quote")).Return(nil)
+
+	quote, err := svc.CreateQuote(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 2},
+	}})
+	s.Require().NoError(err)
+	s.Equal(model.QuoteOpen, quote.Status)
+	s.Equal(time.Date(2026, 10, 22, 12, 0, 0, 0, time.UTC), quote.ExpiresAt)
+	s.Equal(float64(200), quote.Order.TotalPrice)
+	s.Empty(quote.Order.OrderUUID)
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestAcceptQuote_lockedPrices() {
+	ctx := context.Background()
+	svc, quotes := s.quoteService()
+
+	quotes.On("Get", ctx, testQuoteID).Return(s.openQuote(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)), nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 150, Quantity: 5, Name: "Engine"},
+	}, nil)
+	s.repo.On("Create", ctx, mock.MatchedBy(func(o *model.Order) bool {
+		return o.QuoteUUID != nil && *o.QuoteUUID == testQuoteID &&
+			o.Status == model.StatusPendingPayment && o.OrderUUID != "" &&
+			o.Items[0].Price == 100 && o.TotalPrice == 200
+	})).Return(nil)
+
+	order, err := svc.AcceptQuote(ctx, testQuoteID)
+	s.Require().NoError(err)
+	s.Equal(float64(200), order.TotalPrice)
+}
+
+func (s *OrderServiceTest) TestAcceptQuote_expired() {
+	ctx := context.Background()
+	svc, quotes := s.quoteService()
+
+	quotes.On("Get", ctx, testQuoteID).Return(s.openQuote(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)), nil)
+
+	_, err := svc.AcceptQuote(ctx, testQuoteID)
+	s.ErrorIs(err, model.ErrQuoteExpired)
+	s.inv.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestAcceptQuote_alreadyAccepted() {
+	ctx := context.Background()
+	svc, quotes := s.quoteService()
+
+	quote := s.openQuote(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))
+	quote.Status = model.QuoteAccepted
+	quotes.On("Get", ctx, testQuoteID).Return(quote, nil)
+
+	_, err := svc.AcceptQuote(ctx, testQuoteID)
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *OrderServiceTest) TestAcceptQuote_notEnoughStock() {
+	ctx := context.Background()
+	svc, quotes := s.quoteService()
+
+	quotes.On("Get", ctx, testQuoteID).Return(s.openQuote(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)), nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 1, Name: "Engine"},
+	}, nil)
+
+	_, err := svc.AcceptQuote(ctx, testQuoteID)
+	s.ErrorIs(err, model.ErrNotEnoughInStock)
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestGetQuote_invalidID() {
+	svc, _ := s.quoteService()
+
+	_, err := svc.GetQuote(context.Background(), "not-a-uuid")
+	s.ErrorIs(err, model.ErrNotFound)
+}
@@ -2,25 +2,241 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"order-service/internal/repository"
 	"order-service/internal/repository/model"
 	"order-service/internal/service"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo repository.OrderRepository
-	inv  service.InventoryService
-	pay  service.PaymentService
+	repo     repository.OrderRepository
+	inv      service.InventoryService
+	pay      service.PaymentService
+	promos   repository.PromoRepository
+	taxes    service.TaxCalculator
+	shipping service.ShippingCalculator
+	compat   service.CompatibilityValidator
+	quotes   repository.QuoteRepository
+	quoteTTL time.Duration
+	// paymentTimeout is how long a pending payment is waited for when the
+	// provider does not set its expiry.
+	paymentTimeout time.Duration
+	now            func() time.Time
 }
 
-func NewService(repo repository.OrderRepository, inv service.InventoryService, pay service.PaymentService) *Service {
-	return &Service{repo: repo, inv: inv, pay: pay}
+// Option configures the optional parts of Service.
+type Option func(*Service)
+
+// WithPromotions enables promo codes on orders.
+func WithPromotions(promos repository.PromoRepository) Option {
+	return func(s *Service) {
+		s.promos = promos
+	}
 }
 
-func (s *Service) CreateOrder(ctx context.Context, userID string, items []model.Item) (*model.Order, error) {
+// WithTaxes adds tax and import duty to order lines.
+func WithTaxes(taxes service.TaxCalculator) Option {
+	return func(s *Service) {
+		s.taxes = taxes
+	}
+}
 
+// WithShipping enables delivery options and shipping costs on orders.
+func WithShipping(shipping service.ShippingCalculator) Option {
+	return func(s *Service) {
+		s.shipping = shipping
+	}
+}
+
+// WithCompatibility rejects orders whose parts break the inventory
+// compatibility rules.
+func WithCompatibility(compat service.CompatibilityValidator) Option {
+	return func(s *Service) {
+		s.compat = compat
+	}
+}
+
+// WithQuotes enables quotes that lock prices for ttl.
+func WithQuotes(quotes repository.QuoteRepository, ttl time.Duration) Option {
+	return func(s *Service) {
+		s.quotes = quotes
+		s.quoteTTL = ttl
+	}
+}
+
+// WithPaymentTimeout sets how long a pending payment without an expiry of its
+// own is waited for, DefaultPaymentTimeout by default.
+func WithPaymentTimeout(d time.Duration) Option {
+	return func(s *Service) {
+		s.paymentTimeout = d
+	}
+}
+
+// DefaultPaymentTimeout is how long a pending payment is waited for unless
+// WithPaymentTimeout says otherwise.
+const DefaultPaymentTimeout = 15 * time.Minute
+
+func NewService(repo repository.OrderRepository, inv service.InventoryService, pay service.PaymentService, opts ...Option) *Service {
+	s := &Service{repo: repo, inv: inv, pay: pay, paymentTimeout: DefaultPaymentTimeout, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// CreateOrder normalises the requested lines, prices them with the current
+// inventory data, checks that the parts fit together, applies the promo code
+// if one is given, adds taxes for the buyer country and the chosen shipping
+// option and stores a new order awaiting payment.
+func (s *Service) CreateOrder(ctx context.Context, req model.OrderRequest) (*model.Order, error) {
+	order, err := s.buildOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// buildOrder prices req into a new order awaiting payment without storing it.
+func (s *Service) buildOrder(ctx context.Context, req model.OrderRequest) (*model.Order, error) {
+	merged, lines, err := normalizeItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	upItems, err := s.priceItems(ctx, merged, lines)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCompatibility(ctx, upItems); err != nil {
+		return nil, err
+	}
+
+	order := &model.Order{
+		OrderUUID: uuid.New().String(),
+		UserUUID:  req.UserUUID,
+		Items:     upItems,
+		Status:    model.StatusPendingPayment,
+		Cart:      req.Cart,
+	}
+	if code := strings.TrimSpace(req.PromoCode); code != "" {
+		if err := s.applyPromo(ctx, code, order.Items); err != nil {
+			return nil, err
+		}
+		order.PromoCode = &code
+	}
+	if country := strings.TrimSpace(req.BuyerCountry); country != "" {
+		order.BuyerCountry = &country
+	}
+	order.DeliveryAddress, err = normalizeAddress(req.DeliveryAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyTaxes(order); err != nil {
+		return nil, err
+	}
+	if option := strings.TrimSpace(req.ShippingOption); option != "" {
+		if err := s.applyShipping(order, option, shippingCountry(order)); err != nil {
+			return nil, err
+		}
+	}
+	order.Recalculate()
+	return order, nil
+}
+
+// modifyAttempts bounds how many times ModifyItems re-reads an order that was
+// changed between reading and saving it.
+const modifyAttempts = 3
+
+// ModifyItems applies ops to the items of a pending order, re-prices every
+// line with the current inventory prices and saves the result. Orders that
+// already left PENDING_PAYMENT cannot be modified. If the order is changed
+// concurrently, ops are applied again to the saved version.
+func (s *Service) ModifyItems(ctx context.Context, orderID string, ops []model.ItemOperation) (*model.Order, error) {
+	var err error
+	for range modifyAttempts {
+		var order *model.Order
+		order, err = s.modifyItems(ctx, orderID, ops)
+		if !errors.Is(err, model.ErrOrderChanged) {
+			return order, err
+		}
+	}
+	return nil, err
+}
+
+func (s *Service) modifyItems(ctx context.Context, orderID string, ops []model.ItemOperation) (*model.Order, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != model.StatusPendingPayment {
+		return nil, model.ErrConflict
+	}
+
+	items, err := applyItemOperations(order.Items, ops)
+	if err != nil {
+		return nil, err
+	}
+	upItems, err := s.priceItems(ctx, items, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCompatibility(ctx, upItems); err != nil {
+		return nil, err
+	}
+	if order.PromoCode != nil {
+		if err := s.reapplyPromo(ctx, *order.PromoCode, upItems); err != nil {
+			return nil, err
+		}
+	}
+
+	order.Items = upItems
+	if err := s.applyTaxes(order); err != nil {
+		return nil, err
+	}
+	if order.ShippingOption != nil {
+		if err := s.applyShipping(order, *order.ShippingOption, shippingCountry(order)); err != nil {
+			return nil, err
+		}
+	}
+	order.Recalculate()
+	if err := s.repo.ReplaceItems(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// checkCompatibility returns a *model.ConfigurationError listing every broken
+// compatibility rule. It does nothing unless WithCompatibility was given.
+func (s *Service) checkCompatibility(ctx context.Context, items []model.Item) error {
+	if s.compat == nil {
+		return nil
+	}
+	violations, err := s.compat.ValidateConfiguration(ctx, items)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return &model.ConfigurationError{Violations: violations}
+	}
+	return nil
+}
+
+// priceItems looks up the parts in inventory, checks that each of them has
+// enough stock and returns the items with current names, prices and
+// categories, without discounts. items must not contain duplicate parts.
+// lines maps a part to the request lines it came from; when nil, the position
+// in items is reported instead. All missing parts and stock shortages are
+// reported at once as a *model.ValidationError.
+func (s *Service) priceItems(ctx context.Context, items []model.Item, lines map[string][]int) ([]model.Item, error) {
 	var partIDs []string
 	for _, v := range items {
 		partIDs = append(partIDs, v.PartUUID)
@@ -30,81 +246,255 @@ func (s *Service) CreateOrder(ctx context.Context, userID string, items []model.
 		return nil, err
 	}
 
-	if len(parts) != len(items) {
-		return nil, model.ErrNotFound
-	}
-
 	partMap := make(map[string]*model.Part, len(parts))
 	for _, v := range parts {
 		partMap[v.UUID] = v
 	}
-	var total float64
+	var lineErrs []model.LineError
 	upItems := make([]model.Item, len(items))
 	for i, v := range items {
+		itemLines := []int{i}
+		if lines != nil {
+			itemLines = lines[v.PartUUID]
+		}
 		part, exists := partMap[v.PartUUID]
 		if !exists {
-			return nil, model.ErrNotFound
+			lineErrs = append(lineErrs, lineErrors(itemLines, v.PartUUID, model.CodePartNotFound, fmt.Sprintf("part %s not found", v.PartUUID))...)
+			continue
 		}
 		if part.Quantity < v.Quantity {
-			return nil, model.ErrNotEnoughInStock
+			msg := notEnoughStockMessage(v.PartUUID, v.Quantity, part.Quantity, itemLines)
+			lineErrs = append(lineErrs, lineErrors(itemLines, v.PartUUID, model.CodeNotEnoughStock, msg)...)
+			continue
 		}
 		upItems[i] = model.Item{
-			PartUUID: part.UUID,
-			Name:     part.Name,
-			Price:    part.Price,
-			Quantity: v.Quantity,
+			PartUUID:            part.UUID,
+			Name:                part.Name,
+			Price:               part.Price,
+			Quantity:            v.Quantity,
+			Category:            part.Category,
+			ManufacturerCountry: part.ManufacturerCountry,
+			Dimensions:          part.Dimensions,
 		}
-		total += part.Price * float64(v.Quantity)
 	}
-
-	order := &model.Order{
-		OrderUUID:  uuid.New().String(),
-		UserUUID:   userID,
-		Items:      upItems,
-		TotalPrice: total,
-		Status:     model.StatusPendingPayment,
+	if len(lineErrs) > 0 {
+		sort.Slice(lineErrs, func(i, j int) bool { return lineErrs[i].Line < lineErrs[j].Line })
+		return nil, &model.ValidationError{Lines: lineErrs}
 	}
+	return upItems, nil
+}
 
-	err = s.repo.Create(ctx, order)
+// applyTaxes calculates tax and duty of the order lines after discounts and
+// records the buyer country the rates were taken from.
+func (s *Service) applyTaxes(order *model.Order) error {
+	if s.taxes == nil {
+		return nil
+	}
+	var country string
+	if order.BuyerCountry != nil {
+		country = *order.BuyerCountry
+	}
+	resolved, err := s.taxes.Apply(country, order.Items)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return order, nil
+	order.BuyerCountry = &resolved
+	return nil
 }
 
 func (s *Service) GetOrder(ctx context.Context, orderID string) (*model.Order, error) {
 	return s.repo.Get(ctx, orderID)
 }
 
-func (s *Service) PayOrder(ctx context.Context, orderID string, pm *model.PaymentMethod) (string, error) {
+func (s *Service) GetOrderHistory(ctx context.Context, orderID string) (*model.OrderHistory, error) {
 	order, err := s.repo.Get(ctx, orderID)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	changes, err := s.repo.History(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.OrderHistory{Order: order, Changes: changes}, nil
+}
+
+// PayOrder reserves the ordered parts in inventory and charges the customer.
+// The reservation is released again if the payment fails or the order
+// cannot be saved, see undoPayment. A payment the provider confirms later
+// leaves the order PAYMENT_PENDING until SyncPayment sees its outcome.
+func (s *Service) PayOrder(ctx context.Context, orderID, actor string, pm *model.PaymentMethod) (*model.Payment, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
 	}
-	if order.Status == model.StatusPaid {
-		return "", model.ErrConflict
+	if order.Status != model.StatusPendingPayment {
+		return nil, model.ErrConflict
 	}
-	tId, err := s.pay.MakePayment(ctx, order.UserUUID, order.OrderUUID, pm)
+	if err := s.inv.ReserveStock(ctx, order.Items); err != nil {
+		return nil, err
+	}
+	payment, err := s.pay.MakePayment(ctx, order.OrderUUID, order.UserUUID, order.TotalPrice, pm, order.PaymentKey())
 	if err != nil {
-		return "", err
+		if rerr := s.inv.ReleaseStock(ctx, order.Items); rerr != nil {
+			return nil, errors.Join(err, fmt.Errorf("release stock: %w", rerr))
+		}
+		return nil, err
 	}
 	order.PaymentMethod = pm
-	order.TransactionUUID = &tId
-	order.Status = model.StatusPaid
-	s.repo.Update(ctx, order)
-	return tId, nil
+	order.TransactionUUID = &payment.TransactionUUID
+	if payment.State == model.PaymentPending {
+		deadline := payment.ExpiresAt
+		if deadline.IsZero() {
+			deadline = s.now().Add(s.paymentTimeout)
+		}
+		order.PaymentDeadline = &deadline
+		err = s.transition(ctx, order, model.StatusPaymentPending, actor, "waiting for payment confirmation")
+	} else {
+		err = s.transition(ctx, order, model.StatusPaid, actor, "payment completed")
+	}
+	if err != nil {
+		return nil, s.undoPayment(ctx, order, payment, err)
+	}
+	return payment, nil
+}
+
+// undoPayment cleans up after a payment made for an order that could not be
+// saved, e.g. because a concurrent PayOrder or CancelOrder changed it first:
+// the parts reserved for it go back to stock and, unless the order holds the
+// very same payment (payment-service returns it for repeats), the payment is
+// refunded, or voided if still pending. cause is returned with any failure.
+func (s *Service) undoPayment(ctx context.Context, order *model.Order, payment *model.Payment, cause error) error {
+	errs := []error{cause}
+	if err := s.inv.ReleaseStock(ctx, order.Items); err != nil {
+		errs = append(errs, fmt.Errorf("release stock: %w", err))
+	}
+	current, err := s.repo.Get(ctx, order.OrderUUID)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("get order: %w", err))...)
+	}
+	if current.TransactionUUID != nil && *current.TransactionUUID == payment.TransactionUUID {
+		return errors.Join(errs...)
+	}
+	const reason = "order changed while it was being paid"
+	if payment.State == model.PaymentPending {
+		err = s.pay.Void(ctx, payment.TransactionUUID, reason)
+	} else {
+		_, err = s.pay.Refund(ctx, payment.TransactionUUID, order.OrderUUID, order.UserUUID, reason)
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("give back payment %s: %w", payment.TransactionUUID, err))
+	}
+	return errors.Join(errs...)
 }
 
-func (s *Service) CancelOrder(ctx context.Context, orderId string) error {
+// CancelOrder cancels an order that has not been shipped yet. An unpaid
+// order is cancelled at once. A paid or authorized order is first moved to
+// CANCELLING, so that it can no longer ship, and then finished by
+// finishCancel. If the payment cannot be given back, the order stays
+// CANCELLING; cancelling it again or FinishCancellations retries.
+func (s *Service) CancelOrder(ctx context.Context, orderId, actor string, reason model.CancelReason, comment string) (*model.Order, error) {
 	order, err := s.repo.Get(ctx, orderId)
 	if err != nil {
-		return model.ErrNotFound
+		return nil, err
 	}
-	if order.Status == model.StatusPaid {
-		return model.ErrConflict
+	if order.Status == model.StatusCancelling {
+		if err := s.finishCancel(ctx, order, actor); err != nil {
+			return nil, err
+		}
+		return order, nil
+	}
+	paid := order.Status.IsPaid() || order.Status == model.StatusAuthorized
+	to := model.StatusCancelled
+	if paid {
+		to = model.StatusCancelling
+	}
+	if !model.CanTransition(order.Status, to) {
+		return nil, model.ErrConflict
+	}
+	if reason == "" {
+		reason = model.CancelReasonCustomerRequest
+	}
+
+	order.CancelReason = &reason
+	if comment != "" {
+		order.CancelComment = &comment
+	}
+	if err := s.transition(ctx, order, to, actor, string(reason)); err != nil {
+		return nil, err
+	}
+	if paid {
+		if err := s.finishCancel(ctx, order, actor); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// finishCancel refunds or voids the payment of a CANCELLING order, marks it
+// CANCELLED and returns its parts to stock. The payment is given back outside
+// of any database transaction; payment-service refunds and voids a
+// transaction once however often it is asked, so a retry after a failure at
+// any step does not give the money back twice.
+func (s *Service) finishCancel(ctx context.Context, order *model.Order, actor string) error {
+	reason := model.CancelReasonCustomerRequest
+	if order.CancelReason != nil {
+		reason = *order.CancelReason
+	}
+	if err := s.refund(ctx, order, reason); err != nil {
+		return fmt.Errorf("give back payment of order %s: %w", order.OrderUUID, err)
+	}
+	done := "payment refunded"
+	if order.RefundUUID == nil {
+		done = "authorization voided"
+	}
+	if err := s.transition(ctx, order, model.StatusCancelled, actor, done); err != nil {
+		return err
+	}
+	// If the parts cannot go back to stock they stay reserved, which holds
+	// stock back but never oversells.
+	if err := s.inv.ReleaseStock(ctx, order.Items); err != nil {
+		log.Printf("заказ %s отменён, но детали не вернулись на склад: %v", order.OrderUUID, err)
+	}
+	return nil
+}
+
+// refund refunds the payment of the order, or voids it if it was only
+// authorized. An authorization that cannot be voided because it was captured
+// after all, by a capture the order failed to save, is refunded.
+func (s *Service) refund(ctx context.Context, order *model.Order, reason model.CancelReason) error {
+	if order.TransactionUUID == nil {
+		return fmt.Errorf("order %s is paid but has no transaction", order.OrderUUID)
+	}
+	if order.AwaitingCapture() {
+		err := s.pay.Void(ctx, *order.TransactionUUID, string(reason))
+		if err == nil {
+			return nil
+		}
+		payment, serr := s.pay.PaymentStatus(ctx, *order.TransactionUUID)
+		if serr != nil || payment.State != model.PaymentSucceeded {
+			return err
+		}
+	}
+	refundID, err := s.pay.Refund(ctx, *order.TransactionUUID, order.OrderUUID, order.UserUUID, string(reason))
+	if err != nil {
+		return err
 	}
-	order.Status = model.StatusCancelled
-	s.repo.Update(ctx, order)
+	order.RefundUUID = &refundID
 	return nil
+}
 
+// transition moves the order to status to and persists it together with the
+// history entry describing who made the change and why.
+func (s *Service) transition(ctx context.Context, order *model.Order, to model.OrderStatus, actor, reason string) error {
+	if !model.CanTransition(order.Status, to) {
+		return model.ErrConflict
+	}
+	change := model.StatusChange{
+		From:   order.Status,
+		To:     to,
+		Actor:  actor,
+		Reason: reason,
+	}
+	order.Status = to
+	return s.repo.Update(ctx, order, change)
 }
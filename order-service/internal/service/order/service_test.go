@@ -3,9 +3,11 @@ package order
 import (
 	"context"
 	"errors"
+	"fmt"
 	"order-service/internal/mocks"
 	"order-service/internal/repository/model"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -14,9 +16,10 @@ import (
 type OrderServiceTest struct {
 	suite.Suite
 
-	repo *mocks.OrderRepository
-	inv  *mocks.InventoryService
-	pay  *mocks.PaymentService
+	repo   *mocks.OrderRepository
+	inv    *mocks.InventoryService
+	pay    *mocks.PaymentService
+	promos *mocks.PromoRepository
 
 	service *Service
 }
@@ -25,14 +28,23 @@ func (s *OrderServiceTest) SetupTest() {
 	s.repo = mocks.NewOrderRepository(s.T())
 	s.inv = mocks.NewInventoryService(s.T())
 	s.pay = mocks.NewPaymentService(s.T())
+	s.promos = mocks.NewPromoRepository(s.T())
 
-	s.service = NewService(s.repo, s.inv, s.pay)
+	s.service = NewService(s.repo, s.inv, s.pay, WithPromotions(s.promos))
+	s.service.now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
 }
 
 func TestOrderServiceTest(t *testing.T) {
 	suite.Run(t, new(OrderServiceTest))
 }
 
+// statusChange matches a change of the order status from from to to.
+func statusChange(from, to model.OrderStatus) any {
+	return mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.From == from && c.To == to
+	})
+}
+
 func (s *OrderServiceTest) TestCreateOrder_success() {
 	ctx := context.Background()
 
@@ -43,7 +55,7 @@ func (s *OrderServiceTest) TestCreateOrder_success() {
 		{UUID: "wing-1", Price: 20, Quantity: 3, Name: "Movtka"},
 	}, nil)
 	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
-	order, err := s.service.CreateOrder(ctx, "user-1", []model.Item{
+	order, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
 		{
 			PartUUID: "engine-1",
 			Quantity: 5,
@@ -52,7 +64,7 @@ func (s *OrderServiceTest) TestCreateOrder_success() {
 			PartUUID: "wing-1",
 			Quantity: 3,
 		},
-	})
+	}})
 
 	s.NoError(err)
 	s.Equal(float64(110), order.TotalPrice)
@@ -67,7 +79,7 @@ func (s *OrderServiceTest) TestCreateOrder_inventoryError() {
 	partIDs := []string{"engine-1", "wing-1"}
 
 	s.inv.On("ListParts", ctx, partIDs).Return(nil, errors.New("not found"))
-	_, err := s.service.CreateOrder(ctx, "user-1", []model.Item{
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
 		{
 			PartUUID: "engine-1",
 			Quantity: 5,
@@ -76,7 +88,7 @@ func (s *OrderServiceTest) TestCreateOrder_inventoryError() {
 			PartUUID: "wing-1",
 			Quantity: 3,
 		},
-	})
+	}})
 	s.Error(err)
 	s.inv.AssertExpectations(s.T())
 	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything)
@@ -91,24 +103,977 @@ func (s *OrderServiceTest) TestPayOrder_success() {
 		OrderUUID: orderID,
 		UserUUID:  userID,
 		Status:    model.StatusPendingPayment,
+		UpdatedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
 	}
 	s.repo.On("Get", ctx, orderID).Return(order, nil)
-	s.pay.On("MakePayment", ctx, userID, orderID, (*model.PaymentMethod)(nil)).Return("tId-1", nil)
-	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
-	_, err := s.service.PayOrder(ctx, orderID, nil)
+	s.inv.On("ReserveStock", ctx, order.Items).Return(nil)
+	s.pay.On("MakePayment", ctx, orderID, userID, 0.0, (*model.PaymentMethod)(nil), "id-1-1790856000000000").Return(&model.Payment{TransactionUUID: "tId-1", State: model.PaymentSucceeded}, nil)
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), model.StatusChange{
+		From:   model.StatusPendingPayment,
+		To:     model.StatusPaid,
+		Actor:  userID,
+		Reason: "payment completed",
+	}).Return(nil)
+	_, err := s.service.PayOrder(ctx, orderID, userID, nil)
 	s.NoError(err)
+	s.Equal(model.StatusPaid, order.Status)
 	s.repo.AssertExpectations(s.T())
 	s.pay.AssertExpectations(s.T())
 }
+func (s *OrderServiceTest) TestPayOrder_paymentFailed() {
+	ctx := context.Background()
+
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	order := &model.Order{
+		OrderUUID: "id-1",
+		UserUUID:  "u-1",
+		Items:     items,
+		Status:    model.StatusPendingPayment,
+	}
+	pm := model.PaymentCard
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("MakePayment", ctx, "id-1", "u-1", 0.0, &pm, order.PaymentKey()).Return(nil, errors.New("declined"))
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+
+	_, err := s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.Error(err)
+	s.inv.AssertExpectations(s.T())
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestPayOrder_raceWithRepeat() {
+	ctx := context.Background()
+
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 200, Status: model.StatusPendingPayment}
+	pm := model.PaymentCard
+	tx := "tx-1"
+	// A concurrent PayOrder got the same payment from payment-service and
+	// saved the order first.
+	saved := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, Status: model.StatusPaid, TransactionUUID: &tx}
+	s.repo.On("Get", ctx, "id-1").Return(order, nil).Once()
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("MakePayment", ctx, "id-1", "u-1", 200.0, &pm, order.PaymentKey()).Return(&model.Payment{TransactionUUID: tx, State: model.PaymentSucceeded}, nil)
+	s.repo.On("Update", ctx, order, mock.AnythingOfType("model.StatusChange")).Return(model.ErrConflict)
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+	s.repo.On("Get", ctx, "id-1").Return(saved, nil).Once()
+
+	_, err := s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.ErrorIs(err, model.ErrConflict)
+	s.inv.AssertExpectations(s.T())
+	s.pay.AssertNotCalled(s.T(), "Refund", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestPayOrder_raceWithCancel() {
+	ctx := context.Background()
+
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	order := &model.Order{OrderUUID: "id-1", UserUUID: "u-1", Items: items, TotalPrice: 200, Status: model.StatusPendingPayment}
+	pm := model.PaymentCard
+	s.repo.On("Get", ctx, "id-1").Return(order, nil).Once()
+	s.inv.On("ReserveStock", ctx, items).Return(nil)
+	s.pay.On("MakePayment", ctx, "id-1", "u-1", 200.0, &pm, order.PaymentKey()).Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil)
+	s.repo.On("Update", ctx, order, mock.AnythingOfType("model.StatusChange")).Return(model.ErrConflict)
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusCancelled}, nil).Once()
+	s.pay.On("Refund", ctx, "tx-1", "id-1", "u-1", "order changed while it was being paid").Return("refund-1", nil)
+
+	_, err := s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.ErrorIs(err, model.ErrConflict)
+	s.inv.AssertExpectations(s.T())
+	s.pay.AssertExpectations(s.T())
+}
+
+func (s *OrderServiceTest) TestPayOrder_notEnoughStock() {
+	ctx := context.Background()
+
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 20}}
+	pm := model.PaymentCard
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID: "id-1",
+		Items:     items,
+		Status:    model.StatusPendingPayment,
+	}, nil)
+	s.inv.On("ReserveStock", ctx, items).Return(model.ErrNotEnoughInStock)
+
+	_, err := s.service.PayOrder(ctx, "id-1", "u-1", &pm)
+	s.ErrorIs(err, model.ErrNotEnoughInStock)
+	s.pay.AssertNotCalled(s.T(), "MakePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (s *OrderServiceTest) TestCancelOrder_conflict() {
 	ctx := context.Background()
 
 	orderId := "id-1"
 	s.repo.On("Get", ctx, orderId).Return(&model.Order{
 		OrderUUID: orderId,
-		Status:    model.StatusPaid,
+		Status:    model.StatusCancelled,
 	}, nil)
-	err := s.service.CancelOrder(ctx, orderId)
+	_, err := s.service.CancelOrder(ctx, orderId, "u-1", "", "")
+	s.ErrorIs(err, model.ErrConflict)
+	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_pending() {
+	ctx := context.Background()
+
+	order := &model.Order{
+		OrderUUID: "id-1",
+		UserUUID:  "u-1",
+		Status:    model.StatusPendingPayment,
+	}
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPendingPayment,
+		To:     model.StatusCancelled,
+		Actor:  "support-7",
+		Reason: string(model.CancelReasonFoundCheaper),
+	}).Return(nil)
+
+	res, err := s.service.CancelOrder(ctx, "id-1", "support-7", model.CancelReasonFoundCheaper, "")
+	s.NoError(err)
+	s.Equal(model.StatusCancelled, res.Status)
+	s.Equal(model.CancelReasonFoundCheaper, *res.CancelReason)
+	s.Nil(res.CancelComment)
+	s.Nil(res.RefundUUID)
+	s.pay.AssertNotCalled(s.T(), "Refund", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_paidRefund() {
+	ctx := context.Background()
+
+	tId := "tId-1"
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	order := &model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           items,
+		TransactionUUID: &tId,
+		Status:          model.StatusPaid,
+	}
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	// The order leaves PAID before the refund, so it cannot ship meanwhile.
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusPaid,
+		To:     model.StatusCancelling,
+		Actor:  "u-1",
+		Reason: string(model.CancelReasonCustomerRequest),
+	}).Return(nil).Once()
+	s.pay.On("Refund", ctx, tId, "id-1", "u-1", string(model.CancelReasonCustomerRequest)).Return("refund-1", nil)
+	s.repo.On("Update", ctx, order, model.StatusChange{
+		From:   model.StatusCancelling,
+		To:     model.StatusCancelled,
+		Actor:  "u-1",
+		Reason: "payment refunded",
+	}).Return(nil).Once()
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+
+	res, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "changed plans")
+	s.NoError(err)
+	s.Equal(model.StatusCancelled, res.Status)
+	s.Equal("refund-1", *res.RefundUUID)
+	s.Equal("changed plans", *res.CancelComment)
+	s.repo.AssertExpectations(s.T())
+	s.inv.AssertExpectations(s.T())
+	s.pay.AssertExpectations(s.T())
+}
+
+func (s *OrderServiceTest) TestCancelOrder_refundFailed() {
+	ctx := context.Background()
+
+	tId := "tId-1"
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           items,
+		TransactionUUID: &tId,
+		Status:          model.StatusPaid,
+	}, nil)
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), statusChange(model.StatusPaid, model.StatusCancelling)).Return(nil)
+	s.pay.On("Refund", ctx, tId, "id-1", "u-1", mock.Anything).Return("", errors.New("gateway down"))
+
+	// The order stays CANCELLING with its parts reserved until the refund
+	// is retried.
+	_, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
 	s.Error(err)
-	s.repo.AssertNotCalled(s.T(), "Update", mock.Anything)
+	s.repo.AssertNumberOfCalls(s.T(), "Update", 1)
+	s.inv.AssertNotCalled(s.T(), "ReleaseStock", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_retriesRefund() {
+	ctx := context.Background()
+
+	tId := "tId-1"
+	reason := model.CancelReasonFoundCheaper
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	order := &model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           items,
+		TransactionUUID: &tId,
+		Status:          model.StatusCancelling,
+		CancelReason:    &reason,
+	}
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	// payment-service returns the refund it already made, if any.
+	s.pay.On("Refund", ctx, tId, "id-1", "u-1", string(reason)).Return("refund-1", nil)
+	s.repo.On("Update", ctx, order, statusChange(model.StatusCancelling, model.StatusCancelled)).Return(nil)
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+
+	res, err := s.service.CancelOrder(ctx, "id-1", "support-7", "", "")
+	s.Require().NoError(err)
+	s.Equal(model.StatusCancelled, res.Status)
+	s.Equal("refund-1", *res.RefundUUID)
+	s.Equal(reason, *res.CancelReason)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_raceLost() {
+	ctx := context.Background()
+
+	tId := "tId-1"
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           []model.Item{{PartUUID: "engine-1", Quantity: 2}},
+		TransactionUUID: &tId,
+		Status:          model.StatusAssembling,
+	}, nil)
+	// Another cancel or the shipment got the order first: the repository
+	// reports the conflict and nothing is refunded.
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), statusChange(model.StatusAssembling, model.StatusCancelling)).Return(model.ErrConflict)
+
+	_, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.ErrorIs(err, model.ErrConflict)
+	s.pay.AssertNotCalled(s.T(), "Refund", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.inv.AssertNotCalled(s.T(), "ReleaseStock", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestPayOrder_cancelled() {
+	ctx := context.Background()
+
+	orderID := "id-1"
+	s.repo.On("Get", ctx, orderID).Return(&model.Order{
+		OrderUUID: orderID,
+		Status:    model.StatusCancelled,
+	}, nil)
+	pm := model.PaymentCard
+	_, err := s.service.PayOrder(ctx, orderID, "u-1", &pm)
+	s.ErrorIs(err, model.ErrConflict)
+	s.pay.AssertNotCalled(s.T(), "MakePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestGetOrderHistory_success() {
+	ctx := context.Background()
+
+	orderID := "id-1"
+	order := &model.Order{OrderUUID: orderID, Status: model.StatusPaid}
+	changes := []model.StatusChange{
+		{To: model.StatusPendingPayment, Actor: "u-1", Reason: "order created"},
+		{From: model.StatusPendingPayment, To: model.StatusPaid, Actor: "u-1", Reason: "payment completed"},
+	}
+	s.repo.On("Get", ctx, orderID).Return(order, nil)
+	s.repo.On("History", ctx, orderID).Return(changes, nil)
+
+	history, err := s.service.GetOrderHistory(ctx, orderID)
+	s.NoError(err)
+	s.Equal(order, history.Order)
+	s.Equal(changes, history.Changes)
+}
+
+func (s *OrderServiceTest) TestGetOrderHistory_notFound() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(nil, model.ErrNotFound)
+	_, err := s.service.GetOrderHistory(ctx, "id-1")
+	s.ErrorIs(err, model.ErrNotFound)
+	s.repo.AssertNotCalled(s.T(), "History", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestModifyItems_success() {
+	ctx := context.Background()
+
+	order := &model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items: []model.Item{
+			{PartUUID: "engine-1", Quantity: 1, Price: 10, Name: "Engine"},
+			{PartUUID: "wing-1", Quantity: 2, Price: 20, Name: "Wing"},
+		},
+		TotalPrice: 50,
+	}
+	s.repo.On("Get", ctx, "id-1").Return(order, nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1", "porthole-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 12, Quantity: 5, Name: "Engine"},
+		{UUID: "porthole-1", Price: 3, Quantity: 10, Name: "Porthole"},
+	}, nil)
+	s.repo.On("ReplaceItems", ctx, order).Return(nil)
+
+	res, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpAdd, PartUUID: "porthole-1", Quantity: 4},
+		{Op: model.ItemOpSetQuantity, PartUUID: "engine-1", Quantity: 3},
+		{Op: model.ItemOpRemove, PartUUID: "wing-1"},
+	})
+	s.NoError(err)
+	s.Equal([]model.Item{
+		{PartUUID: "engine-1", Quantity: 3, Price: 12, Name: "Engine"},
+		{PartUUID: "porthole-1", Quantity: 4, Price: 3, Name: "Porthole"},
+	}, res.Items)
+	s.Equal(float64(48), res.TotalPrice)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *OrderServiceTest) TestModifyItems_changedConcurrently() {
+	ctx := context.Background()
+
+	stale := &model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 1, Price: 10}},
+		UpdatedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	// Another request added a wing before this one saved its change.
+	fresh := &model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items: []model.Item{
+			{PartUUID: "engine-1", Quantity: 1, Price: 10},
+			{PartUUID: "wing-1", Quantity: 2, Price: 20},
+		},
+		UpdatedAt: time.Date(2026, 10, 1, 12, 0, 1, 0, time.UTC),
+	}
+	s.repo.On("Get", ctx, "id-1").Return(stale, nil).Once()
+	s.repo.On("Get", ctx, "id-1").Return(fresh, nil).Once()
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1", "wing-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+		{UUID: "wing-1", Price: 20, Quantity: 5},
+	}, nil)
+	s.repo.On("ReplaceItems", ctx, stale).Return(model.ErrOrderChanged)
+	s.repo.On("ReplaceItems", ctx, fresh).Return(nil)
+
+	res, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpAdd, PartUUID: "engine-1", Quantity: 2},
+	})
+	s.Require().NoError(err)
+	s.Equal([]model.Item{
+		{PartUUID: "engine-1", Quantity: 3, Price: 10},
+		{PartUUID: "wing-1", Quantity: 2, Price: 20},
+	}, res.Items)
+	s.repo.AssertExpectations(s.T())
+}
+
+func (s *OrderServiceTest) TestModifyItems_keepsChanging() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 1, Price: 10}},
+	}, nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+	s.repo.On("ReplaceItems", ctx, mock.AnythingOfType("*model.Order")).Return(model.ErrOrderChanged)
+
+	_, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpAdd, PartUUID: "engine-1", Quantity: 1},
+	})
+	s.ErrorIs(err, model.ErrConflict)
+	s.repo.AssertNumberOfCalls(s.T(), "ReplaceItems", modifyAttempts)
+}
+
+func (s *OrderServiceTest) TestModifyItems_notPending() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPaid,
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+	}, nil)
+
+	_, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpAdd, PartUUID: "engine-1", Quantity: 1},
+	})
+	s.ErrorIs(err, model.ErrConflict)
+	s.inv.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+	s.repo.AssertNotCalled(s.T(), "ReplaceItems", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestModifyItems_notEnoughInStock() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 4}},
+	}, nil)
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+
+	_, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpAdd, PartUUID: "engine-1", Quantity: 2},
+	})
+	s.ErrorIs(err, model.ErrNotEnoughInStock)
+	s.repo.AssertNotCalled(s.T(), "ReplaceItems", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestModifyItems_removeLastItem() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID: "id-1",
+		Status:    model.StatusPendingPayment,
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 4}},
+	}, nil)
+
+	_, err := s.service.ModifyItems(ctx, "id-1", []model.ItemOperation{
+		{Op: model.ItemOpRemove, PartUUID: "engine-1"},
+	})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_mergesDuplicateLines() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1", "wing-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5, Name: "Engine"},
+		{UUID: "wing-1", Price: 20, Quantity: 3, Name: "Wing"},
+	}, nil)
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 2},
+		{PartUUID: "wing-1", Quantity: 1},
+		{PartUUID: "engine-1", Quantity: 3},
+	}})
+	s.Require().NoError(err)
+	s.Equal([]model.Item{
+		{PartUUID: "engine-1", Quantity: 5, Price: 10, Name: "Engine"},
+		{PartUUID: "wing-1", Quantity: 1, Price: 20, Name: "Wing"},
+	}, order.Items)
+	s.Equal(float64(70), order.TotalPrice)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_aggregatedQuantityExceedsStock() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 3},
+		{PartUUID: "engine-1", Quantity: 3},
+	}})
+	s.ErrorIs(err, model.ErrNotEnoughInStock)
+
+	var validationErr *model.ValidationError
+	s.Require().ErrorAs(err, &validationErr)
+	s.Require().Len(validationErr.Lines, 2)
+	s.Equal(0, validationErr.Lines[0].Line)
+	s.Equal(1, validationErr.Lines[1].Line)
+	s.Equal(model.CodeNotEnoughStock, validationErr.Lines[0].Code)
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_invalidLines() {
+	ctx := context.Background()
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 1},
+		{PartUUID: " ", Quantity: 1},
+		{PartUUID: "wing-1", Quantity: 0},
+	}})
+	s.ErrorIs(err, model.ErrBadRequest)
+
+	var validationErr *model.ValidationError
+	s.Require().ErrorAs(err, &validationErr)
+	s.Equal([]model.LineError{
+		{Line: 1, Code: model.CodeInvalidPartUUID, Message: "part_uuid is required"},
+		{Line: 2, PartUUID: "wing-1", Code: model.CodeInvalidQuantity, Message: "quantity must be greater than 0"},
+	}, validationErr.Lines)
+	s.inv.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_unknownPart() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1", "ghost-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 1},
+		{PartUUID: "ghost-1", Quantity: 1},
+	}})
+	s.ErrorIs(err, model.ErrNotFound)
+
+	var validationErr *model.ValidationError
+	s.Require().ErrorAs(err, &validationErr)
+	s.Require().Len(validationErr.Lines, 1)
+	s.Equal(1, validationErr.Lines[0].Line)
+	s.Equal(model.CodePartNotFound, validationErr.Lines[0].Code)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_promoPercentage() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1", "wing-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5, Name: "Engine", Category: "ENGINE"},
+		{UUID: "wing-1", Price: 20, Quantity: 3, Name: "Wing", Category: "WING"},
+	}, nil)
+	s.promos.On("Get", ctx, "SALE10").Return(&model.PromoCode{
+		Code: "SALE10", RuleType: model.PromoPercentage, Value: 10, Active: true,
+	}, nil)
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:  "user-1",
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 3}, {PartUUID: "wing-1", Quantity: 1}},
+		PromoCode: " SALE10 ",
+	})
+	s.Require().NoError(err)
+	s.Equal(float64(3), order.Items[0].Discount)
+	s.Equal(float64(2), order.Items[1].Discount)
+	s.Equal(float64(50), order.Subtotal)
+	s.Equal(float64(5), order.DiscountTotal)
+	s.Equal(float64(45), order.TotalPrice)
+	s.Require().NotNil(order.PromoCode)
+	s.Equal("SALE10", *order.PromoCode)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_promoExpired() {
+	ctx := context.Background()
+	validTo := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5, Name: "Engine"},
+	}, nil)
+	s.promos.On("Get", ctx, "OLD").Return(&model.PromoCode{
+		Code: "OLD", RuleType: model.PromoPercentage, Value: 10, Active: true, ValidTo: &validTo,
+	}, nil)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:  "user-1",
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		PromoCode: "OLD",
+	})
+	var promoErr *model.PromoError
+	s.Require().ErrorAs(err, &promoErr)
+	s.Equal(model.CodePromoExpired, promoErr.Code)
+	s.ErrorIs(err, model.ErrBadRequest)
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_promoNotFound() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5, Name: "Engine"},
+	}, nil)
+	s.promos.On("Get", ctx, "NOPE").Return(nil, model.ErrNotFound)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:  "user-1",
+		Items:     []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		PromoCode: "NOPE",
+	})
+	var promoErr *model.PromoError
+	s.Require().ErrorAs(err, &promoErr)
+	s.Equal(model.CodePromoNotFound, promoErr.Code)
+	s.NotErrorIs(err, model.ErrNotFound)
+}
+
+func (s *OrderServiceTest) TestModifyItems_reappliesPromo() {
+	ctx := context.Background()
+	code := "WINGS"
+
+	s.repo.On("Get", ctx, "order-1").Return(&model.Order{
+		OrderUUID: "order-1",
+		Status:    model.StatusPendingPayment,
+		PromoCode: &code,
+		Items:     []model.Item{{PartUUID: "wing-1", Quantity: 1, Price: 20, Category: "WING", Discount: 10}},
+	}, nil)
+	s.inv.On("ListParts", ctx, []string{"wing-1"}).Return([]*model.Part{
+		{UUID: "wing-1", Price: 20, Quantity: 5, Name: "Wing", Category: "WING"},
+	}, nil)
+	// Validity is not checked again for an order that already redeemed the code.
+	s.promos.On("Get", ctx, code).Return(&model.PromoCode{
+		Code: code, RuleType: model.PromoCategoryPercentage, Category: "WING", Value: 50,
+	}, nil)
+	s.repo.On("ReplaceItems", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := s.service.ModifyItems(ctx, "order-1", []model.ItemOperation{
+		{Op: model.ItemOpSetQuantity, PartUUID: "wing-1", Quantity: 3},
+	})
+	s.Require().NoError(err)
+	s.Equal(float64(30), order.Items[0].Discount)
+	s.Equal(float64(30), order.TotalPrice)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_taxes() {
+	ctx := context.Background()
+	taxes := mocks.NewTaxCalculator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithTaxes(taxes))
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine", Category: "ENGINE", ManufacturerCountry: "USA"},
+	}, nil)
+	taxes.On("Apply", "Germany", mock.AnythingOfType("[]model.Item")).Run(func(args mock.Arguments) {
+		items := args.Get(1).([]model.Item)
+		items[0].Duty = 8
+		items[0].Tax = 39.52
+	}).Return("Germany", nil)
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := svc.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:     "user-1",
+		Items:        []model.Item{{PartUUID: "engine-1", Quantity: 2}},
+		BuyerCountry: "Germany",
+	})
+	s.Require().NoError(err)
+	s.Equal("USA", order.Items[0].ManufacturerCountry)
+	s.Equal(float64(200), order.Subtotal)
+	s.Equal(39.52, order.TaxTotal)
+	s.Equal(float64(8), order.DutyTotal)
+	s.Equal(247.52, order.TotalPrice)
+	s.Require().NotNil(order.BuyerCountry)
+	s.Equal("Germany", *order.BuyerCountry)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_unknownBuyerCountry() {
+	ctx := context.Background()
+	taxes := mocks.NewTaxCalculator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithTaxes(taxes))
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+	taxes.On("Apply", "Mars", mock.Anything).Return("", fmt.Errorf("%w: no tax rates for country Mars", model.ErrBadRequest))
+
+	_, err := svc.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:     "user-1",
+		Items:        []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		BuyerCountry: "Mars",
+	})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_shipping() {
+	ctx := context.Background()
+	shipping := mocks.NewShippingCalculator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithShipping(shipping))
+	dims := &model.Dimensions{Length: 4, Width: 2, Height: 2, Weight: 1500}
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine", Dimensions: dims},
+	}, nil)
+	shipping.On("Quote", "Russia", mock.MatchedBy(func(items []model.Item) bool {
+		return len(items) == 1 && items[0].Dimensions == dims
+	})).Return(&model.ShippingQuotes{
+		ActualWeight: 1500,
+		Options: []model.ShippingQuote{
+			{Carrier: "POST", Option: "POST_STANDARD", Cost: 300},
+			{Carrier: "FREIGHT", Option: "FREIGHT_ROAD", Cost: 512.5},
+		},
+	}, nil)
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := svc.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:       "user-1",
+		Items:          []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		BuyerCountry:   "Russia",
+		ShippingOption: "freight_road",
+	})
+	s.Require().NoError(err)
+	s.Equal("FREIGHT", *order.ShippingCarrier)
+	s.Equal("FREIGHT_ROAD", *order.ShippingOption)
+	s.Equal(512.5, order.ShippingCost)
+	s.Equal(612.5, order.TotalPrice)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_shippingOptionUnavailable() {
+	ctx := context.Background()
+	shipping := mocks.NewShippingCalculator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithShipping(shipping))
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+	shipping.On("Quote", "Germany", mock.Anything).Return(&model.ShippingQuotes{
+		Options: []model.ShippingQuote{{Carrier: "POST", Option: "POST_STANDARD", Cost: 300}},
+	}, nil)
+
+	_, err := svc.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:       "user-1",
+		Items:          []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		BuyerCountry:   "Germany",
+		ShippingOption: "FREIGHT_ROAD",
+	})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_shippingDisabled() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:       "user-1",
+		Items:          []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		ShippingOption: "POST_STANDARD",
+	})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestQuoteShipping() {
+	ctx := context.Background()
+	shipping := mocks.NewShippingCalculator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithShipping(shipping))
+	quotes := &model.ShippingQuotes{ActualWeight: 3000}
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine", Dimensions: &model.Dimensions{Weight: 1500}},
+	}, nil)
+	shipping.On("Quote", "USA", mock.MatchedBy(func(items []model.Item) bool {
+		return len(items) == 1 && items[0].Quantity == 2
+	})).Return(quotes, nil)
+
+	res, err := svc.QuoteShipping(ctx, model.ShippingQuoteRequest{
+		Items:   []model.Item{{PartUUID: "engine-1", Quantity: 1}, {PartUUID: "engine-1", Quantity: 1}},
+		Address: model.Address{Country: "USA", City: "Boca Chica"},
+	})
+	s.Require().NoError(err)
+	s.Same(quotes, res)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_assembling() {
+	ctx := context.Background()
+	carrier := "POST"
+	address := &model.Address{Country: "Russia", City: "Moscow"}
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID:       "id-1",
+		Status:          model.StatusPaid,
+		ShippingCarrier: &carrier,
+		DeliveryAddress: address,
+	}, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(nil, model.ErrNotFound)
+	s.repo.On("SaveShipment", ctx, mock.AnythingOfType("*model.Order"), model.StatusChange{
+		From:   model.StatusPaid,
+		To:     model.StatusAssembling,
+		Actor:  "admin",
+		Reason: "shipment assembling",
+	}, mock.AnythingOfType("*model.Shipment"), model.ShipmentEvent{
+		Type:  model.ShipmentAssembling,
+		Actor: "admin",
+	}).Return(nil)
+
+	shipment, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:  model.ShipmentAssembling,
+		Actor: "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(model.StatusAssembling, shipment.Status)
+	s.Equal("POST", *shipment.Carrier)
+	s.Nil(shipment.TrackingNumber)
+	s.Equal(address, shipment.Address)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_shipped() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusAssembling}, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+	s.repo.On("SaveShipment", ctx, mock.AnythingOfType("*model.Order"), mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.From == model.StatusAssembling && c.To == model.StatusShipped
+	}), mock.AnythingOfType("*model.Shipment"), mock.AnythingOfType("model.ShipmentEvent")).Return(nil)
+
+	shipment, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:           model.ShipmentShipped,
+		Carrier:        "FREIGHT",
+		TrackingNumber: " TRK-1 ",
+		Actor:          "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(model.StatusShipped, shipment.Status)
+	s.Equal("FREIGHT", *shipment.Carrier)
+	s.Equal("TRK-1", *shipment.TrackingNumber)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_shippedWithoutTracking() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusAssembling}, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1"}, nil)
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{Type: model.ShipmentShipped, Carrier: "POST"})
+	s.ErrorIs(err, model.ErrBadRequest)
+	s.repo.AssertNotCalled(s.T(), "SaveShipment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_inTransitKeepsStatus() {
+	ctx := context.Background()
+	tracking := "TRK-1"
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusShipped}, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(&model.Shipment{OrderUUID: "id-1", TrackingNumber: &tracking}, nil)
+	s.repo.On("SaveShipment", ctx, mock.AnythingOfType("*model.Order"), mock.MatchedBy(func(c model.StatusChange) bool {
+		return c.From == model.StatusShipped && c.To == model.StatusShipped
+	}), mock.AnythingOfType("*model.Shipment"), model.ShipmentEvent{
+		Type:     model.ShipmentInTransit,
+		Location: "Baikonur",
+		Actor:    "admin",
+	}).Return(nil)
+
+	shipment, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{
+		Type:     model.ShipmentInTransit,
+		Location: "Baikonur",
+		Actor:    "admin",
+	})
+	s.Require().NoError(err)
+	s.Equal(model.StatusShipped, shipment.Status)
+	s.Equal("TRK-1", *shipment.TrackingNumber)
+}
+
+func (s *OrderServiceTest) TestRecordShipmentEvent_conflict() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusPendingPayment}, nil)
+
+	_, err := s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{Type: model.ShipmentAssembling})
+	s.ErrorIs(err, model.ErrConflict)
+
+	_, err = s.service.RecordShipmentEvent(ctx, "id-1", model.ShipmentUpdate{Type: "LOST"})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestGetShipment_notStarted() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusPaid}, nil)
+	s.repo.On("GetShipment", ctx, "id-1").Return(nil, model.ErrNotFound)
+
+	_, err := s.service.GetShipment(ctx, "id-1")
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_assemblingRefund() {
+	ctx := context.Background()
+
+	tId := "tId-1"
+	items := []model.Item{{PartUUID: "engine-1", Quantity: 2}}
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{
+		OrderUUID:       "id-1",
+		UserUUID:        "u-1",
+		Items:           items,
+		TransactionUUID: &tId,
+		Status:          model.StatusAssembling,
+	}, nil)
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), statusChange(model.StatusAssembling, model.StatusCancelling)).Return(nil)
+	s.pay.On("Refund", ctx, tId, "id-1", "u-1", mock.Anything).Return("refund-1", nil)
+	s.repo.On("Update", ctx, mock.AnythingOfType("*model.Order"), statusChange(model.StatusCancelling, model.StatusCancelled)).Return(nil)
+	s.inv.On("ReleaseStock", ctx, items).Return(nil)
+
+	res, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.Require().NoError(err)
+	s.Equal("refund-1", *res.RefundUUID)
+}
+
+func (s *OrderServiceTest) TestCancelOrder_shipped() {
+	ctx := context.Background()
+
+	s.repo.On("Get", ctx, "id-1").Return(&model.Order{OrderUUID: "id-1", Status: model.StatusShipped}, nil)
+
+	_, err := s.service.CancelOrder(ctx, "id-1", "u-1", "", "")
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_deliveryAddressWithoutCountry() {
+	ctx := context.Background()
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID:        "user-1",
+		Items:           []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		DeliveryAddress: &model.Address{City: "Moscow"},
+	})
+	s.ErrorIs(err, model.ErrBadRequest)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_fromCart() {
+	ctx := context.Background()
+	ref := &model.CartRef{UserUUID: "user-1", Version: 4}
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 100, Quantity: 5, Name: "Engine"},
+	}, nil)
+	s.repo.On("Create", ctx, mock.MatchedBy(func(o *model.Order) bool { return o.Cart == ref })).
+		Return(fmt.Errorf("%w: cart was changed during checkout", model.ErrConflict))
+
+	_, err := s.service.CreateOrder(ctx, model.OrderRequest{
+		UserUUID: "user-1",
+		Items:    []model.Item{{PartUUID: "engine-1", Quantity: 1}},
+		Cart:     ref,
+	})
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_incompatibleConfiguration() {
+	ctx := context.Background()
+	compat := mocks.NewCompatibilityValidator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithCompatibility(compat))
+
+	s.inv.On("ListParts", ctx, []string{"engine-1", "fuel-2"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+		{UUID: "fuel-2", Price: 1, Quantity: 5},
+	}, nil)
+	violation := model.ConfigurationViolation{
+		PartUUID:       "engine-1",
+		Rule:           model.RuleCompatibleWith,
+		TargetPartUUID: "fuel-2",
+		Message:        "part engine-1 is not compatible with fuel-2",
+	}
+	compat.On("ValidateConfiguration", ctx, mock.MatchedBy(func(items []model.Item) bool {
+		return len(items) == 2 && items[0].PartUUID == "engine-1" && items[1].PartUUID == "fuel-2"
+	})).Return([]model.ConfigurationViolation{violation}, nil)
+
+	_, err := svc.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 1},
+		{PartUUID: "fuel-2", Quantity: 1},
+	}})
+
+	s.ErrorIs(err, model.ErrBadRequest)
+	var configErr *model.ConfigurationError
+	s.Require().ErrorAs(err, &configErr)
+	s.Equal([]model.ConfigurationViolation{violation}, configErr.Violations)
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *OrderServiceTest) TestCreateOrder_compatibleConfiguration() {
+	ctx := context.Background()
+	compat := mocks.NewCompatibilityValidator(s.T())
+	svc := NewService(s.repo, s.inv, s.pay, WithCompatibility(compat))
+
+	s.inv.On("ListParts", ctx, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Price: 10, Quantity: 5},
+	}, nil)
+	compat.On("ValidateConfiguration", ctx, mock.Anything).Return(nil, nil)
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.Order")).Return(nil)
+
+	order, err := svc.CreateOrder(ctx, model.OrderRequest{UserUUID: "user-1", Items: []model.Item{
+		{PartUUID: "engine-1", Quantity: 2},
+	}})
+	s.Require().NoError(err)
+	s.Equal(float64(20), order.TotalPrice)
 }
@@ -0,0 +1,159 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"order-service/internal/repository/model"
+	"strings"
+)
+
+// RecordShipmentEvent moves a paid or authorized order through assembling,
+// shipping and delivery and appends the event to the tracking log of its
+// shipment. An authorized payment is captured before the order is shipped,
+// see captureForShipment for an authorization that has expired by then.
+// IN_TRANSIT events only add tracking checkpoints to a shipped order. Events
+// that do not fit the current order status are a conflict.
+func (s *Service) RecordShipmentEvent(ctx context.Context, orderID string, upd model.ShipmentUpdate) (*model.Shipment, error) {
+	to, ok := upd.Type.OrderStatus()
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown shipment event %q", model.ErrBadRequest, upd.Type)
+	}
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if upd.Type == model.ShipmentInTransit {
+		if order.Status != model.StatusShipped {
+			return nil, model.ErrConflict
+		}
+	} else if !model.CanTransition(order.Status, to) {
+		return nil, model.ErrConflict
+	}
+
+	shipment, err := s.repo.GetShipment(ctx, orderID)
+	if errors.Is(err, model.ErrNotFound) {
+		shipment = &model.Shipment{OrderUUID: orderID, Carrier: order.ShippingCarrier}
+	} else if err != nil {
+		return nil, err
+	}
+	if upd.Type == model.ShipmentShipped {
+		tracking := strings.TrimSpace(upd.TrackingNumber)
+		if tracking == "" {
+			return nil, fmt.Errorf("%w: tracking_number is required to ship an order", model.ErrBadRequest)
+		}
+		shipment.TrackingNumber = &tracking
+		if carrier := strings.TrimSpace(upd.Carrier); carrier != "" {
+			shipment.Carrier = &carrier
+		}
+		if shipment.Carrier == nil {
+			return nil, fmt.Errorf("%w: carrier is required to ship an order", model.ErrBadRequest)
+		}
+	}
+
+	// Two-step payments are captured when the parts leave the warehouse.
+	if upd.Type == model.ShipmentShipped && order.AwaitingCapture() {
+		if err := s.captureForShipment(ctx, order, upd.Actor); err != nil {
+			return nil, err
+		}
+	}
+
+	change := model.StatusChange{
+		From:   order.Status,
+		To:     to,
+		Actor:  upd.Actor,
+		Reason: "shipment " + strings.ToLower(string(upd.Type)),
+	}
+	event := model.ShipmentEvent{
+		Type:        upd.Type,
+		Location:    upd.Location,
+		Description: upd.Description,
+		Actor:       upd.Actor,
+	}
+	if err := s.repo.SaveShipment(ctx, order, change, shipment, event); err != nil {
+		return nil, err
+	}
+	order.Status = to
+	shipment.Status = to
+	shipment.Address = order.DeliveryAddress
+	return shipment, nil
+}
+
+// captureForShipment captures the authorized payment of an order about to
+// ship. An authorization that expired while the order was assembled is
+// authorized again and captured. If the payment provider refuses the new
+// authorization the order is cancelled, since it cannot ship unpaid, and an
+// error wrapping ErrAuthorizationExpired is returned. On any other failure
+// the order stays as it was and the shipment event can be retried.
+func (s *Service) captureForShipment(ctx context.Context, order *model.Order, actor string) error {
+	err := s.capture(ctx, order, 0, order.Status, actor)
+	if !errors.Is(err, model.ErrAuthorizationExpired) {
+		return err
+	}
+	// payment-service marks the old authorization expired, so it authorizes
+	// the order anew; a retry after a failed save gets the same one back.
+	auth, err := s.pay.Authorize(ctx, order.OrderUUID, order.UserUUID, *order.AuthorizedAmount, order.PaymentMethod)
+	if errors.Is(err, model.ErrPaymentRequired) {
+		return s.cancelUnpaid(ctx, order, actor, err)
+	}
+	if err != nil {
+		return fmt.Errorf("authorize expired payment again: %w", err)
+	}
+	order.TransactionUUID = &auth.TransactionUUID
+	order.AuthorizationExpiresAt = &auth.ExpiresAt
+	if err := s.repo.Update(ctx, order, model.StatusChange{From: order.Status, To: order.Status}); err != nil {
+		return err
+	}
+	return s.capture(ctx, order, 0, order.Status, actor)
+}
+
+// cancelUnpaid cancels an order whose expired authorization the payment
+// provider refused to renew. The expired hold has already been released, so
+// cancelling only returns the parts to stock. If the order is left
+// CANCELLING, CancelOrder or FinishCancellations finishes it.
+func (s *Service) cancelUnpaid(ctx context.Context, order *model.Order, actor string, refused error) error {
+	reason := model.CancelReasonOther
+	comment := "payment authorization expired and could not be renewed"
+	order.CancelReason = &reason
+	order.CancelComment = &comment
+	if err := s.transition(ctx, order, model.StatusCancelling, actor, "authorization expired"); err != nil {
+		return fmt.Errorf("cancel order %s after %v: %w", order.OrderUUID, refused, err)
+	}
+	if err := s.finishCancel(ctx, order, actor); err != nil {
+		return fmt.Errorf("cancel order %s after %v: %w", order.OrderUUID, refused, err)
+	}
+	return fmt.Errorf("%w: order %s cancelled: %v", model.ErrAuthorizationExpired, order.OrderUUID, refused)
+}
+
+// GetShipment returns the shipment of an order. ErrNotFound is returned for
+// orders that have not started assembling yet.
+func (s *Service) GetShipment(ctx context.Context, orderID string) (*model.Shipment, error) {
+	order, err := s.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	shipment, err := s.repo.GetShipment(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	shipment.Status = order.Status
+	shipment.Address = order.DeliveryAddress
+	return shipment, nil
+}
+
+// normalizeAddress trims the address fields. An address must name a country.
+func normalizeAddress(a *model.Address) (*model.Address, error) {
+	if a == nil {
+		return nil, nil
+	}
+	res := &model.Address{
+		Country:    strings.TrimSpace(a.Country),
+		City:       strings.TrimSpace(a.City),
+		PostalCode: strings.TrimSpace(a.PostalCode),
+		Street:     strings.TrimSpace(a.Street),
+	}
+	if res.Country == "" {
+		return nil, fmt.Errorf("%w: delivery_address.country is required", model.ErrBadRequest)
+	}
+	return res, nil
+}
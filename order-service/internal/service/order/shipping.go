@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"order-service/internal/repository/model"
+	"strings"
+)
+
+// QuoteShipping prices the delivery of the requested parts to an address
+// with every configured carrier.
+func (s *Service) QuoteShipping(ctx context.Context, req model.ShippingQuoteRequest) (*model.ShippingQuotes, error) {
+	if s.shipping == nil {
+		return nil, fmt.Errorf("%w: shipping is not available", model.ErrBadRequest)
+	}
+	merged, lines, err := normalizeItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.priceItems(ctx, merged, lines)
+	if err != nil {
+		return nil, err
+	}
+	return s.shipping.Quote(req.Address.Country, items)
+}
+
+// applyShipping prices the chosen delivery option for the order lines and
+// stores it on the order. The option must still be offered for the parcel.
+func (s *Service) applyShipping(order *model.Order, option, country string) error {
+	if s.shipping == nil {
+		return fmt.Errorf("%w: shipping is not available", model.ErrBadRequest)
+	}
+	quotes, err := s.shipping.Quote(country, order.Items)
+	if err != nil {
+		return err
+	}
+	for _, q := range quotes.Options {
+		if strings.EqualFold(q.Option, option) {
+			order.ShippingCarrier = &q.Carrier
+			order.ShippingOption = &q.Option
+			order.ShippingCost = q.Cost
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: shipping option %s is not available for this order", model.ErrBadRequest, option)
+}
+
+// shippingCountry is where the order is delivered to: the country of the
+// delivery address, or the buyer country for orders without one.
+func shippingCountry(order *model.Order) string {
+	if order.DeliveryAddress != nil {
+		return order.DeliveryAddress.Country
+	}
+	if order.BuyerCountry != nil {
+		return *order.BuyerCountry
+	}
+	return ""
+}
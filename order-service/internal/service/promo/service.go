@@ -0,0 +1,92 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"order-service/internal/repository"
+	"order-service/internal/repository/model"
+	"strings"
+)
+
+// Service manages the promo codes customers apply to their orders.
+type Service struct {
+	repo repository.PromoRepository
+}
+
+func NewService(repo repository.PromoRepository) *Service {
+	return &Service{repo: repo}
+}
+
+// CreatePromo checks the rule of a new promo code and stores it active and
+// unused. Usage counters and the creation time are set by the repository.
+func (s *Service) CreatePromo(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	promo.Code = strings.TrimSpace(promo.Code)
+	promo.Category = strings.TrimSpace(promo.Category)
+	promo.GetCategory = strings.TrimSpace(promo.GetCategory)
+	if err := validate(&promo); err != nil {
+		return nil, err
+	}
+	promo.UsedCount = 0
+	promo.Active = true
+	if err := s.repo.Create(ctx, &promo); err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+// DeactivatePromo stops code from being applied to new orders and returns
+// the deactivated promo code.
+func (s *Service) DeactivatePromo(ctx context.Context, code string) (*model.PromoCode, error) {
+	if err := s.repo.Deactivate(ctx, code); err != nil {
+		return nil, err
+	}
+	return s.repo.Get(ctx, code)
+}
+
+// ListPromos returns every promo code, inactive ones included.
+func (s *Service) ListPromos(ctx context.Context) ([]*model.PromoCode, error) {
+	return s.repo.List(ctx)
+}
+
+func validate(p *model.PromoCode) error {
+	if p.Code == "" {
+		return fmt.Errorf("%w: code is required", model.ErrBadRequest)
+	}
+	if p.Value <= 0 {
+		return fmt.Errorf("%w: value must be greater than 0", model.ErrBadRequest)
+	}
+
+	switch p.RuleType {
+	case model.PromoFixedAmount:
+	case model.PromoPercentage, model.PromoCategoryPercentage, model.PromoBuyXGetY:
+		if p.Value > 100 {
+			return fmt.Errorf("%w: %s value is a percentage and cannot exceed 100", model.ErrBadRequest, p.RuleType)
+		}
+	default:
+		return fmt.Errorf("%w: unknown rule type %q", model.ErrBadRequest, p.RuleType)
+	}
+	if p.RuleType == model.PromoCategoryPercentage && p.Category == "" {
+		return fmt.Errorf("%w: category is required for %s", model.ErrBadRequest, p.RuleType)
+	}
+	if p.RuleType == model.PromoBuyXGetY {
+		if p.Category == "" || p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return fmt.Errorf("%w: category, buy and reward quantities are required for %s", model.ErrBadRequest, p.RuleType)
+		}
+	} else if p.BuyQuantity != 0 || p.GetQuantity != 0 || p.GetCategory != "" {
+		return fmt.Errorf("%w: buy and reward fields only apply to %s", model.ErrBadRequest, model.PromoBuyXGetY)
+	}
+
+	if p.MinSubtotal < 0 {
+		return fmt.Errorf("%w: min_subtotal cannot be negative", model.ErrBadRequest)
+	}
+	if p.ValidFrom != nil && p.ValidTo != nil && !p.ValidTo.After(*p.ValidFrom) {
+		return fmt.Errorf("%w: valid_to must be after valid_from", model.ErrBadRequest)
+	}
+	if p.MaxUses != nil && *p.MaxUses <= 0 {
+		return fmt.Errorf("%w: max_uses must be greater than 0", model.ErrBadRequest)
+	}
+	if p.PerUserLimit != nil && *p.PerUserLimit <= 0 {
+		return fmt.Errorf("%w: per_user_limit must be greater than 0", model.ErrBadRequest)
+	}
+	return nil
+}
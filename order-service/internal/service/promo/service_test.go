@@ -0,0 +1,115 @@
+package promo
+
+import (
+	"context"
+	"order-service/internal/mocks"
+	"order-service/internal/repository/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromoServiceTest struct {
+	suite.Suite
+
+	repo *mocks.PromoRepository
+
+	service *Service
+}
+
+func (s *PromoServiceTest) SetupTest() {
+	s.repo = mocks.NewPromoRepository(s.T())
+	s.service = NewService(s.repo)
+}
+
+func TestPromoServiceTest(t *testing.T) {
+	suite.Run(t, new(PromoServiceTest))
+}
+
+func (s *PromoServiceTest) TestCreatePromo() {
+	ctx := context.Background()
+	created := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.PromoCode")).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.PromoCode).CreatedAt = created
+	}).Return(nil)
+
+	got, err := s.service.CreatePromo(ctx, model.PromoCode{
+		Code:        " WINGS2FOR1 ",
+		RuleType:    model.PromoBuyXGetY,
+		Value:       100,
+		Category:    "WING",
+		BuyQuantity: 2,
+		GetQuantity: 1,
+		UsedCount:   5,
+	})
+	s.Require().NoError(err)
+	s.Equal("WINGS2FOR1", got.Code)
+	s.True(got.Active)
+	s.Zero(got.UsedCount)
+	s.Equal(created, got.CreatedAt)
+}
+
+func (s *PromoServiceTest) TestCreatePromo_invalid() {
+	ctx := context.Background()
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	zero := 0
+
+	tests := []struct {
+		name  string
+		promo model.PromoCode
+	}{
+		{"no code", model.PromoCode{RuleType: model.PromoPercentage, Value: 10}},
+		{"unknown rule", model.PromoCode{Code: "X", RuleType: "GIFT", Value: 10}},
+		{"zero value", model.PromoCode{Code: "X", RuleType: model.PromoFixedAmount}},
+		{"percentage over 100", model.PromoCode{Code: "X", RuleType: model.PromoPercentage, Value: 101}},
+		{"category missing", model.PromoCode{Code: "X", RuleType: model.PromoCategoryPercentage, Value: 10}},
+		{"buy x get y without quantities", model.PromoCode{Code: "X", RuleType: model.PromoBuyXGetY, Value: 100, Category: "WING"}},
+		{"reward fields on a percentage", model.PromoCode{Code: "X", RuleType: model.PromoPercentage, Value: 10, GetQuantity: 1}},
+		{"negative min subtotal", model.PromoCode{Code: "X", RuleType: model.PromoFixedAmount, Value: 10, MinSubtotal: -1}},
+		{"ends before it starts", model.PromoCode{Code: "X", RuleType: model.PromoFixedAmount, Value: 10, ValidFrom: &from, ValidTo: &from}},
+		{"zero max uses", model.PromoCode{Code: "X", RuleType: model.PromoFixedAmount, Value: 10, MaxUses: &zero}},
+		{"zero per user limit", model.PromoCode{Code: "X", RuleType: model.PromoFixedAmount, Value: 10, PerUserLimit: &zero}},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreatePromo(ctx, tt.promo)
+			s.ErrorIs(err, model.ErrBadRequest)
+		})
+	}
+	s.repo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *PromoServiceTest) TestCreatePromo_taken() {
+	ctx := context.Background()
+	s.repo.On("Create", ctx, mock.AnythingOfType("*model.PromoCode")).Return(model.ErrConflict)
+
+	_, err := s.service.CreatePromo(ctx, model.PromoCode{Code: "TEN", RuleType: model.PromoPercentage, Value: 10})
+	s.ErrorIs(err, model.ErrConflict)
+}
+
+func (s *PromoServiceTest) TestDeactivatePromo() {
+	ctx := context.Background()
+	s.repo.On("Deactivate", ctx, "TEN").Return(nil)
+	s.repo.On("Get", ctx, "TEN").Return(&model.PromoCode{Code: "TEN", RuleType: model.PromoPercentage, Value: 10}, nil)
+	s.repo.On("Deactivate", ctx, "NONE").Return(model.ErrNotFound)
+
+	got, err := s.service.DeactivatePromo(ctx, "TEN")
+	s.Require().NoError(err)
+	s.False(got.Active)
+
+	_, err = s.service.DeactivatePromo(ctx, "NONE")
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *PromoServiceTest) TestListPromos() {
+	ctx := context.Background()
+	promos := []*model.PromoCode{{Code: "TEN"}, {Code: "OLD"}}
+	s.repo.On("List", ctx).Return(promos, nil)
+
+	got, err := s.service.ListPromos(ctx)
+	s.Require().NoError(err)
+	s.Equal(promos, got)
+}
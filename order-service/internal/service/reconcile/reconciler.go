@@ -0,0 +1,278 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"order-service/internal/repository"
+	"order-service/internal/repository/model"
+	"order-service/internal/service"
+	"time"
+)
+
+// Reconciler matches the paid orders against the transactions of
+// payment-service on order UUID, user UUID, transaction UUID and amount.
+type Reconciler struct {
+	orders   repository.OrderRepository
+	payments service.PaymentLedger
+	// fixer marks orders paid when the payment side says so; nil reports
+	// the mismatches only.
+	fixer service.OrderService
+	now   func() time.Time
+}
+
+func NewReconciler(orders repository.OrderRepository, payments service.PaymentLedger, fixer service.OrderService) *Reconciler {
+	return &Reconciler{orders: orders, payments: payments, fixer: fixer, now: time.Now}
+}
+
+// Run reconciles the orders paid in [from, to) with the payments made in
+// it. Payments that took money (PAID and REFUNDED) are matched; the
+// transaction of an order paid in the window is looked up even if it was
+// made before from, and the order of a payment even if it was paid after to,
+// so the edges of the window do not show up as mismatches.
+//
+// With fix, orders that missed a payment that went through for the right
+// amount are marked PAID. Other mismatches need a person and are only
+// reported.
+func (r *Reconciler) Run(ctx context.Context, from, to time.Time, fix bool) (*model.ReconciliationReport, error) {
+	orders, err := r.orders.ListPaid(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("list paid orders: %w", err)
+	}
+	all, err := r.payments.ListTransactions(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("list transactions: %w", err)
+	}
+	report := &model.ReconciliationReport{
+		From:          from,
+		To:            to,
+		GeneratedAt:   r.now(),
+		OrdersChecked: len(orders),
+	}
+
+	var settled []model.PaymentTransaction
+	byUUID := make(map[string]*model.PaymentTransaction)
+	byOrder := make(map[string][]*model.PaymentTransaction)
+	for i := range all {
+		if tookMoney(all[i].Status) {
+			settled = append(settled, all[i])
+		}
+	}
+	for i := range settled {
+		tx := &settled[i]
+		byUUID[tx.TransactionUUID] = tx
+		byOrder[tx.OrderUUID] = append(byOrder[tx.OrderUUID], tx)
+	}
+	report.TransactionsChecked = len(settled)
+
+	seen := make(map[string]bool)
+	checked := make(map[string]bool)
+	for _, o := range orders {
+		checked[o.OrderUUID] = true
+		found, err := r.checkOrder(ctx, o, byUUID, byOrder[o.OrderUUID], seen)
+		if err != nil {
+			return nil, err
+		}
+		r.add(report, found)
+	}
+
+	for i := range settled {
+		tx := &settled[i]
+		if seen[tx.TransactionUUID] || checked[tx.OrderUUID] {
+			continue
+		}
+		o, err := r.orders.Get(ctx, tx.OrderUUID)
+		if errors.Is(err, model.ErrNotFound) {
+			seen[tx.TransactionUUID] = true
+			r.add(report, []model.Mismatch{{
+				Kind:            model.MismatchMissingOrder,
+				OrderUUID:       tx.OrderUUID,
+				TransactionUUID: tx.TransactionUUID,
+				PaymentStatus:   tx.Status,
+				PaymentAmount:   tx.CapturedAmount,
+				Detail:          "payment of an order that does not exist",
+			}})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get order %s: %w", tx.OrderUUID, err)
+		}
+		checked[o.OrderUUID] = true
+		var found []model.Mismatch
+		if o.PaidAt != nil {
+			found, err = r.checkOrder(ctx, o, byUUID, byOrder[o.OrderUUID], seen)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			found = r.checkUnpaid(o, byOrder[o.OrderUUID], seen)
+		}
+		r.add(report, found)
+	}
+
+	if fix && r.fixer != nil {
+		r.fix(ctx, report, byUUID)
+	}
+	return report, nil
+}
+
+// add records the mismatches of one order; an order without any is matched.
+func (r *Reconciler) add(report *model.ReconciliationReport, found []model.Mismatch) {
+	if len(found) == 0 {
+		report.Matched++
+		return
+	}
+	report.Mismatches = append(report.Mismatches, found...)
+}
+
+// checkOrder compares a paid order with its transaction and reports the
+// other payments of the order as duplicates.
+func (r *Reconciler) checkOrder(ctx context.Context, o *model.Order, byUUID map[string]*model.PaymentTransaction, charges []*model.PaymentTransaction, seen map[string]bool) ([]model.Mismatch, error) {
+	m := model.Mismatch{OrderUUID: o.OrderUUID, OrderStatus: o.Status, OrderAmount: orderAmount(o)}
+	if o.TransactionUUID == nil {
+		m.Kind = model.MismatchMissingPayment
+		m.Detail = "paid order has no transaction"
+		return append([]model.Mismatch{m}, duplicates(o, charges, "", seen)...), nil
+	}
+	m.TransactionUUID = *o.TransactionUUID
+
+	tx, ok := byUUID[*o.TransactionUUID]
+	if !ok {
+		var err error
+		tx, err = r.payments.Transaction(ctx, *o.TransactionUUID)
+		if errors.Is(err, model.ErrNotFound) {
+			m.Kind = model.MismatchMissingPayment
+			m.Detail = "payment-service does not know the transaction"
+			return append([]model.Mismatch{m}, duplicates(o, charges, "", seen)...), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get transaction %s: %w", *o.TransactionUUID, err)
+		}
+	}
+	seen[tx.TransactionUUID] = true
+	m.PaymentStatus = tx.Status
+	m.PaymentAmount = tx.CapturedAmount
+
+	var found []model.Mismatch
+	switch want := expectedStatus(o); {
+	case tx.OrderUUID != o.OrderUUID:
+		m.Kind = model.MismatchMissingPayment
+		m.Detail = fmt.Sprintf("transaction belongs to order %s", tx.OrderUUID)
+		found = append(found, m)
+	case tx.UserUUID != o.UserUUID:
+		m.Kind = model.MismatchMissingPayment
+		m.Detail = fmt.Sprintf("transaction was paid by user %s", tx.UserUUID)
+		found = append(found, m)
+	case tx.Status != want:
+		m.Kind = model.MismatchStatusDiffers
+		m.Detail = fmt.Sprintf("order is %s, so the payment should be %s", o.Status, want)
+		found = append(found, m)
+	case !sameAmount(tx.CapturedAmount, m.OrderAmount):
+		m.Kind = model.MismatchAmountDiffers
+		m.Detail = fmt.Sprintf("order amount %.2f, payment %.2f", m.OrderAmount, tx.CapturedAmount)
+		found = append(found, m)
+	}
+	return append(found, duplicates(o, charges, tx.TransactionUUID, seen)...), nil
+}
+
+// checkUnpaid reports the payments of an order that is not paid.
+func (r *Reconciler) checkUnpaid(o *model.Order, charges []*model.PaymentTransaction, seen map[string]bool) []model.Mismatch {
+	var found []model.Mismatch
+	for _, tx := range charges {
+		seen[tx.TransactionUUID] = true
+		if tx.Status != model.TransactionPaid {
+			continue
+		}
+		found = append(found, model.Mismatch{
+			Kind:            model.MismatchStatusDiffers,
+			OrderUUID:       o.OrderUUID,
+			TransactionUUID: tx.TransactionUUID,
+			OrderStatus:     o.Status,
+			PaymentStatus:   tx.Status,
+			OrderAmount:     o.TotalPrice,
+			PaymentAmount:   tx.CapturedAmount,
+			Detail:          fmt.Sprintf("payment went through but the order is %s", o.Status),
+		})
+	}
+	return found
+}
+
+// duplicates reports the payments of o other than its transaction that
+// still hold the customer's money.
+func duplicates(o *model.Order, charges []*model.PaymentTransaction, txUUID string, seen map[string]bool) []model.Mismatch {
+	var found []model.Mismatch
+	for _, tx := range charges {
+		if tx.TransactionUUID == txUUID {
+			continue
+		}
+		seen[tx.TransactionUUID] = true
+		if tx.Status != model.TransactionPaid {
+			continue
+		}
+		found = append(found, model.Mismatch{
+			Kind:            model.MismatchDuplicate,
+			OrderUUID:       o.OrderUUID,
+			TransactionUUID: tx.TransactionUUID,
+			OrderStatus:     o.Status,
+			PaymentStatus:   tx.Status,
+			OrderAmount:     orderAmount(o),
+			PaymentAmount:   tx.CapturedAmount,
+			Detail:          "order is paid by another transaction",
+		})
+	}
+	return found
+}
+
+// fix marks PAID the orders that missed a payment of the right amount.
+func (r *Reconciler) fix(ctx context.Context, report *model.ReconciliationReport, byUUID map[string]*model.PaymentTransaction) {
+	for i := range report.Mismatches {
+		m := &report.Mismatches[i]
+		fixable := m.Kind == model.MismatchStatusDiffers &&
+			m.PaymentStatus == model.TransactionPaid &&
+			(m.OrderStatus == model.StatusPendingPayment || m.OrderStatus == model.StatusPaymentPending)
+		if !fixable {
+			continue
+		}
+		tx, ok := byUUID[m.TransactionUUID]
+		if !ok {
+			continue
+		}
+		if !sameAmount(m.OrderAmount, m.PaymentAmount) {
+			m.Detail += "; not fixed: amounts differ"
+			continue
+		}
+		o, err := r.fixer.RecordPayment(ctx, m.OrderUUID, *tx)
+		if err != nil {
+			m.Detail += "; fix failed: " + err.Error()
+			continue
+		}
+		m.Fixed = true
+		m.Detail += fmt.Sprintf("; order marked %s", o.Status)
+	}
+}
+
+func tookMoney(status string) bool {
+	return status == model.TransactionPaid || status == model.TransactionRefunded
+}
+
+// expectedStatus is the status the payment of a paid order should have.
+func expectedStatus(o *model.Order) string {
+	if o.RefundUUID != nil {
+		return model.TransactionRefunded
+	}
+	return model.TransactionPaid
+}
+
+// orderAmount is what the order was charged: the captured part of a
+// two-step payment, the total otherwise.
+func orderAmount(o *model.Order) float64 {
+	if o.CapturedAmount != nil {
+		return *o.CapturedAmount
+	}
+	return o.TotalPrice
+}
+
+func sameAmount(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
@@ -0,0 +1,200 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"order-service/internal/mocks"
+	"order-service/internal/repository/model"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconcilerTest struct {
+	suite.Suite
+
+	ctx      context.Context
+	orders   *mocks.OrderRepository
+	payments *mocks.PaymentLedger
+	fixer    *mocks.OrderService
+	r        *Reconciler
+	from, to time.Time
+}
+
+func (s *ReconcilerTest) SetupTest() {
+	s.ctx = context.Background()
+	s.orders = mocks.NewOrderRepository(s.T())
+	s.payments = mocks.NewPaymentLedger(s.T())
+	s.fixer = mocks.NewOrderService(s.T())
+	s.r = NewReconciler(s.orders, s.payments, s.fixer)
+	s.to = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	s.from = s.to.Add(-24 * time.Hour)
+	s.r.now = func() time.Time { return s.to }
+}
+
+func TestReconcilerTest(t *testing.T) {
+	suite.Run(t, new(ReconcilerTest))
+}
+
+const (
+	userID      = "4128849a-5e0a-41a5-8f44-8fb5bcc69087"
+	otherUserID = "23603910-d57a-47b1-8b5d-b073580f144e"
+
+	orderOK          = "497032f3-1709-4201-b27e-191614f25758"
+	orderWrongAmount = "2275078f-81d4-463e-8b7c-1d187596c558"
+	orderWrongStatus = "6da9e0ce-c4dc-4669-aa99-828dad62e408"
+	orderDup         = "4b1ffd08-4861-41d6-b95c-eef545cf0a12"
+	orderNoTx        = "c3b2efa8-d963-465f-9075-1fad2810c95a"
+	orderUnknown     = "19d2f9d5-ee09-4e3a-beef-77fa477e6f15"
+	orderRefunded    = "54a2a62e-2192-48b6-899a-ab124187b582"
+	orderEarlier     = "a752a8b1-c798-4c16-ae38-9f106efdf830"
+	orderGone        = "a996f604-0c83-4d11-b3ef-9f0b96e23959"
+	orderSwapped     = "c7d3f028-1f8f-4c83-8c2a-93fbd6cb327f"
+	orderOtherUser   = "0a658c09-5283-47a7-842a-38d70163cc78"
+
+	txOK          = "c417f7e9-82c1-40bc-8a7d-f6afee3d651e"
+	txWrongAmount = "196e9bd7-2ad2-452e-80d6-574e669a3a8b"
+	txWrongStatus = "4a3bc71d-b758-4369-81a6-1c3d8f4bd17c"
+	txDup1        = "0ac3c906-e6f0-4e7a-a365-abc29b1dbd2b"
+	txDup2        = "a9a05533-7454-475c-af3c-b84fa2c9313d"
+	txUnknown     = "1340ef06-da3d-4a51-8a8c-5e41294b465c"
+	txRefunded    = "8f725449-1849-4a97-b600-4cd27c1c3f37"
+	txEarlier     = "32faba14-b026-4c14-8bd3-8258a26a6936"
+	txOrphan      = "4da8b0f9-096b-41f4-936f-be16b38dd938"
+	txFailed      = "b46ab12b-2aae-417d-a045-c5059ccd6b18"
+	txSwapped     = "6a6d2097-9b73-40dc-999f-5707fa14e4df"
+	txOtherUser   = "1a67a3f8-d6bf-4167-b5ae-5a55a2f33006"
+)
+
+func paidOrder(id, tx string, total float64) *model.Order {
+	paidAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	o := &model.Order{OrderUUID: id, UserUUID: userID, Status: model.StatusPaid, TotalPrice: total, PaidAt: &paidAt}
+	if tx != "" {
+		o.TransactionUUID = &tx
+	}
+	return o
+}
+
+func payment(id, order, status string, amount float64) model.PaymentTransaction {
+	return model.PaymentTransaction{TransactionUUID: id, OrderUUID: order, UserUUID: userID, Status: status, Amount: amount, CapturedAmount: amount, Method: model.PaymentCard}
+}
+
+func (s *ReconcilerTest) kinds(report *model.ReconciliationReport) map[string]model.MismatchKind {
+	kinds := make(map[string]model.MismatchKind)
+	for _, m := range report.Mismatches {
+		kinds[m.OrderUUID+"/"+m.TransactionUUID] = m.Kind
+	}
+	return kinds
+}
+
+func (s *ReconcilerTest) TestRun() {
+	refunded := paidOrder(orderRefunded, txRefunded, 50)
+	refundID := "81c27816-e3e2-4850-8e61-0e305b889b61"
+	refunded.RefundUUID = &refundID
+	refunded.Status = model.StatusCancelled
+	earlier := paidOrder(orderEarlier, txEarlier, 70)
+	// A payment made with the order and user UUIDs the wrong way round.
+	swapped := payment(txSwapped, userID, model.TransactionPaid, 100)
+	swapped.UserUUID = orderSwapped
+	otherUser := payment(txOtherUser, orderOtherUser, model.TransactionPaid, 100)
+	otherUser.UserUUID = otherUserID
+
+	s.orders.On("ListPaid", s.ctx, s.from, s.to).Return([]*model.Order{
+		paidOrder(orderOK, txOK, 100),
+		paidOrder(orderWrongAmount, txWrongAmount, 100),
+		paidOrder(orderWrongStatus, txWrongStatus, 100),
+		paidOrder(orderDup, txDup1, 100),
+		paidOrder(orderNoTx, "", 100),
+		paidOrder(orderUnknown, txUnknown, 100),
+		paidOrder(orderSwapped, txSwapped, 100),
+		paidOrder(orderOtherUser, txOtherUser, 100),
+		refunded,
+		earlier,
+	}, nil)
+	s.payments.On("ListTransactions", s.ctx, s.from, s.to).Return([]model.PaymentTransaction{
+		payment(txOK, orderOK, model.TransactionPaid, 100),
+		payment(txWrongAmount, orderWrongAmount, model.TransactionPaid, 90),
+		payment(txWrongStatus, orderWrongStatus, model.TransactionRefunded, 100),
+		payment(txDup1, orderDup, model.TransactionPaid, 100),
+		payment(txDup2, orderDup, model.TransactionPaid, 100),
+		payment(txRefunded, orderRefunded, model.TransactionRefunded, 50),
+		payment(txOrphan, orderGone, model.TransactionPaid, 10),
+		payment(txFailed, orderOK, "FAILED", 100),
+		swapped,
+		otherUser,
+	}, nil)
+	// The transaction of an order paid in the window may be older than it.
+	earlierTx := payment(txEarlier, orderEarlier, model.TransactionPaid, 70)
+	s.payments.On("Transaction", s.ctx, txEarlier).Return(&earlierTx, nil)
+	s.payments.On("Transaction", s.ctx, txUnknown).Return(nil, model.ErrNotFound)
+	s.orders.On("Get", s.ctx, orderGone).Return(nil, model.ErrNotFound)
+
+	report, err := s.r.Run(s.ctx, s.from, s.to, false)
+	s.Require().NoError(err)
+	s.Equal(10, report.OrdersChecked)
+	s.Equal(9, report.TransactionsChecked)
+	s.Equal(3, report.Matched)
+	s.Equal(map[string]model.MismatchKind{
+		orderWrongAmount + "/" + txWrongAmount: model.MismatchAmountDiffers,
+		orderWrongStatus + "/" + txWrongStatus: model.MismatchStatusDiffers,
+		orderDup + "/" + txDup2:                model.MismatchDuplicate,
+		orderNoTx + "/":                        model.MismatchMissingPayment,
+		orderUnknown + "/" + txUnknown:         model.MismatchMissingPayment,
+		orderSwapped + "/" + txSwapped:         model.MismatchMissingPayment,
+		orderOtherUser + "/" + txOtherUser:     model.MismatchMissingPayment,
+		orderGone + "/" + txOrphan:             model.MismatchMissingOrder,
+	}, s.kinds(report))
+	s.fixer.AssertNotCalled(s.T(), "RecordPayment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ReconcilerTest) TestRun_fixesMissedPayment() {
+	const orderMissed, orderShort = "e2a8f3b1-6c4d-4f0e-9a7b-3d5c1e8f2a60", "5b9d0c7e-1a2f-4e3b-8c6d-7f4a2b1e9d03"
+	const txMissed, txShort = "9c1e4a7d-2b3f-4d6e-a8c0-5f7b3e2d1a94", "d3f6b2a9-8e1c-4a5d-b7f0-2c9e6a4d8b15"
+	missed := payment(txMissed, orderMissed, model.TransactionPaid, 100)
+	short := payment(txShort, orderShort, model.TransactionPaid, 80)
+	s.orders.On("ListPaid", s.ctx, s.from, s.to).Return(nil, nil)
+	s.payments.On("ListTransactions", s.ctx, s.from, s.to).Return([]model.PaymentTransaction{missed, short}, nil)
+	s.orders.On("Get", s.ctx, orderMissed).Return(&model.Order{OrderUUID: orderMissed, UserUUID: userID, Status: model.StatusPendingPayment, TotalPrice: 100}, nil)
+	s.orders.On("Get", s.ctx, orderShort).Return(&model.Order{OrderUUID: orderShort, UserUUID: userID, Status: model.StatusPendingPayment, TotalPrice: 100}, nil)
+	s.fixer.On("RecordPayment", s.ctx, orderMissed, missed).Return(&model.Order{OrderUUID: orderMissed, Status: model.StatusPaid}, nil)
+
+	report, err := s.r.Run(s.ctx, s.from, s.to, true)
+	s.Require().NoError(err)
+	s.Require().Len(report.Mismatches, 2)
+	s.Equal(model.MismatchStatusDiffers, report.Mismatches[0].Kind)
+	s.True(report.Mismatches[0].Fixed)
+	s.Contains(report.Mismatches[0].Detail, "order marked PAID")
+	s.False(report.Mismatches[1].Fixed)
+	s.Contains(report.Mismatches[1].Detail, "not fixed: amounts differ")
+}
+
+func (s *ReconcilerTest) TestWriteReport() {
+	report := &model.ReconciliationReport{From: s.from, To: s.to, Mismatches: []model.Mismatch{{
+		Kind:            model.MismatchAmountDiffers,
+		OrderUUID:       "o-1",
+		TransactionUUID: "t-1",
+		OrderStatus:     model.StatusPaid,
+		PaymentStatus:   model.TransactionPaid,
+		OrderAmount:     100,
+		PaymentAmount:   90.5,
+		Detail:          "order amount 100.00, payment 90.50",
+	}}}
+
+	var csvOut bytes.Buffer
+	s.Require().NoError(WriteCSV(&csvOut, report))
+	lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	s.Require().Len(lines, 2)
+	s.Equal("kind,order_uuid,transaction_uuid,order_status,payment_status,order_amount,payment_amount,detail,fixed", lines[0])
+	s.Equal(`AMOUNT_DIFFERS,o-1,t-1,PAID,PAID,100.00,90.50,"order amount 100.00, payment 90.50",false`, lines[1])
+
+	var jsonOut bytes.Buffer
+	s.Require().NoError(WriteJSON(&jsonOut, report))
+	var decoded model.ReconciliationReport
+	s.Require().NoError(json.Unmarshal(jsonOut.Bytes(), &decoded))
+	s.Equal(report.Mismatches, decoded.Mismatches)
+	s.True(decoded.To.Equal(s.to))
+}
@@ -0,0 +1,51 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"order-service/internal/repository/model"
+	"strconv"
+)
+
+// WriteJSON writes the whole report, summary included.
+func WriteJSON(w io.Writer, report *model.ReconciliationReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+var csvHeader = []string{
+	"kind", "order_uuid", "transaction_uuid", "order_status", "payment_status",
+	"order_amount", "payment_amount", "detail", "fixed",
+}
+
+// WriteCSV writes one row per mismatch.
+func WriteCSV(w io.Writer, report *model.ReconciliationReport) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, m := range report.Mismatches {
+		err := cw.Write([]string{
+			string(m.Kind),
+			m.OrderUUID,
+			m.TransactionUUID,
+			string(m.OrderStatus),
+			m.PaymentStatus,
+			amount(m.OrderAmount),
+			amount(m.PaymentAmount),
+			m.Detail,
+			strconv.FormatBool(m.Fixed),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func amount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
@@ -3,19 +3,66 @@ package service
 import (
 	"context"
 	"order-service/internal/repository/model"
+	"time"
 )
 
 type InventoryService interface {
 	ListParts(ctx context.Context, partIDs []string) ([]*model.Part, error)
+	ReserveStock(ctx context.Context, items []model.Item) error
+	ReleaseStock(ctx context.Context, items []model.Item) error
 }
 
 type PaymentService interface {
-	MakePayment(ctx context.Context, orderID, userID string, pm *model.PaymentMethod) (string, error)
+	// MakePayment charges the order. The payment may be left pending, its
+	// outcome is then read with PaymentStatus. A repeat with the same
+	// idempotencyKey returns the earlier payment instead of charging again.
+	MakePayment(ctx context.Context, orderID, userID string, amount float64, pm *model.PaymentMethod, idempotencyKey string) (*model.Payment, error)
+	PaymentStatus(ctx context.Context, transactionID string) (*model.Payment, error)
+	Refund(ctx context.Context, transactionID, orderID, userID, reason string) (string, error)
+	Authorize(ctx context.Context, orderID, userID string, amount float64, pm *model.PaymentMethod) (*model.Authorization, error)
+	// Capture takes amount of an authorization and returns the captured
+	// amount; amount 0 captures all of it.
+	Capture(ctx context.Context, transactionID string, amount float64) (float64, error)
+	// Void releases an authorization, or expires a payment that is still
+	// pending so that it cannot be confirmed anymore.
+	Void(ctx context.Context, transactionID, reason string) error
+}
+
+// PaymentLedger reads the transactions payment-service recorded.
+type PaymentLedger interface {
+	// ListTransactions returns the transactions created in [from, to).
+	ListTransactions(ctx context.Context, from, to time.Time) ([]model.PaymentTransaction, error)
+	// Transaction returns model.ErrNotFound for an unknown transaction.
+	Transaction(ctx context.Context, transactionID string) (*model.PaymentTransaction, error)
 }
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, userID string, partIDs []string) (*model.Order, error)
+	CreateOrder(ctx context.Context, req model.OrderRequest) (*model.Order, error)
 	GetOrder(ctx context.Context, orderID string) (*model.Order, error)
-	PayOrder(ctx context.Context, orderID string, pm *model.PaymentMethod) (string, error)
-	CancelOrder(ctx context.Context, orderID string) error
+	GetOrderHistory(ctx context.Context, orderID string) (*model.OrderHistory, error)
+	ModifyItems(ctx context.Context, orderID string, ops []model.ItemOperation) (*model.Order, error)
+	PayOrder(ctx context.Context, orderID, actor string, pm *model.PaymentMethod) (*model.Payment, error)
+	SyncPayment(ctx context.Context, orderID string) (*model.Order, error)
+	AuthorizeOrder(ctx context.Context, orderID, actor string, pm *model.PaymentMethod) (*model.Order, error)
+	CaptureOrder(ctx context.Context, orderID, actor string, amount float64) (*model.Order, error)
+	CancelOrder(ctx context.Context, orderID, actor string, reason model.CancelReason, comment string) (*model.Order, error)
+	// RecordPayment marks the order PAID with a payment that went through
+	// although the order missed it.
+	RecordPayment(ctx context.Context, orderID string, tx model.PaymentTransaction) (*model.Order, error)
+}
+
+// TaxCalculator sets the tax and import duty of order lines for a buyer
+// country and returns the country the rates were taken from.
+type TaxCalculator interface {
+	Apply(country string, items []model.Item) (string, error)
+}
+
+// ShippingCalculator quotes delivery options of order lines to a country.
+type ShippingCalculator interface {
+	Quote(country string, items []model.Item) (*model.ShippingQuotes, error)
+}
+
+// CompatibilityValidator checks that the parts of an order fit together.
+type CompatibilityValidator interface {
+	ValidateConfiguration(ctx context.Context, items []model.Item) ([]model.ConfigurationViolation, error)
 }
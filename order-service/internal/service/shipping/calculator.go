@@ -0,0 +1,178 @@
+package shipping
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"order-service/internal/repository/model"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Carrier is a rate table of one carrier, loaded from a JSON file.
+type Carrier struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// VolumetricFactor converts the parcel volume in cubic metres into
+	// volumetric weight in kilograms.
+	VolumetricFactor float64  `json:"volumetric_factor"`
+	Options          []Option `json:"options"`
+}
+
+// Option is a delivery service of a carrier. Its code must be unique over all
+// carriers, it is what customers choose when ordering.
+type Option struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	DaysMin int    `json:"days_min"`
+	DaysMax int    `json:"days_max"`
+	Rates   []Rate `json:"rates"`
+}
+
+// Rate prices the option for the listed destination countries. A rate without
+// countries applies everywhere else. The cost is Base plus PerKg for every
+// started kilogram of chargeable weight; parcels heavier than MaxWeight are
+// not accepted, zero means no limit.
+type Rate struct {
+	Countries []string `json:"countries"`
+	Base      float64  `json:"base"`
+	PerKg     float64  `json:"per_kg"`
+	MaxWeight float64  `json:"max_weight"`
+}
+
+// LoadCarriers reads every *.json file in dir as a Carrier. The tables are
+// checked by NewCalculator.
+func LoadCarriers(dir string) ([]Carrier, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	carriers := make([]Carrier, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var c Carrier
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("parse carrier %s: %w", file, err)
+		}
+		carriers = append(carriers, c)
+	}
+	return carriers, nil
+}
+
+func validate(carriers []Carrier) error {
+	seen := make(map[string]string)
+	for _, c := range carriers {
+		if c.Code == "" {
+			return fmt.Errorf("carrier %q has no code", c.Name)
+		}
+		if c.VolumetricFactor < 0 {
+			return fmt.Errorf("carrier %s: volumetric_factor must not be negative", c.Code)
+		}
+		for _, o := range c.Options {
+			if o.Code == "" {
+				return fmt.Errorf("carrier %s: option %q has no code", c.Code, o.Name)
+			}
+			if other, ok := seen[o.Code]; ok {
+				return fmt.Errorf("option %s is defined by carriers %s and %s", o.Code, other, c.Code)
+			}
+			seen[o.Code] = c.Code
+		}
+	}
+	return nil
+}
+
+// Calculator quotes delivery options for order lines.
+type Calculator struct {
+	carriers []Carrier
+}
+
+func NewCalculator(carriers []Carrier) (*Calculator, error) {
+	if err := validate(carriers); err != nil {
+		return nil, err
+	}
+	return &Calculator{carriers: carriers}, nil
+}
+
+// Quote weighs the items and prices every option that delivers to country.
+// Items without dimensions count as weightless. Options are sorted by cost.
+func (c *Calculator) Quote(country string, items []model.Item) (*model.ShippingQuotes, error) {
+	country = normalize(country)
+	if country == "" {
+		return nil, fmt.Errorf("%w: destination country is required", model.ErrBadRequest)
+	}
+
+	var actual, volume float64
+	for _, v := range items {
+		if v.Dimensions == nil {
+			continue
+		}
+		actual += v.Dimensions.Weight * float64(v.Quantity)
+		volume += v.Dimensions.Volume() * float64(v.Quantity)
+	}
+
+	res := &model.ShippingQuotes{
+		ActualWeight: roundWeight(actual),
+		Volume:       roundWeight(volume),
+	}
+	for _, carrier := range c.carriers {
+		volumetric := volume * carrier.VolumetricFactor
+		chargeable := max(actual, volumetric)
+
+		for _, option := range carrier.Options {
+			rate, ok := rateFor(option.Rates, country)
+			if !ok || (rate.MaxWeight > 0 && chargeable > rate.MaxWeight) {
+				continue
+			}
+			res.Options = append(res.Options, model.ShippingQuote{
+				Carrier:          carrier.Code,
+				Option:           option.Code,
+				Name:             option.Name,
+				Cost:             model.RoundMoney(rate.Base + rate.PerKg*math.Ceil(chargeable)),
+				DaysMin:          option.DaysMin,
+				DaysMax:          option.DaysMax,
+				VolumetricWeight: roundWeight(volumetric),
+				ChargeableWeight: roundWeight(chargeable),
+			})
+		}
+	}
+	sort.SliceStable(res.Options, func(i, j int) bool { return res.Options[i].Cost < res.Options[j].Cost })
+	return res, nil
+}
+
+// rateFor picks the rate listing country, falling back to a rate without
+// countries.
+func rateFor(rates []Rate, country string) (Rate, bool) {
+	var fallback *Rate
+	for i, r := range rates {
+		if len(r.Countries) == 0 {
+			if fallback == nil {
+				fallback = &rates[i]
+			}
+			continue
+		}
+		for _, c := range r.Countries {
+			if normalize(c) == country {
+				return r, true
+			}
+		}
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return Rate{}, false
+}
+
+func roundWeight(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+func normalize(s string) string {
+	return strings.ToUpper(strings.TrimSpace(s))
+}
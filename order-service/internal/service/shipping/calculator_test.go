@@ -0,0 +1,106 @@
+package shipping
+
+import (
+	"order-service/internal/repository/model"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCarriers() []Carrier {
+	return []Carrier{
+		{
+			Code:             "POST",
+			VolumetricFactor: 200,
+			Options: []Option{
+				{
+					Code: "POST_STANDARD",
+					Name: "Standard",
+					Rates: []Rate{
+						{Countries: []string{"Russia"}, Base: 100, PerKg: 10, MaxWeight: 50},
+						{Base: 300, PerKg: 20},
+					},
+				},
+			},
+		},
+		{
+			Code:             "FREIGHT",
+			VolumetricFactor: 100,
+			Options: []Option{
+				{Code: "FREIGHT_ROAD", Name: "Road", Rates: []Rate{{Countries: []string{"Russia"}, Base: 500, PerKg: 1}}},
+			},
+		},
+	}
+}
+
+func TestQuote(t *testing.T) {
+	calc, err := NewCalculator(testCarriers())
+	require.NoError(t, err)
+
+	// 0.1 m³ and 12 kg: 20 kg volumetric for POST, 10 kg for FREIGHT
+	items := []model.Item{
+		{Quantity: 2, Dimensions: &model.Dimensions{Length: 0.5, Width: 0.2, Height: 0.5, Weight: 6}},
+		{Quantity: 1},
+	}
+
+	quotes, err := calc.Quote("russia", items)
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, quotes.ActualWeight)
+	assert.Equal(t, 0.1, quotes.Volume)
+	require.Len(t, quotes.Options, 2)
+
+	assert.Equal(t, "POST_STANDARD", quotes.Options[0].Option)
+	assert.Equal(t, 20.0, quotes.Options[0].VolumetricWeight)
+	assert.Equal(t, 20.0, quotes.Options[0].ChargeableWeight)
+	assert.Equal(t, 300.0, quotes.Options[0].Cost)
+
+	assert.Equal(t, "FREIGHT_ROAD", quotes.Options[1].Option)
+	assert.Equal(t, 12.0, quotes.Options[1].ChargeableWeight)
+	assert.Equal(t, 512.0, quotes.Options[1].Cost)
+}
+
+func TestQuote_fallbackRateAndWeightLimit(t *testing.T) {
+	calc, err := NewCalculator(testCarriers())
+	require.NoError(t, err)
+
+	quotes, err := calc.Quote("Germany", []model.Item{{Quantity: 1, Dimensions: &model.Dimensions{Weight: 2.5}}})
+	require.NoError(t, err)
+	require.Len(t, quotes.Options, 1)
+	assert.Equal(t, 360.0, quotes.Options[0].Cost)
+
+	quotes, err = calc.Quote("Russia", []model.Item{{Quantity: 1, Dimensions: &model.Dimensions{Weight: 60}}})
+	require.NoError(t, err)
+	require.Len(t, quotes.Options, 1)
+	assert.Equal(t, "FREIGHT_ROAD", quotes.Options[0].Option)
+}
+
+func TestQuote_noCountry(t *testing.T) {
+	calc, err := NewCalculator(testCarriers())
+	require.NoError(t, err)
+
+	_, err = calc.Quote(" ", nil)
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+}
+
+func TestNewCalculator_duplicateOption(t *testing.T) {
+	carriers := testCarriers()
+	carriers[1].Options[0].Code = "POST_STANDARD"
+
+	_, err := NewCalculator(carriers)
+	assert.Error(t, err)
+}
+
+func TestLoadCarriers(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "post.json"), []byte(`{"code":"POST","volumetric_factor":200,"options":[{"code":"POST_STANDARD","rates":[{"base":100}]}]}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.txt"), []byte("ignored"), 0o600))
+
+	carriers, err := LoadCarriers(dir)
+	require.NoError(t, err)
+	require.Len(t, carriers, 1)
+	assert.Equal(t, "POST", carriers[0].Code)
+	assert.Equal(t, []Rate{{Base: 100}}, carriers[0].Options[0].Rates)
+}
@@ -0,0 +1,120 @@
+package tax
+
+import (
+	"encoding/json"
+	"fmt"
+	"order-service/internal/repository/model"
+	"os"
+	"strings"
+)
+
+// Config holds tax and import duty rates per buyer country. Rates are
+// percentages. Country and category keys are matched case-insensitively;
+// countries are named the same way inventory names manufacturer countries.
+type Config struct {
+	// DefaultCountry is used when the order does not name a buyer country.
+	DefaultCountry string                  `json:"default_country"`
+	Countries      map[string]CountryRates `json:"countries"`
+}
+
+type CountryRates struct {
+	// Rate is the tax rate for categories without their own rate.
+	Rate          float64            `json:"rate"`
+	CategoryRates map[string]float64 `json:"category_rates"`
+	// ImportDuty is charged on parts made outside the buyer country.
+	ImportDuty     float64            `json:"import_duty"`
+	CategoryDuties map[string]float64 `json:"category_duties"`
+}
+
+// LoadConfig reads a JSON tax config from path.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parse tax config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Engine calculates tax and import duty of order lines.
+type Engine struct {
+	defaultCountry string
+	countries      map[string]CountryRates
+	// names keeps the spelling of the countries used in the config.
+	names map[string]string
+}
+
+func NewEngine(cfg Config) *Engine {
+	e := &Engine{
+		defaultCountry: normalize(cfg.DefaultCountry),
+		countries:      make(map[string]CountryRates, len(cfg.Countries)),
+		names:          make(map[string]string, len(cfg.Countries)),
+	}
+	for country, rates := range cfg.Countries {
+		e.names[normalize(country)] = strings.TrimSpace(country)
+		e.countries[normalize(country)] = CountryRates{
+			Rate:           rates.Rate,
+			CategoryRates:  normalizeKeys(rates.CategoryRates),
+			ImportDuty:     rates.ImportDuty,
+			CategoryDuties: normalizeKeys(rates.CategoryDuties),
+		}
+	}
+	return e
+}
+
+// Apply sets Tax and Duty of every line for a buyer in country and returns the
+// country the rates were taken from. Duty is charged on the discounted line
+// amount of parts made in another country; tax is charged on the discounted
+// amount plus duty. The country is returned as spelled in the config. An
+// unknown country is a bad request.
+func (e *Engine) Apply(country string, items []model.Item) (string, error) {
+	key := normalize(country)
+	if key == "" {
+		key = e.defaultCountry
+	}
+	rates, ok := e.countries[key]
+	if !ok {
+		if key == "" {
+			return "", fmt.Errorf("%w: buyer country is required", model.ErrBadRequest)
+		}
+		return "", fmt.Errorf("%w: no tax rates for country %s", model.ErrBadRequest, country)
+	}
+
+	for i := range items {
+		category := normalize(items[i].Category)
+		base := items[i].Amount() - items[i].Discount
+
+		items[i].Duty = 0
+		if made := normalize(items[i].ManufacturerCountry); made != "" && made != key {
+			items[i].Duty = percentOf(base, rateFor(rates.CategoryDuties, category, rates.ImportDuty))
+		}
+		items[i].Tax = percentOf(base+items[i].Duty, rateFor(rates.CategoryRates, category, rates.Rate))
+	}
+	return e.names[key], nil
+}
+
+func rateFor(byCategory map[string]float64, category string, def float64) float64 {
+	if rate, ok := byCategory[category]; ok {
+		return rate
+	}
+	return def
+}
+
+func percentOf(amount, percent float64) float64 {
+	return model.RoundMoney(amount * percent / 100)
+}
+
+func normalize(s string) string {
+	return strings.ToUpper(strings.TrimSpace(s))
+}
+
+func normalizeKeys(m map[string]float64) map[string]float64 {
+	res := make(map[string]float64, len(m))
+	for k, v := range m {
+		res[normalize(k)] = v
+	}
+	return res
+}
@@ -0,0 +1,79 @@
+package tax
+
+import (
+	"order-service/internal/repository/model"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEngine() *Engine {
+	return NewEngine(Config{
+		DefaultCountry: "Russia",
+		Countries: map[string]CountryRates{
+			"Russia": {
+				Rate:           20,
+				CategoryRates:  map[string]float64{"fuel": 10},
+				ImportDuty:     5,
+				CategoryDuties: map[string]float64{"ENGINE": 10},
+			},
+			"USA": {Rate: 7},
+		},
+	})
+}
+
+func TestApply(t *testing.T) {
+	items := []model.Item{
+		{PartUUID: "engine-1", Price: 100, Quantity: 2, Category: "ENGINE", ManufacturerCountry: "USA", Discount: 20},
+		{PartUUID: "fuel-1", Price: 10, Quantity: 3, Category: "FUEL", ManufacturerCountry: "russia"},
+		{PartUUID: "wing-1", Price: 50, Quantity: 1, Category: "WING", ManufacturerCountry: "Germany"},
+		{PartUUID: "porthole-1", Price: 40, Quantity: 1, Category: "PORTHOLE"},
+	}
+
+	country, err := testEngine().Apply(" russia ", items)
+	require.NoError(t, err)
+	assert.Equal(t, "Russia", country)
+
+	// engine: base 180, duty 10% = 18, tax 20% of 198
+	assert.Equal(t, 18.0, items[0].Duty)
+	assert.Equal(t, 39.6, items[0].Tax)
+	// fuel is made locally and has a reduced rate
+	assert.Equal(t, 0.0, items[1].Duty)
+	assert.Equal(t, 3.0, items[1].Tax)
+	// wing falls back to the country duty and rate
+	assert.Equal(t, 2.5, items[2].Duty)
+	assert.Equal(t, 10.5, items[2].Tax)
+	// unknown manufacturer country pays no duty
+	assert.Equal(t, 0.0, items[3].Duty)
+	assert.Equal(t, 8.0, items[3].Tax)
+}
+
+func TestApply_defaultCountry(t *testing.T) {
+	items := []model.Item{{Price: 10, Quantity: 1, ManufacturerCountry: "USA"}}
+
+	country, err := testEngine().Apply("", items)
+	require.NoError(t, err)
+	assert.Equal(t, "Russia", country)
+	assert.Equal(t, 0.5, items[0].Duty)
+}
+
+func TestApply_unknownCountry(t *testing.T) {
+	_, err := testEngine().Apply("Mars", []model.Item{{Price: 10, Quantity: 1}})
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+
+	_, err = NewEngine(Config{}).Apply("", []model.Item{{Price: 10, Quantity: 1}})
+	assert.ErrorIs(t, err, model.ErrBadRequest)
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"default_country":"USA","countries":{"USA":{"rate":7,"import_duty":2.5}}}`), 0o600))
+
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "USA", cfg.DefaultCountry)
+	assert.Equal(t, CountryRates{Rate: 7, ImportDuty: 2.5}, cfg.Countries["USA"])
+}
@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN paid_at TIMESTAMPTZ,
+    ADD COLUMN cancelled_at TIMESTAMPTZ;
+
+CREATE TABLE order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL,
+    from_status TEXT,
+    to_status TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX order_status_history_order_id_idx ON order_status_history (order_id, id);
+
+-- +goose Down
+DROP TABLE order_status_history;
+
+ALTER TABLE orders
+    DROP COLUMN cancelled_at,
+    DROP COLUMN paid_at,
+    DROP COLUMN updated_at;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN cancel_reason TEXT,
+    ADD COLUMN cancel_comment TEXT,
+    ADD COLUMN refund_transaction_id TEXT;
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN refund_transaction_id,
+    DROP COLUMN cancel_comment,
+    DROP COLUMN cancel_reason;
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TABLE promo_codes (
+    code TEXT PRIMARY KEY,
+    rule_type TEXT NOT NULL CHECK (rule_type IN ('PERCENTAGE', 'FIXED_AMOUNT', 'CATEGORY_PERCENTAGE', 'BUY_X_GET_Y')),
+    value NUMERIC(10, 2) NOT NULL,
+    category TEXT,
+    buy_quantity INT,
+    get_category TEXT,
+    get_quantity INT,
+    min_subtotal NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    valid_from TIMESTAMPTZ,
+    valid_to TIMESTAMPTZ,
+    max_uses INT,
+    per_user_limit INT,
+    used_count INT NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE promo_redemptions (
+    order_id UUID PRIMARY KEY,
+    code TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    FOREIGN KEY (code) REFERENCES promo_codes(code)
+);
+
+CREATE INDEX promo_redemptions_code_user_idx ON promo_redemptions (code, user_id);
+
+ALTER TABLE orders
+    ADD COLUMN subtotal NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN discount_total NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN promo_code TEXT;
+
+UPDATE orders SET subtotal = total_price;
+
+ALTER TABLE order_items
+    ADD COLUMN category TEXT NOT NULL DEFAULT '',
+    ADD COLUMN discount NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE order_items
+    DROP COLUMN discount,
+    DROP COLUMN category;
+
+ALTER TABLE orders
+    DROP COLUMN promo_code,
+    DROP COLUMN discount_total,
+    DROP COLUMN subtotal;
+
+DROP TABLE promo_redemptions;
+DROP TABLE promo_codes;
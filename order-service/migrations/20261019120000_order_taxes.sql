@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN buyer_country TEXT,
+    ADD COLUMN tax_total NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN duty_total NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE order_items
+    ADD COLUMN manufacturer_country TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tax NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN duty NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE order_items
+    DROP COLUMN duty,
+    DROP COLUMN tax,
+    DROP COLUMN manufacturer_country;
+
+ALTER TABLE orders
+    DROP COLUMN duty_total,
+    DROP COLUMN tax_total,
+    DROP COLUMN buyer_country;
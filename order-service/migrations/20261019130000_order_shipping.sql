@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN shipping_carrier TEXT,
+    ADD COLUMN shipping_option TEXT,
+    ADD COLUMN shipping_cost NUMERIC(10, 2) NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN shipping_cost,
+    DROP COLUMN shipping_option,
+    DROP COLUMN shipping_carrier;
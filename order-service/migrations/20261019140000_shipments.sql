@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN delivery_country TEXT,
+    ADD COLUMN delivery_city TEXT,
+    ADD COLUMN delivery_postal_code TEXT,
+    ADD COLUMN delivery_street TEXT;
+
+CREATE TABLE shipments (
+    order_id UUID PRIMARY KEY,
+    carrier TEXT,
+    tracking_number TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE TABLE shipment_events (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL,
+    type TEXT NOT NULL,
+    location TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    actor TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (order_id) REFERENCES shipments(order_id) ON DELETE CASCADE
+);
+
+CREATE INDEX shipment_events_order_id_idx ON shipment_events (order_id, id);
+
+-- +goose Down
+DROP TABLE shipment_events;
+DROP TABLE shipments;
+
+ALTER TABLE orders
+    DROP COLUMN delivery_street,
+    DROP COLUMN delivery_postal_code,
+    DROP COLUMN delivery_city,
+    DROP COLUMN delivery_country;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE carts (
+    user_id TEXT PRIMARY KEY,
+    version INT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE cart_items (
+    user_id TEXT NOT NULL,
+    part_id TEXT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    added_price NUMERIC(10, 2) NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, part_id),
+    FOREIGN KEY (user_id) REFERENCES carts(user_id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE cart_items;
+DROP TABLE carts;
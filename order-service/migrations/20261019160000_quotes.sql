@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE quotes (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN',
+    items JSONB NOT NULL,
+    subtotal NUMERIC(10, 2) NOT NULL,
+    discount_total NUMERIC(10, 2) NOT NULL,
+    tax_total NUMERIC(10, 2) NOT NULL,
+    duty_total NUMERIC(10, 2) NOT NULL,
+    shipping_cost NUMERIC(10, 2) NOT NULL,
+    total_price NUMERIC(10, 2) NOT NULL,
+    promo_code TEXT,
+    buyer_country TEXT,
+    shipping_carrier TEXT,
+    shipping_option TEXT,
+    delivery_address JSONB,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    accepted_at TIMESTAMPTZ,
+    order_id UUID REFERENCES orders(id)
+);
+
+CREATE INDEX quotes_user_id_idx ON quotes (user_id);
+
+-- +goose Down
+DROP TABLE quotes;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN authorized_amount NUMERIC(10, 2),
+    ADD COLUMN captured_amount NUMERIC(10, 2),
+    ADD COLUMN authorization_expires_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE orders
+    DROP COLUMN authorization_expires_at,
+    DROP COLUMN captured_amount,
+    DROP COLUMN authorized_amount;
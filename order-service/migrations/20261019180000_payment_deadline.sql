@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD COLUMN payment_deadline TIMESTAMPTZ;
+
+CREATE INDEX orders_payment_pending_idx ON orders (payment_deadline) WHERE status = 'PAYMENT_PENDING';
+
+-- +goose Down
+DROP INDEX orders_payment_pending_idx;
+
+ALTER TABLE orders
+    DROP COLUMN payment_deadline;
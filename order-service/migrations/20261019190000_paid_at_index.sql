@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX orders_paid_at_idx ON orders (paid_at) WHERE paid_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX orders_paid_at_idx;
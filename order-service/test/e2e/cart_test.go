@@ -0,0 +1,80 @@
+package e2e
+
+import (
+	"context"
+	"order-service/internal/oapi"
+	"order-service/internal/repository/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *OrderE2ESuite) TestCart_AddUpdateCheckout() {
+	ctx := context.Background()
+	engine := &model.Part{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}
+	wing := &model.Part{UUID: "wing-1", Name: "Wing", Price: 50, Quantity: 10}
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{engine}, nil)
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"wing-1"}).Return([]*model.Part{wing}, nil)
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1", "wing-1"}).Return([]*model.Part{engine, wing}, nil).Times(3)
+
+	_, err := s.Client.AddCartItem(ctx, &oapi.AddCartItemRequest{PartUUID: "engine-1", Quantity: 1}, oapi.AddCartItemParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+	_, err = s.Client.AddCartItem(ctx, &oapi.AddCartItemRequest{PartUUID: "wing-1", Quantity: 2}, oapi.AddCartItemParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.UpdateCartItem(ctx, &oapi.UpdateCartItemRequest{Quantity: 3}, oapi.UpdateCartItemParams{UserUUID: "user-1", PartUUID: "engine-1"})
+	s.Require().NoError(err)
+	cart, ok := resp.(*oapi.Cart)
+	s.Require().True(ok)
+	s.Require().Len(cart.Lines, 2)
+	s.Equal(3, cart.Lines[0].Quantity)
+	s.Equal(float64(400), cart.Subtotal)
+	s.Empty(cart.Warnings)
+
+	checkoutResp, err := s.Client.CheckoutCart(ctx, oapi.OptCheckoutRequest{}, oapi.CheckoutCartParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+	created, ok := checkoutResp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(float64(400), created.TotalPrice)
+
+	getResp, err := s.Client.GetCart(ctx, oapi.GetCartParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+	cart, ok = getResp.(*oapi.Cart)
+	s.Require().True(ok)
+	s.Empty(cart.Lines)
+
+	orderResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: created.OrderUUID})
+	s.Require().NoError(err)
+	order, ok := orderResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Len(order.Items, 2)
+}
+
+func (s *OrderE2ESuite) TestCart_PriceChangedWarning() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Twice()
+
+	_, err := s.Client.AddCartItem(ctx, &oapi.AddCartItemRequest{PartUUID: "engine-1", Quantity: 1}, oapi.AddCartItemParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 130, Quantity: 10},
+	}, nil).Once()
+	resp, err := s.Client.GetCart(ctx, oapi.GetCartParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+	cart, ok := resp.(*oapi.Cart)
+	s.Require().True(ok)
+	s.Require().Len(cart.Warnings, 1)
+	s.Equal(oapi.CartWarningCodePRICECHANGED, cart.Warnings[0].Code)
+	s.Equal(float64(100), cart.Lines[0].AddedPrice)
+	s.Equal(float64(130), cart.Lines[0].Price)
+}
+
+func (s *OrderE2ESuite) TestCart_CheckoutEmpty() {
+	resp, err := s.Client.CheckoutCart(context.Background(), oapi.OptCheckoutRequest{}, oapi.CheckoutCartParams{UserUUID: "user-1"})
+	s.Require().NoError(err)
+	_, ok := resp.(*oapi.CheckoutCartBadRequest)
+	s.True(ok)
+}
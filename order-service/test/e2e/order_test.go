@@ -4,6 +4,7 @@ import (
 	"context"
 	"order-service/internal/oapi"
 	"order-service/internal/repository/model"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -53,7 +54,7 @@ func (s *OrderE2ESuite) TestCreate_NotFound() {
 
 	s.Env.InvMock.On("ListParts", mock.Anything, req).Return([]*model.Part{}, nil).Once()
 
-	_, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
 		UserUUID: "1",
 		Items: []oapi.CreateOrderRequestItemsItem{
 			{
@@ -62,7 +63,11 @@ func (s *OrderE2ESuite) TestCreate_NotFound() {
 			},
 		},
 	})
-	s.Require().Error(err)
+	s.Require().NoError(err)
+	notFound, ok := resp.(*oapi.CreateOrderNotFound)
+	s.Require().True(ok)
+	s.Require().Len(notFound.Details, 1)
+	s.Equal(oapi.LineErrorCodePARTNOTFOUND, notFound.Details[0].Code)
 	var count int
 	s.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM orders").Scan(&count)
 	s.Equal(0, count)
@@ -174,3 +179,582 @@ func (s *OrderE2ESuite) TestCreate_EmptyItems() {
 	s.Require().NoError(err)
 	s.Require().True(ok)
 }
+
+func (s *OrderE2ESuite) TestHistory_Created() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Once()
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items:    []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 1}},
+	})
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+
+	histResp, err := s.Client.GetOrderHistory(ctx, oapi.GetOrderHistoryParams{OrderUUID: createResp.OrderUUID})
+	s.Require().NoError(err)
+	history, ok := histResp.(*oapi.OrderHistory)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusPENDINGPAYMENT, history.Status)
+	s.Require().Len(history.Entries, 1)
+	s.False(history.Entries[0].FromStatus.IsSet())
+	s.Equal(oapi.OrderStatusPENDINGPAYMENT, history.Entries[0].ToStatus)
+	s.Equal("user-1", history.Entries[0].Actor)
+	s.False(history.PaidAt.IsSet())
+}
+
+func (s *OrderE2ESuite) TestHistory_NotFound() {
+	resp, err := s.Client.GetOrderHistory(context.Background(), oapi.GetOrderHistoryParams{OrderUUID: "00000000-0000-0000-0000-000000000000"})
+	s.Require().NoError(err)
+	_, ok := resp.(*oapi.GetOrderHistoryNotFound)
+	s.True(ok)
+}
+
+func (s *OrderE2ESuite) createOrder(ctx context.Context, items []*model.Part, quantity float64) string {
+	partIDs := make([]string, len(items))
+	reqItems := make([]oapi.CreateOrderRequestItemsItem, len(items))
+	for i, p := range items {
+		partIDs[i] = p.UUID
+		reqItems[i] = oapi.CreateOrderRequestItemsItem{PartUUID: p.UUID, Quantity: quantity}
+	}
+	s.Env.InvMock.On("ListParts", mock.Anything, partIDs).Return(items, nil).Once()
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{UserUUID: "user-1", Items: reqItems})
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	return createResp.OrderUUID
+}
+
+func (s *OrderE2ESuite) TestCancel_PendingWithReason() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 1)
+
+	resp, err := s.Client.CancelOrder(ctx, oapi.NewOptCancelOrderRequest(oapi.CancelOrderRequest{
+		Reason:  oapi.NewOptCancelReason(oapi.CancelReasonFOUNDCHEAPER),
+		Comment: oapi.NewOptString("saw it at a competitor"),
+	}), oapi.CancelOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+	cancelResp, ok := resp.(*oapi.CancelOrderResponse)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusCANCELLED, cancelResp.Status)
+	s.Equal(oapi.CancelReasonFOUNDCHEAPER, cancelResp.Reason)
+	s.False(cancelResp.RefundTransactionUUID.IsSet())
+
+	var reason, comment string
+	err = s.Pool.QueryRow(ctx, "SELECT cancel_reason, cancel_comment FROM orders WHERE id = $1", orderID).Scan(&reason, &comment)
+	s.Require().NoError(err)
+	s.Equal("FOUND_CHEAPER", reason)
+	s.Equal("saw it at a competitor", comment)
+
+	resp, err = s.Client.CancelOrder(ctx, oapi.OptCancelOrderRequest{}, oapi.CancelOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+	_, ok = resp.(*oapi.CancelOrderConflict)
+	s.True(ok)
+}
+
+func (s *OrderE2ESuite) TestCancel_PaidRefund() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 2)
+	items := []model.Item{{PartUUID: "engine-1", Name: "Engine", Price: 100, Quantity: 2}}
+
+	s.Env.InvMock.On("ReserveStock", mock.Anything, items).Return(nil).Once()
+	s.Env.PayMock.On("MakePayment", mock.Anything, orderID, "user-1", mock.Anything, mock.Anything, mock.Anything).Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil).Once()
+	_, err := s.Client.PayOrder(ctx, &oapi.PayOrderRequest{PaymentMethod: oapi.PayOrderRequestPaymentMethodCARD}, oapi.PayOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+
+	s.Env.InvMock.On("ReleaseStock", mock.Anything, items).Return(nil).Once()
+	s.Env.PayMock.On("Refund", mock.Anything, "tx-1", orderID, "user-1", "CUSTOMER_REQUEST").Return("refund-1", nil).Once()
+	resp, err := s.Client.CancelOrder(ctx, oapi.OptCancelOrderRequest{}, oapi.CancelOrderParams{OrderUUID: orderID, XActor: "support-7"})
+	s.Require().NoError(err)
+	cancelResp, ok := resp.(*oapi.CancelOrderResponse)
+	s.Require().True(ok)
+	s.Equal("refund-1", cancelResp.RefundTransactionUUID.Value)
+
+	histResp, err := s.Client.GetOrderHistory(ctx, oapi.GetOrderHistoryParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	history, ok := histResp.(*oapi.OrderHistory)
+	s.Require().True(ok)
+	s.Require().Len(history.Entries, 3)
+	s.Equal(oapi.OrderStatusCANCELLED, history.Entries[2].ToStatus)
+	s.Equal(oapi.OrderStatusPAID, history.Entries[2].FromStatus.Value)
+	s.Equal("user-1", history.Entries[1].Actor)
+	s.Equal("support-7", history.Entries[2].Actor)
+	s.True(history.PaidAt.IsSet())
+	s.True(history.CancelledAt.IsSet())
+	s.Env.PayMock.AssertExpectations(s.T())
+}
+
+func (s *OrderE2ESuite) TestUpdateItems_AddAndChange() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 1)
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1", "porthole-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+		{UUID: "porthole-1", Name: "Porthole", Price: 5, Quantity: 50},
+	}, nil).Once()
+
+	resp, err := s.Client.UpdateOrderItems(ctx, &oapi.UpdateOrderItemsRequest{
+		Operations: []oapi.UpdateOrderItemsRequestOperationsItem{
+			{Op: oapi.UpdateOrderItemsRequestOperationsItemOpSETQUANTITY, PartUUID: "engine-1", Quantity: oapi.NewOptFloat64(3)},
+			{Op: oapi.UpdateOrderItemsRequestOperationsItemOpADD, PartUUID: "porthole-1", Quantity: oapi.NewOptFloat64(2)},
+		},
+	}, oapi.UpdateOrderItemsParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	order, ok := resp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(310.0, order.TotalPrice)
+	s.Len(order.Items, 2)
+
+	var count int
+	var total float64
+	s.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM order_items WHERE order_id = $1", orderID).Scan(&count)
+	s.Pool.QueryRow(ctx, "SELECT total_price FROM orders WHERE id = $1", orderID).Scan(&total)
+	s.Equal(2, count)
+	s.Equal(310.0, total)
+}
+
+func (s *OrderE2ESuite) TestUpdateItems_Cancelled() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 1)
+	_, err := s.Client.CancelOrder(ctx, oapi.OptCancelOrderRequest{}, oapi.CancelOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+
+	resp, err := s.Client.UpdateOrderItems(ctx, &oapi.UpdateOrderItemsRequest{
+		Operations: []oapi.UpdateOrderItemsRequestOperationsItem{
+			{Op: oapi.UpdateOrderItemsRequestOperationsItemOpADD, PartUUID: "engine-1", Quantity: oapi.NewOptFloat64(1)},
+		},
+	}, oapi.UpdateOrderItemsParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	_, ok := resp.(*oapi.UpdateOrderItemsConflict)
+	s.True(ok)
+}
+
+func (s *OrderE2ESuite) TestCreate_DuplicateLinesMerged() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Once()
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items: []oapi.CreateOrderRequestItemsItem{
+			{PartUUID: "engine-1", Quantity: 2},
+			{PartUUID: "engine-1", Quantity: 3},
+		},
+	})
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(500.0, createResp.TotalPrice)
+
+	var count, quantity int
+	s.Pool.QueryRow(ctx, "SELECT COUNT(*), SUM(quantity) FROM order_items WHERE order_id = $1", createResp.OrderUUID).Scan(&count, &quantity)
+	s.Equal(1, count)
+	s.Equal(5, quantity)
+}
+
+func (s *OrderE2ESuite) TestCreate_DuplicateLinesExceedStock() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 4},
+	}, nil).Once()
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items: []oapi.CreateOrderRequestItemsItem{
+			{PartUUID: "engine-1", Quantity: 2},
+			{PartUUID: "engine-1", Quantity: 3},
+		},
+	})
+	s.Require().NoError(err)
+	badReq, ok := resp.(*oapi.CreateOrderBadRequest)
+	s.Require().True(ok)
+	s.Equal("VALIDATION_FAILED", badReq.Code.Value)
+	s.Require().Len(badReq.Details, 2)
+	s.Equal(oapi.LineErrorCodeNOTENOUGHSTOCK, badReq.Details[0].Code)
+	s.Equal(1, badReq.Details[1].Line)
+
+	var count int
+	s.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM orders").Scan(&count)
+	s.Equal(0, count)
+}
+
+func (s *OrderE2ESuite) TestCreate_InvalidLinesReported() {
+	ctx := context.Background()
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items: []oapi.CreateOrderRequestItemsItem{
+			{PartUUID: "", Quantity: 1},
+			{PartUUID: "engine-1", Quantity: -1},
+		},
+	})
+	s.Require().NoError(err)
+	badReq, ok := resp.(*oapi.CreateOrderBadRequest)
+	s.Require().True(ok)
+	s.Require().Len(badReq.Details, 2)
+	s.Equal(oapi.LineErrorCodeINVALIDPARTUUID, badReq.Details[0].Code)
+	s.Equal(oapi.LineErrorCodeINVALIDQUANTITY, badReq.Details[1].Code)
+	s.Env.InvMock.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+}
+
+func (s *OrderE2ESuite) TestCreate_PromoPerUserLimit() {
+	ctx := context.Background()
+	_, err := s.Pool.Exec(ctx, `INSERT INTO promo_codes (code, rule_type, value, per_user_limit) VALUES ('ONCE', 'PERCENTAGE', 10, 1)`)
+	s.Require().NoError(err)
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Twice()
+
+	req := &oapi.CreateOrderRequest{
+		UserUUID:  "user-1",
+		Items:     []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 2}},
+		PromoCode: oapi.NewOptString("ONCE"),
+	}
+	resp, err := s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(float64(200), createResp.Subtotal)
+	s.Equal(float64(20), createResp.DiscountTotal)
+	s.Equal(float64(180), createResp.TotalPrice)
+
+	getResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: createResp.OrderUUID})
+	s.Require().NoError(err)
+	order, ok := getResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal("ONCE", order.PromoCode.Or(""))
+	s.Require().Len(order.Items, 1)
+	s.Equal(float64(20), order.Items[0].Discount)
+
+	resp, err = s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	badReq, ok := resp.(*oapi.CreateOrderBadRequest)
+	s.Require().True(ok)
+	s.Equal(model.CodePromoUserLimit, badReq.Code.Or(""))
+}
+
+func (s *OrderE2ESuite) TestPromo_AdminLifecycle() {
+	ctx := context.Background()
+	resp, err := s.Client.CreatePromoCode(ctx, &oapi.CreatePromoCodeRequest{
+		Code:     "TEN",
+		RuleType: oapi.PromoRuleTypePERCENTAGE,
+		Value:    10,
+		MaxUses:  oapi.NewOptInt(100),
+	})
+	s.Require().NoError(err)
+	created, ok := resp.(*oapi.PromoCode)
+	s.Require().True(ok)
+	s.True(created.Active)
+	s.Equal(100, created.MaxUses.Or(0))
+
+	resp, err = s.Client.CreatePromoCode(ctx, &oapi.CreatePromoCodeRequest{Code: "TEN", RuleType: oapi.PromoRuleTypeFIXEDAMOUNT, Value: 5})
+	s.Require().NoError(err)
+	_, ok = resp.(*oapi.CreatePromoCodeConflict)
+	s.True(ok)
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil)
+	req := &oapi.CreateOrderRequest{
+		UserUUID:  "user-1",
+		Items:     []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 1}},
+		PromoCode: oapi.NewOptString("TEN"),
+	}
+	orderResp, err := s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	_, ok = orderResp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+
+	deactResp, err := s.Client.DeactivatePromoCode(ctx, oapi.DeactivatePromoCodeParams{Code: "TEN"})
+	s.Require().NoError(err)
+	deactivated, ok := deactResp.(*oapi.PromoCode)
+	s.Require().True(ok)
+	s.False(deactivated.Active)
+	s.Equal(1, deactivated.UsedCount)
+
+	orderResp, err = s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	badReq, ok := orderResp.(*oapi.CreateOrderBadRequest)
+	s.Require().True(ok)
+	s.Equal(model.CodePromoInactive, badReq.Code.Or(""))
+
+	list, err := s.Client.ListPromoCodes(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(list.PromoCodes, 1)
+	s.Equal("TEN", list.PromoCodes[0].Code)
+	s.False(list.PromoCodes[0].Active)
+}
+
+func (s *OrderE2ESuite) TestCancel_ReleasesPromo() {
+	ctx := context.Background()
+	_, err := s.Client.CreatePromoCode(ctx, &oapi.CreatePromoCodeRequest{
+		Code:     "LAST",
+		RuleType: oapi.PromoRuleTypeFIXEDAMOUNT,
+		Value:    10,
+		MaxUses:  oapi.NewOptInt(1),
+	})
+	s.Require().NoError(err)
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil)
+	req := &oapi.CreateOrderRequest{
+		UserUUID:  "user-1",
+		Items:     []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 1}},
+		PromoCode: oapi.NewOptString("LAST"),
+	}
+
+	resp, err := s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	first, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+
+	req.UserUUID = "user-2"
+	resp, err = s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	badReq, ok := resp.(*oapi.CreateOrderBadRequest)
+	s.Require().True(ok)
+	s.Equal(model.CodePromoUsageLimit, badReq.Code.Or(""))
+
+	cancelResp, err := s.Client.CancelOrder(ctx, oapi.OptCancelOrderRequest{}, oapi.CancelOrderParams{OrderUUID: first.OrderUUID, XActor: "user-1"})
+	s.Require().NoError(err)
+	_, ok = cancelResp.(*oapi.CancelOrderResponse)
+	s.Require().True(ok)
+
+	var redemptions int
+	err = s.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM promo_redemptions WHERE order_id = $1", first.OrderUUID).Scan(&redemptions)
+	s.Require().NoError(err)
+	s.Zero(redemptions)
+
+	resp, err = s.Client.CreateOrder(ctx, req)
+	s.Require().NoError(err)
+	second, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(float64(10), second.DiscountTotal)
+
+	list, err := s.Client.ListPromoCodes(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(list.PromoCodes, 1)
+	s.Equal(1, list.PromoCodes[0].UsedCount)
+}
+
+func (s *OrderE2ESuite) TestShipping_QuoteAndOrder() {
+	ctx := context.Background()
+	engine := &model.Part{
+		UUID: "engine-1", Name: "Engine", Price: 1000, Quantity: 10,
+		Dimensions: &model.Dimensions{Length: 1, Width: 0.5, Height: 0.2, Weight: 15},
+	}
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{engine}, nil).Twice()
+
+	quoteResp, err := s.Client.QuoteShipping(ctx, &oapi.ShippingQuoteRequest{
+		Items:   []oapi.ShippingQuoteRequestItemsItem{{PartUUID: "engine-1", Quantity: 2}},
+		Address: oapi.Address{Country: "Russia"},
+	})
+	s.Require().NoError(err)
+	quotes, ok := quoteResp.(*oapi.ShippingQuoteResponse)
+	s.Require().True(ok)
+	s.Equal(float64(30), quotes.ActualWeight)
+	s.Require().Len(quotes.Options, 1)
+	s.Equal(float64(40), quotes.Options[0].ChargeableWeight)
+	s.Equal(float64(500), quotes.Options[0].Cost)
+
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID:       "user-1",
+		Items:          []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 2}},
+		BuyerCountry:   oapi.NewOptString("Russia"),
+		ShippingOption: oapi.NewOptString(quotes.Options[0].Option),
+	})
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(float64(500), createResp.ShippingCost)
+	s.Equal(float64(2500), createResp.TotalPrice)
+
+	getResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: createResp.OrderUUID})
+	s.Require().NoError(err)
+	order, ok := getResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal("POST", order.ShippingCarrier.Or(""))
+	s.Equal("POST_STANDARD", order.ShippingOption.Or(""))
+	s.Equal(float64(500), order.ShippingCost)
+}
+
+func (s *OrderE2ESuite) TestShipment_Lifecycle() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Once()
+	resp, err := s.Client.CreateOrder(ctx, &oapi.CreateOrderRequest{
+		UserUUID:        "user-1",
+		Items:           []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 1}},
+		DeliveryAddress: oapi.NewOptAddress(oapi.Address{Country: "Russia", City: oapi.NewOptString("Moscow")}),
+	})
+	s.Require().NoError(err)
+	createResp, ok := resp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	orderID := createResp.OrderUUID
+
+	shipResp, err := s.Client.GetOrderShipment(ctx, oapi.GetOrderShipmentParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	_, ok = shipResp.(*oapi.GetOrderShipmentNotFound)
+	s.True(ok)
+
+	eventResp, err := s.Client.AddShipmentEvent(ctx, &oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeASSEMBLING}, oapi.AddShipmentEventParams{OrderUUID: orderID, XActor: "ops-1"})
+	s.Require().NoError(err)
+	_, ok = eventResp.(*oapi.AddShipmentEventConflict)
+	s.True(ok, "unpaid orders cannot be assembled")
+
+	s.Env.InvMock.On("ReserveStock", mock.Anything, mock.Anything).Return(nil).Once()
+	s.Env.PayMock.On("MakePayment", mock.Anything, orderID, "user-1", mock.Anything, mock.Anything, mock.Anything).Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil).Once()
+	_, err = s.Client.PayOrder(ctx, &oapi.PayOrderRequest{PaymentMethod: oapi.PayOrderRequestPaymentMethodCARD}, oapi.PayOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+
+	events := []struct {
+		req   oapi.ShipmentEventRequest
+		actor string
+	}{
+		{oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeASSEMBLING}, "ops-1"},
+		{oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeSHIPPED, Carrier: oapi.NewOptString("POST"), TrackingNumber: oapi.NewOptString("TRK-1")}, "ops-1"},
+		{oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeINTRANSIT, Location: oapi.NewOptString("Kazan")}, "ops-1"},
+		{oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeDELIVERED}, "courier-7"},
+	}
+	for _, e := range events {
+		eventResp, err := s.Client.AddShipmentEvent(ctx, &e.req, oapi.AddShipmentEventParams{OrderUUID: orderID, XActor: e.actor})
+		s.Require().NoError(err)
+		_, ok := eventResp.(*oapi.Shipment)
+		s.Require().True(ok, "event %s", e.req.Type)
+	}
+
+	shipResp, err = s.Client.GetOrderShipment(ctx, oapi.GetOrderShipmentParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	shipment, ok := shipResp.(*oapi.Shipment)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusDELIVERED, shipment.Status)
+	s.Equal("POST", shipment.Carrier.Or(""))
+	s.Equal("TRK-1", shipment.TrackingNumber.Or(""))
+	s.Equal("Moscow", shipment.DeliveryAddress.Value.City.Or(""))
+	s.Require().Len(shipment.Events, 4)
+	s.Equal("Kazan", shipment.Events[2].Location)
+	s.Equal("courier-7", shipment.Events[3].Actor)
+
+	histResp, err := s.Client.GetOrderHistory(ctx, oapi.GetOrderHistoryParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	history, ok := histResp.(*oapi.OrderHistory)
+	s.Require().True(ok)
+	s.Require().Len(history.Entries, 5)
+	s.Equal(oapi.OrderStatusDELIVERED, history.Entries[4].ToStatus)
+}
+
+func (s *OrderE2ESuite) TestAuthorize_CapturedOnShipment() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 2)
+	expires := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	s.Env.InvMock.On("ReserveStock", mock.Anything, mock.Anything).Return(nil).Once()
+	s.Env.PayMock.On("Authorize", mock.Anything, orderID, "user-1", 200.0, mock.Anything).
+		Return(&model.Authorization{TransactionUUID: "tx-1", ExpiresAt: expires}, nil).Once()
+	resp, err := s.Client.AuthorizeOrder(ctx, &oapi.PayOrderRequest{PaymentMethod: oapi.PayOrderRequestPaymentMethodCARD}, oapi.AuthorizeOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+	order, ok := resp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusAUTHORIZED, order.Status)
+	s.Equal(200.0, order.AuthorizedAmount.Value)
+	s.False(order.CapturedAmount.IsSet())
+
+	eventResp, err := s.Client.AddShipmentEvent(ctx, &oapi.ShipmentEventRequest{Type: oapi.ShipmentEventTypeASSEMBLING}, oapi.AddShipmentEventParams{OrderUUID: orderID, XActor: "ops-1"})
+	s.Require().NoError(err)
+	_, ok = eventResp.(*oapi.Shipment)
+	s.Require().True(ok)
+
+	s.Env.PayMock.On("Capture", mock.Anything, "tx-1", 0.0).Return(200.0, nil).Once()
+	eventResp, err = s.Client.AddShipmentEvent(ctx, &oapi.ShipmentEventRequest{
+		Type:           oapi.ShipmentEventTypeSHIPPED,
+		Carrier:        oapi.NewOptString("POST"),
+		TrackingNumber: oapi.NewOptString("TRK-1"),
+	}, oapi.AddShipmentEventParams{OrderUUID: orderID, XActor: "ops-1"})
+	s.Require().NoError(err)
+	_, ok = eventResp.(*oapi.Shipment)
+	s.Require().True(ok)
+
+	getResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	order, ok = getResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusSHIPPED, order.Status)
+	s.Equal(200.0, order.CapturedAmount.Value)
+	s.True(expires.Equal(order.AuthorizationExpiresAt.Value))
+
+	var paid bool
+	err = s.Pool.QueryRow(ctx, "SELECT paid_at IS NOT NULL FROM orders WHERE id = $1", orderID).Scan(&paid)
+	s.Require().NoError(err)
+	s.True(paid)
+}
+
+func (s *OrderE2ESuite) TestPay_PendingConfirmedByCallback() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 2)
+	expires := time.Now().Add(15 * time.Minute).UTC().Truncate(time.Second)
+
+	s.Env.InvMock.On("ReserveStock", mock.Anything, mock.Anything).Return(nil).Once()
+	s.Env.PayMock.On("MakePayment", mock.Anything, orderID, "user-1", mock.Anything, mock.Anything, mock.Anything).Return(&model.Payment{
+		TransactionUUID: "tx-1",
+		State:           model.PaymentPending,
+		PaymentURL:      "https://qr.nspk.ru/AS1",
+		ExpiresAt:       expires,
+	}, nil).Once()
+	payResp, err := s.Client.PayOrder(ctx, &oapi.PayOrderRequest{PaymentMethod: oapi.PayOrderRequestPaymentMethodSBP}, oapi.PayOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+	payment, ok := payResp.(*oapi.PayOrderResponse)
+	s.Require().True(ok)
+	s.Equal(oapi.PayOrderResponseStatusPENDING, payment.Status)
+	s.Equal("https://qr.nspk.ru/AS1", payment.PaymentURL.Value)
+
+	getResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	order, ok := getResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusPAYMENTPENDING, order.Status)
+	s.True(expires.Equal(order.PaymentDeadline.Value))
+
+	callbackResp, err := s.Client.PaymentCallback(ctx, &oapi.PaymentCallbackRequest{TransactionUUID: "tx-other", OrderUUID: orderID})
+	s.Require().NoError(err)
+	_, ok = callbackResp.(*oapi.PaymentCallbackConflict)
+	s.True(ok)
+
+	s.Env.PayMock.On("PaymentStatus", mock.Anything, "tx-1").Return(&model.Payment{TransactionUUID: "tx-1", State: model.PaymentSucceeded}, nil).Once()
+	callbackResp, err = s.Client.PaymentCallback(ctx, &oapi.PaymentCallbackRequest{TransactionUUID: "tx-1", OrderUUID: orderID})
+	s.Require().NoError(err)
+	order, ok = callbackResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusPAID, order.Status)
+	s.False(order.PaymentDeadline.IsSet())
+}
+
+func (s *OrderE2ESuite) TestPay_InsufficientFunds() {
+	ctx := context.Background()
+	orderID := s.createOrder(ctx, []*model.Part{{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10}}, 2)
+
+	s.Env.InvMock.On("ReserveStock", mock.Anything, mock.Anything).Return(nil).Once()
+	s.Env.PayMock.On("MakePayment", mock.Anything, orderID, "user-1", mock.Anything, mock.Anything, mock.Anything).Return(nil, &model.PaymentRefusedError{
+		Reason:  "INSUFFICIENT_FUNDS",
+		Message: "investor account has 50.00 available, 200.00 requested",
+	}).Once()
+	s.Env.InvMock.On("ReleaseStock", mock.Anything, mock.Anything).Return(nil).Once()
+
+	resp, err := s.Client.PayOrder(ctx, &oapi.PayOrderRequest{PaymentMethod: oapi.PayOrderRequestPaymentMethodINVESTORMONEY}, oapi.PayOrderParams{OrderUUID: orderID, XActor: "user-1"})
+	s.Require().NoError(err)
+	refused, ok := resp.(*oapi.PayOrderPaymentRequired)
+	s.Require().True(ok)
+	s.Equal("INSUFFICIENT_FUNDS", refused.Code.Value)
+	s.Equal("investor account has 50.00 available, 200.00 requested", refused.Message)
+
+	getResp, err := s.Client.GetOrder(ctx, oapi.GetOrderParams{OrderUUID: orderID})
+	s.Require().NoError(err)
+	order, ok := getResp.(*oapi.Order)
+	s.Require().True(ok)
+	s.Equal(oapi.OrderStatusPENDINGPAYMENT, order.Status)
+}
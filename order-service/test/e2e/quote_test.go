@@ -0,0 +1,73 @@
+package e2e
+
+import (
+	"context"
+	"order-service/internal/oapi"
+	"order-service/internal/repository/model"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *OrderE2ESuite) TestQuote_AcceptAtLockedPrice() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil).Once()
+
+	resp, err := s.Client.CreateQuote(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items:    []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 2}},
+	})
+	s.Require().NoError(err)
+	quote, ok := resp.(*oapi.Quote)
+	s.Require().True(ok)
+	s.Equal(oapi.QuoteStatusOPEN, quote.Status)
+	s.Equal(float64(200), quote.TotalPrice)
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 150, Quantity: 10},
+	}, nil).Once()
+	acceptResp, err := s.Client.AcceptQuote(ctx, oapi.AcceptQuoteParams{QuoteUUID: quote.QuoteUUID})
+	s.Require().NoError(err)
+	created, ok := acceptResp.(*oapi.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Equal(float64(200), created.TotalPrice)
+
+	getResp, err := s.Client.GetQuote(ctx, oapi.GetQuoteParams{QuoteUUID: quote.QuoteUUID})
+	s.Require().NoError(err)
+	quote, ok = getResp.(*oapi.Quote)
+	s.Require().True(ok)
+	s.Equal(oapi.QuoteStatusACCEPTED, quote.Status)
+	s.Equal(created.OrderUUID, quote.OrderUUID.Or(""))
+
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 150, Quantity: 10},
+	}, nil).Maybe()
+	acceptResp, err = s.Client.AcceptQuote(ctx, oapi.AcceptQuoteParams{QuoteUUID: quote.QuoteUUID})
+	s.Require().NoError(err)
+	_, ok = acceptResp.(*oapi.AcceptQuoteConflict)
+	s.True(ok)
+}
+
+func (s *OrderE2ESuite) TestQuote_Expired() {
+	ctx := context.Background()
+	s.Env.InvMock.On("ListParts", mock.Anything, []string{"engine-1"}).Return([]*model.Part{
+		{UUID: "engine-1", Name: "Engine", Price: 100, Quantity: 10},
+	}, nil)
+
+	resp, err := s.Client.CreateQuote(ctx, &oapi.CreateOrderRequest{
+		UserUUID: "user-1",
+		Items:    []oapi.CreateOrderRequestItemsItem{{PartUUID: "engine-1", Quantity: 1}},
+	})
+	s.Require().NoError(err)
+	quote, ok := resp.(*oapi.Quote)
+	s.Require().True(ok)
+
+	_, err = s.Pool.Exec(ctx, `UPDATE quotes SET expires_at = now() - interval '1 minute' WHERE id = $1`, quote.QuoteUUID)
+	s.Require().NoError(err)
+
+	acceptResp, err := s.Client.AcceptQuote(ctx, oapi.AcceptQuoteParams{QuoteUUID: quote.QuoteUUID})
+	s.Require().NoError(err)
+	_, ok = acceptResp.(*oapi.AcceptQuoteGone)
+	s.True(ok)
+}
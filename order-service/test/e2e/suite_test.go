@@ -6,9 +6,16 @@ import (
 	"net/http/httptest"
 	"order-service/internal/handlers"
 	"order-service/internal/oapi"
+	cartrepo "order-service/internal/repository/cart"
 	repository "order-service/internal/repository/order"
+	promorepo "order-service/internal/repository/promo"
+	quoterepo "order-service/internal/repository/quote"
+	"order-service/internal/service/cart"
 	"order-service/internal/service/order"
+	"order-service/internal/service/promo"
+	"order-service/internal/service/shipping"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/suite"
@@ -35,8 +42,27 @@ func (s *OrderE2ESuite) SetupSuite() {
 	s.Pool = pool
 
 	repo := repository.NewRepository(pool)
-	svc := order.NewService(repo, s.Env.InvMock, s.Env.PayMock)
-	handler := &handlers.OrderHandler{Service: svc}
+	calc, err := shipping.NewCalculator([]shipping.Carrier{{
+		Code:             "POST",
+		VolumetricFactor: 200,
+		Options: []shipping.Option{{
+			Code:  "POST_STANDARD",
+			Name:  "Standard",
+			Rates: []shipping.Rate{{Base: 100, PerKg: 10}},
+		}},
+	}})
+	s.Require().NoError(err)
+	promoRepo := promorepo.NewRepository(pool)
+	svc := order.NewService(repo, s.Env.InvMock, s.Env.PayMock,
+		order.WithPromotions(promoRepo),
+		order.WithShipping(calc),
+		order.WithQuotes(quoterepo.NewRepository(pool), time.Hour),
+	)
+	handler := &handlers.OrderHandler{
+		Service: svc,
+		Cart:    cart.NewService(cartrepo.NewRepository(pool), s.Env.InvMock, svc),
+		Promos:  promo.NewService(promoRepo),
+	}
 
 	ogenServer, err := oapi.NewServer(handler)
 	s.Require().NoError(err)
@@ -62,10 +88,12 @@ func (s *OrderE2ESuite) TearDownSuite() {
 }
 
 func (s *OrderE2ESuite) SetupTest() {
-	_, err := s.Pool.Exec(context.Background(), "TRUNCATE orders, order_items RESTART IDENTITY CASCADE")
+	_, err := s.Pool.Exec(context.Background(), "TRUNCATE orders, order_items, promo_codes, carts, quotes RESTART IDENTITY CASCADE")
 	s.Require().NoError(err)
 	s.Env.InvMock.ExpectedCalls = nil
 	s.Env.InvMock.Calls = nil
+	s.Env.PayMock.ExpectedCalls = nil
+	s.Env.PayMock.Calls = nil
 }
 
 func TestOrderE2E(t *testing.T) {
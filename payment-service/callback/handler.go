@@ -0,0 +1,94 @@
+// Package callback serves the HTTP endpoint payment providers report the
+// outcome of asynchronous payments to. The providers are simulated, so the
+// endpoint is also how tests and operators confirm or fail a pending
+// payment by hand.
+package callback
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"payment-service/internal/service"
+)
+
+const (
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+)
+
+// Request is the body of POST /callbacks/{provider}. Reference is the
+// provider_reference returned by PayOrder; Code and Message are only read
+// for failed payments.
+type Request struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+type Response struct {
+	TransactionUUID string `json:"transaction_uuid"`
+	OrderUUID       string `json:"order_uuid"`
+	Status          string `json:"status"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns the callback endpoint.
+func NewHandler(s service.PaymentService) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /callbacks/{provider}", func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid body: " + err.Error()})
+			return
+		}
+		if req.Reference == "" || (req.Status != StatusSucceeded && req.Status != StatusFailed) {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: "reference and status SUCCEEDED or FAILED are required"})
+			return
+		}
+		tx, err := s.Complete(r.Context(), service.Callback{
+			Provider:  r.PathValue("provider"),
+			Reference: req.Reference,
+			Succeeded: req.Status == StatusSucceeded,
+			Code:      provider.Code(req.Code),
+			Message:   req.Message,
+		})
+		if err != nil {
+			writeJSON(w, callbackStatus(err), errorResponse{Error: err.Error()})
+			return
+		}
+		log.Printf("Оплата %s заказа %s подтверждена провайдером %s: %s\n", tx.UUID, tx.OrderUUID, tx.Provider, tx.Status)
+		writeJSON(w, http.StatusOK, Response{
+			TransactionUUID: tx.UUID,
+			OrderUUID:       tx.OrderUUID,
+			Status:          string(tx.Status),
+		})
+	})
+	return mux
+}
+
+func callbackStatus(err error) int {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, model.ErrWrongStatus):
+		return http.StatusConflict
+	case errors.Is(err, model.ErrInvalidCallback):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("callback response: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/provider"
+	"payment-service/internal/service"
+	repo "payment-service/repository"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallback(t *testing.T) {
+	router, err := provider.NewRouter(provider.Config{
+		Routes:    map[string]string{"SBP": "sbp"},
+		Providers: map[string]provider.ProviderConfig{"sbp": {Kind: provider.KindSBP, FakeMode: provider.FakePending}},
+	})
+	require.NoError(t, err)
+	svc := service.NewPaymentService(repo.NewMemoryRepo(), router)
+	tx, err := svc.Pay(context.Background(), &paymentpb.PayOrderRequest{
+		OrderUuid:     "order-1",
+		PaymentMethod: paymentpb.PaymentMethod_SBP,
+		Amount:        100,
+	})
+	require.NoError(t, err)
+	h := NewHandler(svc)
+
+	post := func(provider, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/callbacks/"+provider, strings.NewReader(body)))
+		return rec
+	}
+
+	rec := post("sbp", `{"reference": "`+tx.ProviderRef+`", "status": "DONE"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = post("sbp", `{"reference": "unknown", "status": "SUCCEEDED"}`)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = post("sbp", `{"reference": "`+tx.ProviderRef+`", "status": "SUCCEEDED"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp Response
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, Response{TransactionUUID: tx.UUID, OrderUUID: "order-1", Status: "PAID"}, resp)
+
+	rec = post("sbp", `{"reference": "`+tx.ProviderRef+`", "status": "FAILED", "code": "DECLINED"}`)
+	require.Equal(t, http.StatusConflict, rec.Code)
+}
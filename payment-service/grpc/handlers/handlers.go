@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"payment-service/internal/service"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details of failed payments.
+const ErrorDomain = "payment-service"
+
+// ReasonAuthorizationExpired is the ErrorInfo reason of a capture that came
+// too late.
+const ReasonAuthorizationExpired = "AUTHORIZATION_EXPIRED"
+
+// ReasonAlreadyPaid is the ErrorInfo reason of a payment that conflicts with
+// the one the order already has; the metadata describes that payment.
+const ReasonAlreadyPaid = "ALREADY_PAID"
+
+type PaymentHandler struct {
+	paymentpb.UnimplementedPaymentServiceServer
+	service service.PaymentService
+}
+
+func NewPaymentHandler(s service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		service: s,
+	}
+}
+
+func (h *PaymentHandler) PayOrder(ctx context.Context, req *paymentpb.PayOrderRequest) (*paymentpb.PayOrderResponse, error) {
+	if req.GetOrderUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "order_uuid is required")
+	}
+	if req.InstallmentMonths < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "installment_months must not be negative")
+	}
+	tx, err := h.service.Pay(ctx, req)
+	if err != nil {
+		log.Printf("Оплата заказа %s (%s) не прошла: %v\n", req.OrderUuid, req.PaymentMethod, err)
+		return nil, paymentError(err)
+	}
+	resp := &paymentpb.PayOrderResponse{
+		TransactionUuid:   tx.UUID,
+		Provider:          tx.Provider,
+		ProviderReference: tx.ProviderRef,
+		Status:            transactionStatus(tx.Status),
+	}
+	if tx.Status == model.TransactionReview {
+		log.Printf("Оплата заказа %s отправлена на ручную проверку до %s, transaction_uuid: %s\n", tx.OrderUUID, tx.ExpiresAt.Format(time.RFC3339), tx.UUID)
+		resp.ExpiresAt = timestamppb.New(tx.ExpiresAt)
+		return resp, nil
+	}
+	if tx.Status == model.TransactionPending {
+		log.Printf("Заказ %s ожидает подтверждения оплаты через %s, transaction_uuid: %s\n", tx.OrderUUID, tx.Provider, tx.UUID)
+		if !tx.ExpiresAt.IsZero() {
+			resp.ExpiresAt = timestamppb.New(tx.ExpiresAt)
+		}
+		return resp, nil
+	}
+	if tx.Plan != nil {
+		resp.InstallmentPlan = installmentPlan(tx)
+		log.Printf("Заказ %s оплачен в рассрочку на %d мес. под %.1f%% годовых, transaction_uuid: %s\n", tx.OrderUUID, tx.Plan.Months, tx.Plan.AnnualRate, tx.UUID)
+		return resp, nil
+	}
+	log.Printf("Заказ %s успешно оплачен с помощью %s пользователем %s через %s\n transaction_uuid: %s", tx.OrderUUID, tx.Method, tx.UserUUID, tx.Provider, tx.UUID)
+	return resp, nil
+}
+
+func (h *PaymentHandler) GetPaymentStatus(ctx context.Context, req *paymentpb.GetPaymentStatusRequest) (*paymentpb.GetPaymentStatusResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, err := h.service.Status(ctx, req.TransactionUuid)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	resp := &paymentpb.GetPaymentStatusResponse{
+		TransactionUuid:       tx.UUID,
+		OrderUuid:             tx.OrderUUID,
+		Status:                transactionStatus(tx.Status),
+		Amount:                tx.Amount,
+		CapturedAmount:        tx.CapturedAmount,
+		Provider:              tx.Provider,
+		ProviderReference:     tx.ProviderRef,
+		FailureCode:           tx.FailureCode,
+		FailureMessage:        tx.FailureMessage,
+		UpdatedAt:             timestamppb.New(tx.UpdatedAt),
+		UserUuid:              tx.UserUUID,
+		PaymentMethod:         tx.Method,
+		RefundTransactionUuid: tx.RefundUUID,
+		CreatedAt:             timestamppb.New(tx.CreatedAt),
+	}
+	if !tx.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(tx.ExpiresAt)
+	}
+	return resp, nil
+}
+
+func (h *PaymentHandler) RefundPayment(ctx context.Context, req *paymentpb.RefundPaymentRequest) (*paymentpb.RefundPaymentResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	refundUUID, err := h.service.Refund(ctx, req.TransactionUuid, req.Reason)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	log.Printf("Возврат по транзакции %s заказа %s пользователю %s, причина: %s\n refund_transaction_uuid: %s", req.TransactionUuid, req.OrderUuid, req.UserUuid, req.Reason, refundUUID)
+	return &paymentpb.RefundPaymentResponse{
+		RefundTransactionUuid: refundUUID,
+	}, nil
+}
+
+func (h *PaymentHandler) AuthorizePayment(ctx context.Context, req *paymentpb.AuthorizePaymentRequest) (*paymentpb.AuthorizePaymentResponse, error) {
+	if req.GetOrderUuid() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "order_uuid is required")
+	}
+	tx, err := h.service.Authorize(ctx, &paymentpb.PayOrderRequest{
+		OrderUuid:     req.OrderUuid,
+		UserUuid:      req.UserUuid,
+		PaymentMethod: req.PaymentMethod,
+		Amount:        req.Amount,
+	})
+	if err != nil {
+		log.Printf("Авторизация оплаты заказа %s (%s) не прошла: %v\n", req.OrderUuid, req.PaymentMethod, err)
+		return nil, paymentError(err)
+	}
+	log.Printf("Заказ %s: сумма %.2f заблокирована через %s до %s\n transaction_uuid: %s", tx.OrderUUID, tx.Amount, tx.Provider, tx.ExpiresAt.Format(time.RFC3339), tx.UUID)
+	return &paymentpb.AuthorizePaymentResponse{
+		TransactionUuid:   tx.UUID,
+		Provider:          tx.Provider,
+		ProviderReference: tx.ProviderRef,
+		ExpiresAt:         timestamppb.New(tx.ExpiresAt),
+	}, nil
+}
+
+func (h *PaymentHandler) CapturePayment(ctx context.Context, req *paymentpb.CapturePaymentRequest) (*paymentpb.CapturePaymentResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, err := h.service.Capture(ctx, req.TransactionUuid, req.Amount)
+	if err != nil {
+		log.Printf("Списание по транзакции %s не прошло: %v\n", req.TransactionUuid, err)
+		return nil, paymentError(err)
+	}
+	log.Printf("Заказ %s: списано %.2f из %.2f заблокированных, transaction_uuid: %s\n", tx.OrderUUID, tx.CapturedAmount, tx.Amount, tx.UUID)
+	return &paymentpb.CapturePaymentResponse{
+		TransactionUuid: tx.UUID,
+		CapturedAmount:  tx.CapturedAmount,
+		ReleasedAmount:  tx.Amount - tx.CapturedAmount,
+		Status:          transactionStatus(tx.Status),
+	}, nil
+}
+
+func (h *PaymentHandler) VoidAuthorization(ctx context.Context, req *paymentpb.VoidAuthorizationRequest) (*paymentpb.VoidAuthorizationResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, err := h.service.Void(ctx, req.TransactionUuid, req.Reason)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	if tx.Status == model.TransactionExpired && tx.VoidReason == "" {
+		log.Printf("Ожидающая оплата %s заказа %s отменена, причина: %s\n", tx.UUID, tx.OrderUUID, tx.FailureMessage)
+	} else {
+		log.Printf("Блокировка по транзакции %s заказа %s снята, причина: %s\n", tx.UUID, tx.OrderUUID, tx.VoidReason)
+	}
+	return &paymentpb.VoidAuthorizationResponse{
+		TransactionUuid: tx.UUID,
+		Status:          transactionStatus(tx.Status),
+	}, nil
+}
+
+func transactionStatus(s model.TransactionStatus) paymentpb.TransactionStatus {
+	v, ok := paymentpb.TransactionStatus_value["TRANSACTION_STATUS_"+string(s)]
+	if !ok {
+		return paymentpb.TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+	}
+	return paymentpb.TransactionStatus(v)
+}
+
+// providerCodes maps the provider failure codes to gRPC codes: a payment
+// the provider refused is a failed precondition, a provider that does not
+// answer is unavailable.
+var providerCodes = map[provider.Code]codes.Code{
+	provider.CodeDeclined:          codes.FailedPrecondition,
+	provider.CodeInsufficientFunds: codes.FailedPrecondition,
+	provider.CodeLimitExceeded:     codes.FailedPrecondition,
+	provider.CodeExpired:           codes.FailedPrecondition,
+	provider.CodeInvalidRequest:    codes.InvalidArgument,
+	provider.CodeUnsupportedMethod: codes.InvalidArgument,
+	provider.CodeUnavailable:       codes.Unavailable,
+	provider.CodeTimeout:           codes.DeadlineExceeded,
+}
+
+func paymentError(err error) error {
+	var perr *provider.Error
+	var conflict *model.PaymentConflictError
+	switch {
+	case errors.As(err, &perr):
+		code, ok := providerCodes[perr.Code]
+		if !ok {
+			code = codes.Internal
+		}
+		return withReason(status.New(code, perr.Error()), string(perr.Code), perr.Provider)
+	case errors.As(err, &conflict):
+		tx := conflict.Existing
+		return withInfo(status.New(codes.AlreadyExists, conflict.Error()), ReasonAlreadyPaid, map[string]string{
+			"transaction_uuid": tx.UUID,
+			"status":           string(tx.Status),
+			"payment_method":   tx.Method.String(),
+			"amount":           strconv.FormatFloat(tx.Amount, 'f', 2, 64),
+		})
+	case errors.Is(err, model.ErrNotFound), errors.Is(err, provider.ErrAccountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, provider.ErrAccountExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrAuthorizationExpired):
+		return withReason(status.New(codes.FailedPrecondition, err.Error()), ReasonAuthorizationExpired, "")
+	case errors.Is(err, model.ErrRiskRejected):
+		return withReason(status.New(codes.FailedPrecondition, err.Error()), model.RiskRejectedCode, "")
+	case errors.Is(err, model.ErrWrongStatus):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrInvalidAmount), errors.Is(err, model.ErrInvalidCallback), errors.Is(err, model.ErrInvalidPlan):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "internal error: %v", err)
+	}
+}
+
+// withReason attaches an ErrorInfo so that clients can tell the failures
+// apart without parsing the message.
+func withReason(st *status.Status, reason, providerName string) error {
+	var metadata map[string]string
+	if providerName != "" {
+		metadata = map[string]string{"provider": providerName}
+	}
+	return withInfo(st, reason, metadata)
+}
+
+func withInfo(st *status.Status, reason string, metadata map[string]string) error {
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain, Metadata: metadata}
+	if detailed, err := st.WithDetails(info); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/provider"
+	"payment-service/internal/service"
+	repo "payment-service/repository"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// PaymentHandlerTest runs the handlers behind an in-process gRPC server.
+type PaymentHandlerTest struct {
+	suite.Suite
+	ctx    context.Context
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	client paymentpb.PaymentServiceClient
+}
+
+func (s *PaymentHandlerTest) SetupTest() {
+	router, err := provider.NewRouter(provider.Config{
+		Routes: map[string]string{"CARD": "card", "SBP": "sbp"},
+		Providers: map[string]provider.ProviderConfig{
+			// The latency keeps the first payment in flight while its
+			// retries arrive.
+			"card": {Kind: provider.KindCard, Latency: provider.Duration{Duration: 20 * time.Millisecond}},
+			"sbp":  {Kind: provider.KindSBP, Async: true, QRTTL: provider.Duration{Duration: 15 * time.Minute}},
+		},
+	})
+	s.Require().NoError(err)
+
+	lis := bufconn.Listen(1 << 20)
+	s.server = grpc.NewServer()
+	paymentpb.RegisterPaymentServiceServer(s.server, NewPaymentHandler(service.NewPaymentService(repo.NewMemoryRepo(), router)))
+	go s.server.Serve(lis)
+
+	s.conn, err = grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.client = paymentpb.NewPaymentServiceClient(s.conn)
+	s.ctx = context.Background()
+}
+
+func (s *PaymentHandlerTest) TearDownTest() {
+	s.conn.Close()
+	s.server.Stop()
+}
+
+func TestPaymentHandlerTest(t *testing.T) {
+	suite.Run(t, new(PaymentHandlerTest))
+}
+
+func payOrder(method paymentpb.PaymentMethod, amount float64, key string) *paymentpb.PayOrderRequest {
+	return &paymentpb.PayOrderRequest{OrderUuid: "order-1", UserUuid: "user-1", PaymentMethod: method, Amount: amount, IdempotencyKey: key}
+}
+
+// transactions returns the transactions payment-service has for order-1.
+func (s *PaymentHandlerTest) transactions() []*paymentpb.Transaction {
+	resp, err := s.client.ListTransactions(s.ctx, &paymentpb.ListTransactionsRequest{})
+	s.Require().NoError(err)
+	var txs []*paymentpb.Transaction
+	for _, tx := range resp.Transactions {
+		if tx.OrderUuid == "order-1" {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+func (s *PaymentHandlerTest) TestPayOrder_repeatReturnsOriginal() {
+	first, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, ""))
+	s.Require().NoError(err)
+	s.Equal(paymentpb.TransactionStatus_TRANSACTION_STATUS_PAID, first.Status)
+
+	again, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, ""))
+	s.Require().NoError(err)
+	s.Equal(first.TransactionUuid, again.TransactionUuid)
+	s.Equal(first.ProviderReference, again.ProviderReference)
+	s.Len(s.transactions(), 1)
+}
+
+func (s *PaymentHandlerTest) TestPayOrder_concurrentRetries() {
+	const retries = 5
+	ids := make([]string, retries)
+	errs := make([]error, retries)
+	var wg sync.WaitGroup
+	for i := range retries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, "attempt-1"))
+			errs[i] = err
+			if err == nil {
+				ids[i] = resp.TransactionUuid
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range retries {
+		s.Require().NoError(errs[i])
+		s.Equal(ids[0], ids[i])
+	}
+	txs := s.transactions()
+	s.Require().Len(txs, 1)
+	s.Equal(100.0, txs[0].CapturedAmount)
+}
+
+func (s *PaymentHandlerTest) TestPayOrder_conflict() {
+	first, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, "attempt-1"))
+	s.Require().NoError(err)
+
+	cases := []struct {
+		name string
+		req  *paymentpb.PayOrderRequest
+	}{
+		{"amount", payOrder(paymentpb.PaymentMethod_CARD, 150, "attempt-1")},
+		{"method", payOrder(paymentpb.PaymentMethod_SBP, 100, "")},
+		{"key", payOrder(paymentpb.PaymentMethod_CARD, 100, "attempt-2")},
+	}
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			_, err := s.client.PayOrder(s.ctx, c.req)
+			st := status.Convert(err)
+			s.Equal(codes.AlreadyExists, st.Code())
+			s.Require().Len(st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			s.Require().True(ok)
+			s.Equal(ReasonAlreadyPaid, info.Reason)
+			s.Equal(ErrorDomain, info.Domain)
+			s.Equal(map[string]string{
+				"transaction_uuid": first.TransactionUuid,
+				"status":           "PAID",
+				"payment_method":   "CARD",
+				"amount":           "100.00",
+			}, info.Metadata)
+		})
+	}
+	s.Len(s.transactions(), 1)
+}
+
+func (s *PaymentHandlerTest) TestPayOrder_newAttemptAfterRefund() {
+	first, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, "attempt-1"))
+	s.Require().NoError(err)
+	_, err = s.client.RefundPayment(s.ctx, &paymentpb.RefundPaymentRequest{TransactionUuid: first.TransactionUuid, Reason: "cancelled"})
+	s.Require().NoError(err)
+
+	// The refunded attempt is still what its key returns.
+	again, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_CARD, 100, "attempt-1"))
+	s.Require().NoError(err)
+	s.Equal(first.TransactionUuid, again.TransactionUuid)
+
+	second, err := s.client.PayOrder(s.ctx, payOrder(paymentpb.PaymentMethod_SBP, 80, "attempt-2"))
+	s.Require().NoError(err)
+	s.NotEqual(first.TransactionUuid, second.TransactionUuid)
+	s.Equal(paymentpb.TransactionStatus_TRANSACTION_STATUS_PENDING, second.Status)
+	s.Len(s.transactions(), 2)
+}
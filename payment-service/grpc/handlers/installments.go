@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PaymentHandler) GetInstallmentPlan(ctx context.Context, req *paymentpb.GetInstallmentPlanRequest) (*paymentpb.InstallmentPlan, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, err := h.service.InstallmentPlan(ctx, req.TransactionUuid)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	return installmentPlan(tx), nil
+}
+
+func installmentPlan(tx *model.Transaction) *paymentpb.InstallmentPlan {
+	p := tx.Plan
+	resp := &paymentpb.InstallmentPlan{
+		TransactionUuid:  tx.UUID,
+		Months:           int32(p.Months),
+		AnnualRate:       p.AnnualRate,
+		TotalAmount:      p.Total(),
+		TotalInterest:    roundCents(p.Total() - tx.Amount),
+		PaidAmount:       p.Paid(),
+		RemainingBalance: p.Remaining(),
+		OverdueAmount:    p.Overdue(),
+	}
+	if next := p.Next(); next != nil {
+		resp.NextDueDate = timestamppb.New(next.DueDate)
+		resp.NextAmount = next.Amount
+	}
+	for _, i := range p.Installments {
+		pi := &paymentpb.Installment{
+			Number:      int32(i.Number),
+			DueDate:     timestamppb.New(i.DueDate),
+			Principal:   i.Principal,
+			Interest:    i.Interest,
+			Amount:      i.Amount,
+			Status:      installmentStatus(i.Status),
+			FailureCode: i.FailureCode,
+		}
+		if !i.PaidAt.IsZero() {
+			pi.PaidAt = timestamppb.New(i.PaidAt)
+		}
+		resp.Installments = append(resp.Installments, pi)
+	}
+	return resp
+}
+
+func installmentStatus(s model.InstallmentStatus) paymentpb.InstallmentStatus {
+	v, ok := paymentpb.InstallmentStatus_value["INSTALLMENT_STATUS_"+string(s)]
+	if !ok {
+		return paymentpb.InstallmentStatus_INSTALLMENT_STATUS_UNKNOWN
+	}
+	return paymentpb.InstallmentStatus(v)
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/provider"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PaymentHandler) CreateInvestorAccount(ctx context.Context, req *paymentpb.CreateInvestorAccountRequest) (*paymentpb.InvestorAccount, error) {
+	if req.UserUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uuid is required")
+	}
+	a, err := h.service.OpenInvestorAccount(ctx, req.UserUuid, req.PerOrderLimit, req.MonthlyLimit)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	log.Printf("Открыт инвесторский счёт пользователя %s\n", a.UserUUID)
+	return investorAccount(a), nil
+}
+
+func (h *PaymentHandler) DepositInvestorFunds(ctx context.Context, req *paymentpb.DepositInvestorFundsRequest) (*paymentpb.InvestorAccount, error) {
+	if req.UserUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uuid is required")
+	}
+	a, err := h.service.DepositInvestorFunds(ctx, req.UserUuid, req.Amount, req.Reference)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	log.Printf("Инвесторский счёт пользователя %s пополнен на %.2f, баланс %.2f\n", a.UserUUID, req.Amount, a.Balance)
+	return investorAccount(a), nil
+}
+
+func (h *PaymentHandler) SetInvestorLimits(ctx context.Context, req *paymentpb.SetInvestorLimitsRequest) (*paymentpb.InvestorAccount, error) {
+	if req.UserUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uuid is required")
+	}
+	a, err := h.service.SetInvestorLimits(ctx, req.UserUuid, req.PerOrderLimit, req.MonthlyLimit)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	return investorAccount(a), nil
+}
+
+func (h *PaymentHandler) GetInvestorAccount(ctx context.Context, req *paymentpb.GetInvestorAccountRequest) (*paymentpb.InvestorAccount, error) {
+	if req.UserUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uuid is required")
+	}
+	a, err := h.service.InvestorAccount(ctx, req.UserUuid)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	return investorAccount(a), nil
+}
+
+func (h *PaymentHandler) GetInvestorStatement(ctx context.Context, req *paymentpb.GetInvestorStatementRequest) (*paymentpb.GetInvestorStatementResponse, error) {
+	if req.UserUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_uuid is required")
+	}
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	a, entries, err := h.service.InvestorStatement(ctx, req.UserUuid, from, to)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	resp := &paymentpb.GetInvestorStatementResponse{Account: investorAccount(a)}
+	for _, e := range entries {
+		switch e.Type {
+		case provider.EntryCredit:
+			resp.TotalCredits += e.Amount
+		case provider.EntryDebit:
+			resp.TotalDebits += e.Amount
+		}
+		resp.Entries = append(resp.Entries, &paymentpb.StatementEntry{
+			EntryUuid:   e.ID,
+			Type:        statementEntryType(e.Type),
+			Amount:      e.Amount,
+			OrderUuid:   e.OrderUUID,
+			Reference:   e.Reference,
+			Description: e.Description,
+			Balance:     e.Balance,
+			Available:   e.Available,
+			CreatedAt:   timestamppb.New(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func investorAccount(a *provider.InvestorAccount) *paymentpb.InvestorAccount {
+	return &paymentpb.InvestorAccount{
+		UserUuid:       a.UserUUID,
+		Balance:        a.Balance,
+		Held:           a.Held,
+		Available:      a.Available(),
+		PerOrderLimit:  a.PerOrderLimit,
+		MonthlyLimit:   a.MonthlyLimit,
+		SpentThisMonth: a.SpentThisMonth,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func statementEntryType(t provider.EntryType) paymentpb.StatementEntryType {
+	v, ok := paymentpb.StatementEntryType_value["STATEMENT_ENTRY_TYPE_"+string(t)]
+	if !ok {
+		return paymentpb.StatementEntryType_STATEMENT_ENTRY_TYPE_UNKNOWN
+	}
+	return paymentpb.StatementEntryType(v)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *PaymentHandler) ApprovePayment(ctx context.Context, req *paymentpb.ReviewPaymentRequest) (*paymentpb.ReviewPaymentResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, d, err := h.service.ApprovePayment(ctx, req.TransactionUuid, req.Operator, req.Comment)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	log.Printf("Оплата %s заказа %s одобрена после проверки (%s), статус: %s\n", tx.UUID, tx.OrderUUID, req.Operator, tx.Status)
+	return reviewResponse(tx, d), nil
+}
+
+func (h *PaymentHandler) RejectPayment(ctx context.Context, req *paymentpb.ReviewPaymentRequest) (*paymentpb.ReviewPaymentResponse, error) {
+	if req.TransactionUuid == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_uuid is required")
+	}
+	tx, d, err := h.service.RejectPayment(ctx, req.TransactionUuid, req.Operator, req.Comment)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	log.Printf("Оплата %s заказа %s отклонена после проверки (%s)\n", tx.UUID, tx.OrderUUID, req.Operator)
+	return reviewResponse(tx, d), nil
+}
+
+func (h *PaymentHandler) ListRiskDecisions(ctx context.Context, req *paymentpb.ListRiskDecisionsRequest) (*paymentpb.ListRiskDecisionsResponse, error) {
+	f := model.DecisionFilter{
+		OrderUUID:       req.OrderUuid,
+		UserUUID:        req.UserUuid,
+		TransactionUUID: req.TransactionUuid,
+	}
+	if req.Outcome != paymentpb.RiskOutcome_RISK_OUTCOME_UNKNOWN {
+		f.Outcome = model.RiskOutcome(strings.TrimPrefix(req.Outcome.String(), "RISK_OUTCOME_"))
+	}
+	decisions, err := h.service.RiskDecisions(ctx, f, req.AwaitingReview)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	resp := &paymentpb.ListRiskDecisionsResponse{}
+	for _, d := range decisions {
+		resp.Decisions = append(resp.Decisions, riskDecision(d))
+	}
+	return resp, nil
+}
+
+func reviewResponse(tx *model.Transaction, d *model.RiskDecision) *paymentpb.ReviewPaymentResponse {
+	return &paymentpb.ReviewPaymentResponse{
+		TransactionUuid: tx.UUID,
+		Status:          transactionStatus(tx.Status),
+		FailureCode:     tx.FailureCode,
+		FailureMessage:  tx.FailureMessage,
+		Decision:        riskDecision(d),
+	}
+}
+
+func riskDecision(d *model.RiskDecision) *paymentpb.RiskDecision {
+	resp := &paymentpb.RiskDecision{
+		DecisionUuid:    d.UUID,
+		OrderUuid:       d.OrderUUID,
+		UserUuid:        d.UserUUID,
+		PaymentMethod:   d.Method,
+		Amount:          d.Amount,
+		Outcome:         riskOutcome(d.Outcome),
+		TransactionUuid: d.TransactionUUID,
+		Operator:        d.Operator,
+		CreatedAt:       timestamppb.New(d.CreatedAt),
+	}
+	for _, r := range d.Reasons {
+		resp.Reasons = append(resp.Reasons, &paymentpb.RiskReason{
+			Rule:    r.Rule,
+			Outcome: riskOutcome(r.Outcome),
+			Message: r.Message,
+		})
+	}
+	return resp
+}
+
+func riskOutcome(o model.RiskOutcome) paymentpb.RiskOutcome {
+	v, ok := paymentpb.RiskOutcome_value["RISK_OUTCOME_"+string(o)]
+	if !ok {
+		return paymentpb.RiskOutcome_RISK_OUTCOME_UNKNOWN
+	}
+	return paymentpb.RiskOutcome(v)
+}
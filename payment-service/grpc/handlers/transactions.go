@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// ListTransactions pages through the transactions. The page token is the
+// offset of the next page: transactions are listed oldest first, so the
+// pages stay stable while new ones are added.
+func (h *PaymentHandler) ListTransactions(ctx context.Context, req *paymentpb.ListTransactionsRequest) (*paymentpb.ListTransactionsResponse, error) {
+	size := int(req.PageSize)
+	switch {
+	case size < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must not be negative")
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	offset := 0
+	if req.PageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(req.PageToken); err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token %q", req.PageToken)
+		}
+	}
+	f := model.TransactionFilter{}
+	if req.CreatedFrom != nil {
+		f.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		f.CreatedTo = req.CreatedTo.AsTime()
+	}
+	for _, st := range req.Statuses {
+		f.Statuses = append(f.Statuses, model.TransactionStatus(strings.TrimPrefix(st.String(), "TRANSACTION_STATUS_")))
+	}
+
+	txs, err := h.service.Transactions(ctx, f)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+	resp := &paymentpb.ListTransactionsResponse{}
+	if offset >= len(txs) {
+		return resp, nil
+	}
+	end := min(offset+size, len(txs))
+	for _, tx := range txs[offset:end] {
+		resp.Transactions = append(resp.Transactions, transaction(tx))
+	}
+	if end < len(txs) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+func transaction(tx *model.Transaction) *paymentpb.Transaction {
+	return &paymentpb.Transaction{
+		TransactionUuid:       tx.UUID,
+		OrderUuid:             tx.OrderUUID,
+		UserUuid:              tx.UserUUID,
+		PaymentMethod:         tx.Method,
+		Status:                transactionStatus(tx.Status),
+		Amount:                tx.Amount,
+		CapturedAmount:        tx.CapturedAmount,
+		Provider:              tx.Provider,
+		ProviderReference:     tx.ProviderRef,
+		RefundTransactionUuid: tx.RefundUUID,
+		CreatedAt:             timestamppb.New(tx.CreatedAt),
+		UpdatedAt:             timestamppb.New(tx.UpdatedAt),
+	}
+}
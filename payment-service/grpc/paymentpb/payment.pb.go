@@ -9,7 +9,7 @@ package paymentpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,13 +77,264 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_proto_payment_proto_rawDescGZIP(), []int{0}
 }
 
+type InstallmentStatus int32
+
+const (
+	InstallmentStatus_INSTALLMENT_STATUS_UNKNOWN   InstallmentStatus = 0
+	InstallmentStatus_INSTALLMENT_STATUS_SCHEDULED InstallmentStatus = 1
+	InstallmentStatus_INSTALLMENT_STATUS_PAID      InstallmentStatus = 2
+	// Past its due date and not collected yet; collection is retried.
+	InstallmentStatus_INSTALLMENT_STATUS_OVERDUE InstallmentStatus = 3
+	// Left unpaid when the payment was refunded.
+	InstallmentStatus_INSTALLMENT_STATUS_CANCELLED InstallmentStatus = 4
+)
+
+// Enum value maps for InstallmentStatus.
+var (
+	InstallmentStatus_name = map[int32]string{
+		0: "INSTALLMENT_STATUS_UNKNOWN",
+		1: "INSTALLMENT_STATUS_SCHEDULED",
+		2: "INSTALLMENT_STATUS_PAID",
+		3: "INSTALLMENT_STATUS_OVERDUE",
+		4: "INSTALLMENT_STATUS_CANCELLED",
+	}
+	InstallmentStatus_value = map[string]int32{
+		"INSTALLMENT_STATUS_UNKNOWN":   0,
+		"INSTALLMENT_STATUS_SCHEDULED": 1,
+		"INSTALLMENT_STATUS_PAID":      2,
+		"INSTALLMENT_STATUS_OVERDUE":   3,
+		"INSTALLMENT_STATUS_CANCELLED": 4,
+	}
+)
+
+func (x InstallmentStatus) Enum() *InstallmentStatus {
+	p := new(InstallmentStatus)
+	*p = x
+	return p
+}
+
+func (x InstallmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (InstallmentStatus) Type() protoreflect.EnumType {
+	return &file_proto_payment_proto_enumTypes[1]
+}
+
+func (x InstallmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallmentStatus.Descriptor instead.
+func (InstallmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{1}
+}
+
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNKNOWN    TransactionStatus = 0
+	TransactionStatus_TRANSACTION_STATUS_AUTHORIZED TransactionStatus = 1
+	TransactionStatus_TRANSACTION_STATUS_PAID       TransactionStatus = 2
+	TransactionStatus_TRANSACTION_STATUS_VOIDED     TransactionStatus = 3
+	TransactionStatus_TRANSACTION_STATUS_EXPIRED    TransactionStatus = 4
+	TransactionStatus_TRANSACTION_STATUS_REFUNDED   TransactionStatus = 5
+	TransactionStatus_TRANSACTION_STATUS_PENDING    TransactionStatus = 6
+	TransactionStatus_TRANSACTION_STATUS_FAILED     TransactionStatus = 7
+	TransactionStatus_TRANSACTION_STATUS_REVIEW     TransactionStatus = 8
+	TransactionStatus_TRANSACTION_STATUS_REJECTED   TransactionStatus = 9
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNKNOWN",
+		1: "TRANSACTION_STATUS_AUTHORIZED",
+		2: "TRANSACTION_STATUS_PAID",
+		3: "TRANSACTION_STATUS_VOIDED",
+		4: "TRANSACTION_STATUS_EXPIRED",
+		5: "TRANSACTION_STATUS_REFUNDED",
+		6: "TRANSACTION_STATUS_PENDING",
+		7: "TRANSACTION_STATUS_FAILED",
+		8: "TRANSACTION_STATUS_REVIEW",
+		9: "TRANSACTION_STATUS_REJECTED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNKNOWN":    0,
+		"TRANSACTION_STATUS_AUTHORIZED": 1,
+		"TRANSACTION_STATUS_PAID":       2,
+		"TRANSACTION_STATUS_VOIDED":     3,
+		"TRANSACTION_STATUS_EXPIRED":    4,
+		"TRANSACTION_STATUS_REFUNDED":   5,
+		"TRANSACTION_STATUS_PENDING":    6,
+		"TRANSACTION_STATUS_FAILED":     7,
+		"TRANSACTION_STATUS_REVIEW":     8,
+		"TRANSACTION_STATUS_REJECTED":   9,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_proto_payment_proto_enumTypes[2]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{2}
+}
+
+type StatementEntryType int32
+
+const (
+	StatementEntryType_STATEMENT_ENTRY_TYPE_UNKNOWN StatementEntryType = 0
+	// A deposit or a refund.
+	StatementEntryType_STATEMENT_ENTRY_TYPE_CREDIT StatementEntryType = 1
+	// A payment.
+	StatementEntryType_STATEMENT_ENTRY_TYPE_DEBIT StatementEntryType = 2
+	// Money reserved by an authorization and released when it is captured
+	// or voided.
+	StatementEntryType_STATEMENT_ENTRY_TYPE_HOLD    StatementEntryType = 3
+	StatementEntryType_STATEMENT_ENTRY_TYPE_RELEASE StatementEntryType = 4
+)
+
+// Enum value maps for StatementEntryType.
+var (
+	StatementEntryType_name = map[int32]string{
+		0: "STATEMENT_ENTRY_TYPE_UNKNOWN",
+		1: "STATEMENT_ENTRY_TYPE_CREDIT",
+		2: "STATEMENT_ENTRY_TYPE_DEBIT",
+		3: "STATEMENT_ENTRY_TYPE_HOLD",
+		4: "STATEMENT_ENTRY_TYPE_RELEASE",
+	}
+	StatementEntryType_value = map[string]int32{
+		"STATEMENT_ENTRY_TYPE_UNKNOWN": 0,
+		"STATEMENT_ENTRY_TYPE_CREDIT":  1,
+		"STATEMENT_ENTRY_TYPE_DEBIT":   2,
+		"STATEMENT_ENTRY_TYPE_HOLD":    3,
+		"STATEMENT_ENTRY_TYPE_RELEASE": 4,
+	}
+)
+
+func (x StatementEntryType) Enum() *StatementEntryType {
+	p := new(StatementEntryType)
+	*p = x
+	return p
+}
+
+func (x StatementEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_payment_proto_enumTypes[3].Descriptor()
+}
+
+func (StatementEntryType) Type() protoreflect.EnumType {
+	return &file_proto_payment_proto_enumTypes[3]
+}
+
+func (x StatementEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementEntryType.Descriptor instead.
+func (StatementEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{3}
+}
+
+type RiskOutcome int32
+
+const (
+	RiskOutcome_RISK_OUTCOME_UNKNOWN RiskOutcome = 0
+	RiskOutcome_RISK_OUTCOME_APPROVE RiskOutcome = 1
+	RiskOutcome_RISK_OUTCOME_REVIEW  RiskOutcome = 2
+	RiskOutcome_RISK_OUTCOME_REJECT  RiskOutcome = 3
+)
+
+// Enum value maps for RiskOutcome.
+var (
+	RiskOutcome_name = map[int32]string{
+		0: "RISK_OUTCOME_UNKNOWN",
+		1: "RISK_OUTCOME_APPROVE",
+		2: "RISK_OUTCOME_REVIEW",
+		3: "RISK_OUTCOME_REJECT",
+	}
+	RiskOutcome_value = map[string]int32{
+		"RISK_OUTCOME_UNKNOWN": 0,
+		"RISK_OUTCOME_APPROVE": 1,
+		"RISK_OUTCOME_REVIEW":  2,
+		"RISK_OUTCOME_REJECT":  3,
+	}
+)
+
+func (x RiskOutcome) Enum() *RiskOutcome {
+	p := new(RiskOutcome)
+	*p = x
+	return p
+}
+
+func (x RiskOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_payment_proto_enumTypes[4].Descriptor()
+}
+
+func (RiskOutcome) Type() protoreflect.EnumType {
+	return &file_proto_payment_proto_enumTypes[4]
+}
+
+func (x RiskOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskOutcome.Descriptor instead.
+func (RiskOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{4}
+}
+
 type PayOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
 	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Amount to charge, in rubles.
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Splits a CREDIT_CARD payment into this many monthly installments at
+	// the interest rate configured for the term; 0 pays at once. A term that
+	// is not offered fails with InvalidArgument.
+	InstallmentMonths int32 `protobuf:"varint,5,opt,name=installment_months,json=installmentMonths,proto3" json:"installment_months,omitempty"`
+	// Optional key of the payment attempt, chosen by the client. PayOrder is
+	// idempotent: a request with the key of an earlier payment of the order
+	// returns that payment instead of charging again, and so does any request
+	// while the order has a payment in progress or made. Paying such an order
+	// under another key, or with another payment method, amount or
+	// installment term, fails with AlreadyExists.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
@@ -137,9 +388,55 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_UNKNOWN
 }
 
+func (x *PayOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetInstallmentMonths() int32 {
+	if x != nil {
+		return x.InstallmentMonths
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// Failed payments are reported with a google.rpc.ErrorInfo detail: reason is
+// the provider failure code (DECLINED, INSUFFICIENT_FUNDS, LIMIT_EXCEEDED,
+// ...) and metadata["provider"] the provider that failed.
+//
+// Payment methods confirmed asynchronously (SBP) return status PENDING: the
+// payment is not done yet. For SBP provider_reference is the QR code link.
+// The outcome is known after the provider callback; poll GetPaymentStatus
+// until the status is final. A payment not confirmed by expires_at expires.
+//
+// A payment conflicting with the one the order already has fails with
+// AlreadyExists and reason ALREADY_PAID; metadata holds the existing
+// transaction_uuid, status, payment_method and amount.
+//
+// Payments the risk checks reject fail with FailedPrecondition and reason
+// RISK_REJECTED. Payments they hold for an operator return status REVIEW:
+// nothing is charged until ApprovePayment, RejectPayment makes them
+// REJECTED, and without a review by expires_at they expire.
 type PayOrderResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Provider that charged the payment and its reference of the charge.
+	Provider          string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderReference string `protobuf:"bytes,3,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	// PAID, PENDING or REVIEW.
+	Status    TransactionStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Set when installment_months was requested.
+	InstallmentPlan *InstallmentPlan `protobuf:"bytes,6,opt,name=installment_plan,json=installmentPlan,proto3" json:"installment_plan,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -181,27 +478,2562 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
-var File_proto_payment_proto protoreflect.FileDescriptor
+func (x *PayOrderResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
 
-const file_proto_payment_proto_rawDesc = "" +
-	"\n" +
-	"\x13proto/payment.proto\x12\n" +
-	"payment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
-	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
-	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*T\n" +
+func (x *PayOrderResponse) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *PayOrderResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+func (x *PayOrderResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PayOrderResponse) GetInstallmentPlan() *InstallmentPlan {
+	if x != nil {
+		return x.InstallmentPlan
+	}
+	return nil
+}
+
+type Installment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Number    int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Principal float64                `protobuf:"fixed64,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest  float64                `protobuf:"fixed64,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	// principal + interest.
+	Amount float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status InstallmentStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=payment.v1.InstallmentStatus" json:"status,omitempty"`
+	PaidAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// Why the last collection attempt failed, for OVERDUE installments.
+	FailureCode   string `protobuf:"bytes,8,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_proto_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Installment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Installment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Installment) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Installment) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *Installment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Installment) GetStatus() InstallmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstallmentStatus_INSTALLMENT_STATUS_UNKNOWN
+}
+
+func (x *Installment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Installment) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+type InstallmentPlan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Months          int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	// Annual interest rate, in percent.
+	AnnualRate float64 `protobuf:"fixed64,3,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`
+	// Everything the customer pays over the plan, interest included.
+	TotalAmount      float64 `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalInterest    float64 `protobuf:"fixed64,5,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	PaidAmount       float64 `protobuf:"fixed64,6,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	RemainingBalance float64 `protobuf:"fixed64,7,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	OverdueAmount    float64 `protobuf:"fixed64,8,opt,name=overdue_amount,json=overdueAmount,proto3" json:"overdue_amount,omitempty"`
+	// Unset once the plan is paid off.
+	NextDueDate   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
+	NextAmount    float64                `protobuf:"fixed64,10,opt,name=next_amount,json=nextAmount,proto3" json:"next_amount,omitempty"`
+	Installments  []*Installment         `protobuf:"bytes,11,rep,name=installments,proto3" json:"installments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlan) Reset() {
+	*x = InstallmentPlan{}
+	mi := &file_proto_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlan) ProtoMessage() {}
+
+func (x *InstallmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlan.ProtoReflect.Descriptor instead.
+func (*InstallmentPlan) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstallmentPlan) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetAnnualRate() float64 {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetPaidAmount() float64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetRemainingBalance() float64 {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetOverdueAmount() float64 {
+	if x != nil {
+		return x.OverdueAmount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetNextDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return nil
+}
+
+func (x *InstallmentPlan) GetNextAmount() float64 {
+	if x != nil {
+		return x.NextAmount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+type GetInstallmentPlanRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanRequest) Reset() {
+	*x = GetInstallmentPlanRequest{}
+	mi := &file_proto_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *GetInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInstallmentPlanRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_proto_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RefundPaymentResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RefundTransactionUuid string                 `protobuf:"bytes,1,opt,name=refund_transaction_uuid,json=refundTransactionUuid,proto3" json:"refund_transaction_uuid,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_proto_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefundPaymentResponse) GetRefundTransactionUuid() string {
+	if x != nil {
+		return x.RefundTransactionUuid
+	}
+	return ""
+}
+
+type GetPaymentStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusRequest) Reset() {
+	*x = GetPaymentStatusRequest{}
+	mi := &file_proto_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusRequest) ProtoMessage() {}
+
+func (x *GetPaymentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPaymentStatusRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+type GetPaymentStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid   string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid         string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	Status            TransactionStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	Amount            float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CapturedAmount    float64                `protobuf:"fixed64,5,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	Provider          string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,7,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	// Why a FAILED or EXPIRED payment did not go through: the provider
+	// failure code and its message.
+	FailureCode    string `protobuf:"bytes,8,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	FailureMessage string `protobuf:"bytes,9,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	// Until when a PENDING payment can be confirmed or an AUTHORIZED one
+	// captured.
+	ExpiresAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserUuid              string                 `protobuf:"bytes,12,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod         PaymentMethod          `protobuf:"varint,13,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	RefundTransactionUuid string                 `protobuf:"bytes,14,opt,name=refund_transaction_uuid,json=refundTransactionUuid,proto3" json:"refund_transaction_uuid,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusResponse) Reset() {
+	*x = GetPaymentStatusResponse{}
+	mi := &file_proto_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusResponse) ProtoMessage() {}
+
+func (x *GetPaymentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPaymentStatusResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+func (x *GetPaymentStatusResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GetPaymentStatusResponse) GetCapturedAmount() float64 {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return 0
+}
+
+func (x *GetPaymentStatusResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GetPaymentStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetPaymentStatusResponse) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_UNKNOWN
+}
+
+func (x *GetPaymentStatusResponse) GetRefundTransactionUuid() string {
+	if x != nil {
+		return x.RefundTransactionUuid
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Not every method can be authorized: SBP payments are always one-step and
+// fail with UNSUPPORTED_METHOD.
+type AuthorizePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Amount to hold, in rubles.
+	Amount        float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentRequest) Reset() {
+	*x = AuthorizePaymentRequest{}
+	mi := &file_proto_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentRequest) ProtoMessage() {}
+
+func (x *AuthorizePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthorizePaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_UNKNOWN
+}
+
+func (x *AuthorizePaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AuthorizePaymentResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid   string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Provider          string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,3,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	// The hold is released if the payment is not captured by then.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentResponse) Reset() {
+	*x = AuthorizePaymentResponse{}
+	mi := &file_proto_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentResponse) ProtoMessage() {}
+
+func (x *AuthorizePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AuthorizePaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// An authorization is captured once. A capture after expires_at fails with
+// FailedPrecondition and reason AUTHORIZATION_EXPIRED.
+type CapturePaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Amount to capture, at most the authorized one; 0 captures all of it.
+	// The rest of the hold is released.
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_proto_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CapturePaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *CapturePaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CapturePaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	CapturedAmount  float64                `protobuf:"fixed64,2,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	ReleasedAmount  float64                `protobuf:"fixed64,3,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"`
+	Status          TransactionStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CapturePaymentResponse) Reset() {
+	*x = CapturePaymentResponse{}
+	mi := &file_proto_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentResponse) ProtoMessage() {}
+
+func (x *CapturePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CapturePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CapturePaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *CapturePaymentResponse) GetCapturedAmount() float64 {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return 0
+}
+
+func (x *CapturePaymentResponse) GetReleasedAmount() float64 {
+	if x != nil {
+		return x.ReleasedAmount
+	}
+	return 0
+}
+
+func (x *CapturePaymentResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+type VoidAuthorizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationRequest) Reset() {
+	*x = VoidAuthorizationRequest{}
+	mi := &file_proto_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationRequest) ProtoMessage() {}
+
+func (x *VoidAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VoidAuthorizationRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *VoidAuthorizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Voiding an expired or already voided authorization succeeds with its
+// current status. Voiding a payment still PENDING or in REVIEW expires it,
+// so that a confirmation arriving later does not charge the payer.
+type VoidAuthorizationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Status          TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationResponse) Reset() {
+	*x = VoidAuthorizationResponse{}
+	mi := &file_proto_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationResponse) ProtoMessage() {}
+
+func (x *VoidAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VoidAuthorizationResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *VoidAuthorizationResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+// An investor account pays INVESTOR_MONEY orders. Payments over the
+// per-order or monthly limit fail with FailedPrecondition and reason
+// LIMIT_EXCEEDED, payments over the available money with reason
+// INSUFFICIENT_FUNDS. Limits of 0 mean no limit.
+type InvestorAccount struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Money on the account, held money included.
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Reserved by authorizations that are not captured yet.
+	Held          float64 `protobuf:"fixed64,3,opt,name=held,proto3" json:"held,omitempty"`
+	Available     float64 `protobuf:"fixed64,4,opt,name=available,proto3" json:"available,omitempty"`
+	PerOrderLimit float64 `protobuf:"fixed64,5,opt,name=per_order_limit,json=perOrderLimit,proto3" json:"per_order_limit,omitempty"`
+	MonthlyLimit  float64 `protobuf:"fixed64,6,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	// Paid for orders in the current month (UTC) net of refunds, holds
+	// included.
+	SpentThisMonth float64                `protobuf:"fixed64,7,opt,name=spent_this_month,json=spentThisMonth,proto3" json:"spent_this_month,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvestorAccount) Reset() {
+	*x = InvestorAccount{}
+	mi := &file_proto_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestorAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestorAccount) ProtoMessage() {}
+
+func (x *InvestorAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestorAccount.ProtoReflect.Descriptor instead.
+func (*InvestorAccount) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InvestorAccount) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InvestorAccount) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetHeld() float64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetPerOrderLimit() float64 {
+	if x != nil {
+		return x.PerOrderLimit
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetSpentThisMonth() float64 {
+	if x != nil {
+		return x.SpentThisMonth
+	}
+	return 0
+}
+
+func (x *InvestorAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InvestorAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateInvestorAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PerOrderLimit float64                `protobuf:"fixed64,2,opt,name=per_order_limit,json=perOrderLimit,proto3" json:"per_order_limit,omitempty"`
+	MonthlyLimit  float64                `protobuf:"fixed64,3,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvestorAccountRequest) Reset() {
+	*x = CreateInvestorAccountRequest{}
+	mi := &file_proto_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvestorAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvestorAccountRequest) ProtoMessage() {}
+
+func (x *CreateInvestorAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvestorAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvestorAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateInvestorAccountRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *CreateInvestorAccountRequest) GetPerOrderLimit() float64 {
+	if x != nil {
+		return x.PerOrderLimit
+	}
+	return 0
+}
+
+func (x *CreateInvestorAccountRequest) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+type DepositInvestorFundsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Amount   float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The reference of the incoming transfer, shown in the statement.
+	Reference     string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositInvestorFundsRequest) Reset() {
+	*x = DepositInvestorFundsRequest{}
+	mi := &file_proto_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositInvestorFundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositInvestorFundsRequest) ProtoMessage() {}
+
+func (x *DepositInvestorFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositInvestorFundsRequest.ProtoReflect.Descriptor instead.
+func (*DepositInvestorFundsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DepositInvestorFundsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *DepositInvestorFundsRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DepositInvestorFundsRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type SetInvestorLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PerOrderLimit float64                `protobuf:"fixed64,2,opt,name=per_order_limit,json=perOrderLimit,proto3" json:"per_order_limit,omitempty"`
+	MonthlyLimit  float64                `protobuf:"fixed64,3,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInvestorLimitsRequest) Reset() {
+	*x = SetInvestorLimitsRequest{}
+	mi := &file_proto_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInvestorLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvestorLimitsRequest) ProtoMessage() {}
+
+func (x *SetInvestorLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvestorLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetInvestorLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetInvestorLimitsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *SetInvestorLimitsRequest) GetPerOrderLimit() float64 {
+	if x != nil {
+		return x.PerOrderLimit
+	}
+	return 0
+}
+
+func (x *SetInvestorLimitsRequest) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+type GetInvestorAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorAccountRequest) Reset() {
+	*x = GetInvestorAccountRequest{}
+	mi := &file_proto_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorAccountRequest) ProtoMessage() {}
+
+func (x *GetInvestorAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestorAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetInvestorAccountRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+type StatementEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EntryUuid string                 `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	Type      StatementEntryType     `protobuf:"varint,2,opt,name=type,proto3,enum=payment.v1.StatementEntryType" json:"type,omitempty"`
+	Amount    float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Empty for deposits.
+	OrderUuid   string `protobuf:"bytes,4,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	Reference   string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// Account balance and available money after the entry.
+	Balance       float64                `protobuf:"fixed64,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	Available     float64                `protobuf:"fixed64,8,opt,name=available,proto3" json:"available,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementEntry) Reset() {
+	*x = StatementEntry{}
+	mi := &file_proto_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementEntry) ProtoMessage() {}
+
+func (x *StatementEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementEntry.ProtoReflect.Descriptor instead.
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StatementEntry) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetType() StatementEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return StatementEntryType_STATEMENT_ENTRY_TYPE_UNKNOWN
+}
+
+func (x *StatementEntry) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StatementEntry) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *StatementEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// The statement covers [from, to); either end may be left unset.
+type GetInvestorStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorStatementRequest) Reset() {
+	*x = GetInvestorStatementRequest{}
+	mi := &file_proto_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorStatementRequest) ProtoMessage() {}
+
+func (x *GetInvestorStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestorStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetInvestorStatementRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *GetInvestorStatementRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetInvestorStatementRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetInvestorStatementResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *InvestorAccount       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Entries []*StatementEntry      `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Sums of the CREDIT and DEBIT entries of the statement.
+	TotalCredits  float64 `protobuf:"fixed64,3,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	TotalDebits   float64 `protobuf:"fixed64,4,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorStatementResponse) Reset() {
+	*x = GetInvestorStatementResponse{}
+	mi := &file_proto_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorStatementResponse) ProtoMessage() {}
+
+func (x *GetInvestorStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestorStatementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetInvestorStatementResponse) GetAccount() *InvestorAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *GetInvestorStatementResponse) GetEntries() []*StatementEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetInvestorStatementResponse) GetTotalCredits() float64 {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return 0
+}
+
+func (x *GetInvestorStatementResponse) GetTotalDebits() float64 {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return 0
+}
+
+// A risk rule that fired: velocity, method_amount, first_order, two_step
+// (an authorization the rules would hold) or manual_review.
+type RiskReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Outcome       RiskOutcome            `protobuf:"varint,2,opt,name=outcome,proto3,enum=payment.v1.RiskOutcome" json:"outcome,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskReason) Reset() {
+	*x = RiskReason{}
+	mi := &file_proto_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskReason) ProtoMessage() {}
+
+func (x *RiskReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskReason.ProtoReflect.Descriptor instead.
+func (*RiskReason) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RiskReason) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RiskReason) GetOutcome() RiskOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RiskOutcome_RISK_OUTCOME_UNKNOWN
+}
+
+func (x *RiskReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The outcome of the risk checks of a payment, or of an operator's review
+// when operator is set.
+type RiskDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DecisionUuid  string                 `protobuf:"bytes,1,opt,name=decision_uuid,json=decisionUuid,proto3" json:"decision_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,4,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Outcome       RiskOutcome            `protobuf:"varint,6,opt,name=outcome,proto3,enum=payment.v1.RiskOutcome" json:"outcome,omitempty"`
+	Reasons       []*RiskReason          `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Empty for payments rejected by the checks.
+	TransactionUuid string                 `protobuf:"bytes,8,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Operator        string                 `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RiskDecision) Reset() {
+	*x = RiskDecision{}
+	mi := &file_proto_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskDecision) ProtoMessage() {}
+
+func (x *RiskDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskDecision.ProtoReflect.Descriptor instead.
+func (*RiskDecision) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RiskDecision) GetDecisionUuid() string {
+	if x != nil {
+		return x.DecisionUuid
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_UNKNOWN
+}
+
+func (x *RiskDecision) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetOutcome() RiskOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RiskOutcome_RISK_OUTCOME_UNKNOWN
+}
+
+func (x *RiskDecision) GetReasons() []*RiskReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RiskDecision) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Only payments in REVIEW can be approved or rejected; others fail with
+// FailedPrecondition.
+type ReviewPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Operator        string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Comment         string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewPaymentRequest) Reset() {
+	*x = ReviewPaymentRequest{}
+	mi := &file_proto_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPaymentRequest) ProtoMessage() {}
+
+func (x *ReviewPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ReviewPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReviewPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ReviewPaymentRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ReviewPaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// An approved payment is charged: it is PAID, PENDING for asynchronous
+// methods, or FAILED if the provider refused it.
+type ReviewPaymentResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Status          TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	FailureCode     string                 `protobuf:"bytes,3,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	FailureMessage  string                 `protobuf:"bytes,4,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	Decision        *RiskDecision          `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewPaymentResponse) Reset() {
+	*x = ReviewPaymentResponse{}
+	mi := &file_proto_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPaymentResponse) ProtoMessage() {}
+
+func (x *ReviewPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ReviewPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReviewPaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ReviewPaymentResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+func (x *ReviewPaymentResponse) GetFailureCode() string {
+	if x != nil {
+		return x.FailureCode
+	}
+	return ""
+}
+
+func (x *ReviewPaymentResponse) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+func (x *ReviewPaymentResponse) GetDecision() *RiskDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+// Empty fields match every decision.
+type ListRiskDecisionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid       string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Outcome         RiskOutcome            `protobuf:"varint,4,opt,name=outcome,proto3,enum=payment.v1.RiskOutcome" json:"outcome,omitempty"`
+	// Only the payments still waiting in REVIEW.
+	AwaitingReview bool `protobuf:"varint,5,opt,name=awaiting_review,json=awaitingReview,proto3" json:"awaiting_review,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRiskDecisionsRequest) Reset() {
+	*x = ListRiskDecisionsRequest{}
+	mi := &file_proto_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskDecisionsRequest) ProtoMessage() {}
+
+func (x *ListRiskDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListRiskDecisionsRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ListRiskDecisionsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListRiskDecisionsRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ListRiskDecisionsRequest) GetOutcome() RiskOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RiskOutcome_RISK_OUTCOME_UNKNOWN
+}
+
+func (x *ListRiskDecisionsRequest) GetAwaitingReview() bool {
+	if x != nil {
+		return x.AwaitingReview
+	}
+	return false
+}
+
+type ListRiskDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*RiskDecision        `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskDecisionsResponse) Reset() {
+	*x = ListRiskDecisionsResponse{}
+	mi := &file_proto_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskDecisionsResponse) ProtoMessage() {}
+
+func (x *ListRiskDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRiskDecisionsResponse) GetDecisions() []*RiskDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// A transaction as stored by payment-service, for reconciliation.
+type Transaction struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid       string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid             string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid              string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod         PaymentMethod          `protobuf:"varint,4,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	Status                TransactionStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CapturedAmount        float64                `protobuf:"fixed64,7,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	Provider              string                 `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderReference     string                 `protobuf:"bytes,9,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	RefundTransactionUuid string                 `protobuf:"bytes,10,opt,name=refund_transaction_uuid,json=refundTransactionUuid,proto3" json:"refund_transaction_uuid,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_proto_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Transaction) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_UNKNOWN
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNKNOWN
+}
+
+func (x *Transaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetCapturedAmount() float64 {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Transaction) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *Transaction) GetRefundTransactionUuid() string {
+	if x != nil {
+		return x.RefundTransactionUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Lists the transactions created in [created_from, created_to), oldest
+// first; either end may be left unset. Empty statuses match all of them.
+type ListTransactionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Statuses    []TransactionStatus    `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=payment.v1.TransactionStatus" json:"statuses,omitempty"`
+	// At most 1000, 100 if unset.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_proto_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTransactionsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetStatuses() []TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTransactionsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Transactions []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_proto_payment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_proto_payment_proto protoreflect.FileDescriptor
+
+const file_proto_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/payment.proto\x12\n" +
+	"payment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x01\n" +
+	"\x0fPayOrderRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12-\n" +
+	"\x12installment_months\x18\x05 \x01(\x05R\x11installmentMonths\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"\xc2\x02\n" +
+	"\x10PayOrderResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12-\n" +
+	"\x12provider_reference\x18\x03 \x01(\tR\x11providerReference\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12F\n" +
+	"\x10installment_plan\x18\x06 \x01(\v2\x1b.payment.v1.InstallmentPlanR\x0finstallmentPlan\"\xbd\x02\n" +
+	"\vInstallment\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x125\n" +
+	"\bdue_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\x01R\tprincipal\x12\x1a\n" +
+	"\binterest\x18\x04 \x01(\x01R\binterest\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.payment.v1.InstallmentStatusR\x06status\x123\n" +
+	"\apaid_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12!\n" +
+	"\ffailure_code\x18\b \x01(\tR\vfailureCode\"\xd2\x03\n" +
+	"\x0fInstallmentPlan\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\x12\x1f\n" +
+	"\vannual_rate\x18\x03 \x01(\x01R\n" +
+	"annualRate\x12!\n" +
+	"\ftotal_amount\x18\x04 \x01(\x01R\vtotalAmount\x12%\n" +
+	"\x0etotal_interest\x18\x05 \x01(\x01R\rtotalInterest\x12\x1f\n" +
+	"\vpaid_amount\x18\x06 \x01(\x01R\n" +
+	"paidAmount\x12+\n" +
+	"\x11remaining_balance\x18\a \x01(\x01R\x10remainingBalance\x12%\n" +
+	"\x0eoverdue_amount\x18\b \x01(\x01R\roverdueAmount\x12>\n" +
+	"\rnext_due_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vnextDueDate\x12\x1f\n" +
+	"\vnext_amount\x18\n" +
+	" \x01(\x01R\n" +
+	"nextAmount\x12;\n" +
+	"\finstallments\x18\v \x03(\v2\x17.payment.v1.InstallmentR\finstallments\"F\n" +
+	"\x19GetInstallmentPlanRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\x95\x01\n" +
+	"\x14RefundPaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"O\n" +
+	"\x15RefundPaymentResponse\x126\n" +
+	"\x17refund_transaction_uuid\x18\x01 \x01(\tR\x15refundTransactionUuid\"D\n" +
+	"\x17GetPaymentStatusRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\xbb\x05\n" +
+	"\x18GetPaymentStatusResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\x05 \x01(\x01R\x0ecapturedAmount\x12\x1a\n" +
+	"\bprovider\x18\x06 \x01(\tR\bprovider\x12-\n" +
+	"\x12provider_reference\x18\a \x01(\tR\x11providerReference\x12!\n" +
+	"\ffailure_code\x18\b \x01(\tR\vfailureCode\x12'\n" +
+	"\x0ffailure_message\x18\t \x01(\tR\x0efailureMessage\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tuser_uuid\x18\f \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\r \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x126\n" +
+	"\x17refund_transaction_uuid\x18\x0e \x01(\tR\x15refundTransactionUuid\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaf\x01\n" +
+	"\x17AuthorizePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"\xcb\x01\n" +
+	"\x18AuthorizePaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12-\n" +
+	"\x12provider_reference\x18\x03 \x01(\tR\x11providerReference\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Z\n" +
+	"\x15CapturePaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xcc\x01\n" +
+	"\x16CapturePaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12'\n" +
+	"\x0fcaptured_amount\x18\x02 \x01(\x01R\x0ecapturedAmount\x12'\n" +
+	"\x0freleased_amount\x18\x03 \x01(\x01R\x0ereleasedAmount\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\"]\n" +
+	"\x18VoidAuthorizationRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"}\n" +
+	"\x19VoidAuthorizationResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\"\xe7\x02\n" +
+	"\x0fInvestorAccount\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x12\n" +
+	"\x04held\x18\x03 \x01(\x01R\x04held\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x01R\tavailable\x12&\n" +
+	"\x0fper_order_limit\x18\x05 \x01(\x01R\rperOrderLimit\x12#\n" +
+	"\rmonthly_limit\x18\x06 \x01(\x01R\fmonthlyLimit\x12(\n" +
+	"\x10spent_this_month\x18\a \x01(\x01R\x0espentThisMonth\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x88\x01\n" +
+	"\x1cCreateInvestorAccountRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12&\n" +
+	"\x0fper_order_limit\x18\x02 \x01(\x01R\rperOrderLimit\x12#\n" +
+	"\rmonthly_limit\x18\x03 \x01(\x01R\fmonthlyLimit\"p\n" +
+	"\x1bDepositInvestorFundsRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"\x84\x01\n" +
+	"\x18SetInvestorLimitsRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12&\n" +
+	"\x0fper_order_limit\x18\x02 \x01(\x01R\rperOrderLimit\x12#\n" +
+	"\rmonthly_limit\x18\x03 \x01(\x01R\fmonthlyLimit\"8\n" +
+	"\x19GetInvestorAccountRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"\xcd\x02\n" +
+	"\x0eStatementEntry\x12\x1d\n" +
+	"\n" +
+	"entry_uuid\x18\x01 \x01(\tR\tentryUuid\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.payment.v1.StatementEntryTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x04 \x01(\tR\torderUuid\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\abalance\x18\a \x01(\x01R\abalance\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\x01R\tavailable\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x96\x01\n" +
+	"\x1bGetInvestorStatementRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xd3\x01\n" +
+	"\x1cGetInvestorStatementResponse\x125\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1b.payment.v1.InvestorAccountR\aaccount\x124\n" +
+	"\aentries\x18\x02 \x03(\v2\x1a.payment.v1.StatementEntryR\aentries\x12#\n" +
+	"\rtotal_credits\x18\x03 \x01(\x01R\ftotalCredits\x12!\n" +
+	"\ftotal_debits\x18\x04 \x01(\x01R\vtotalDebits\"m\n" +
+	"\n" +
+	"RiskReason\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x121\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x17.payment.v1.RiskOutcomeR\aoutcome\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb0\x03\n" +
+	"\fRiskDecision\x12#\n" +
+	"\rdecision_uuid\x18\x01 \x01(\tR\fdecisionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x121\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x17.payment.v1.RiskOutcomeR\aoutcome\x120\n" +
+	"\areasons\x18\a \x03(\v2\x16.payment.v1.RiskReasonR\areasons\x12)\n" +
+	"\x10transaction_uuid\x18\b \x01(\tR\x0ftransactionUuid\x12\x1a\n" +
+	"\boperator\x18\t \x01(\tR\boperator\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"w\n" +
+	"\x14ReviewPaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\xfb\x01\n" +
+	"\x15ReviewPaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x12!\n" +
+	"\ffailure_code\x18\x03 \x01(\tR\vfailureCode\x12'\n" +
+	"\x0ffailure_message\x18\x04 \x01(\tR\x0efailureMessage\x124\n" +
+	"\bdecision\x18\x05 \x01(\v2\x18.payment.v1.RiskDecisionR\bdecision\"\xdd\x01\n" +
+	"\x18ListRiskDecisionsRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\x121\n" +
+	"\aoutcome\x18\x04 \x01(\x0e2\x17.payment.v1.RiskOutcomeR\aoutcome\x12'\n" +
+	"\x0fawaiting_review\x18\x05 \x01(\bR\x0eawaitingReview\"S\n" +
+	"\x19ListRiskDecisionsResponse\x126\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x18.payment.v1.RiskDecisionR\tdecisions\"\xa7\x04\n" +
+	"\vTransaction\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\a \x01(\x01R\x0ecapturedAmount\x12\x1a\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\x12-\n" +
+	"\x12provider_reference\x18\t \x01(\tR\x11providerReference\x126\n" +
+	"\x17refund_transaction_uuid\x18\n" +
+	" \x01(\tR\x15refundTransactionUuid\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x02\n" +
+	"\x17ListTransactionsRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x129\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x1d.payment.v1.TransactionStatusR\bstatuses\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x18ListTransactionsResponse\x12;\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x17.payment.v1.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*T\n" +
 	"\rPaymentMethod\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\b\n" +
 	"\x04CARD\x10\x01\x12\a\n" +
 	"\x03SBP\x10\x02\x12\x0f\n" +
 	"\vCREDIT_CARD\x10\x03\x12\x12\n" +
-	"\x0eINVESTOR_MONEY\x10\x042W\n" +
+	"\x0eINVESTOR_MONEY\x10\x04*\xb4\x01\n" +
+	"\x11InstallmentStatus\x12\x1e\n" +
+	"\x1aINSTALLMENT_STATUS_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cINSTALLMENT_STATUS_SCHEDULED\x10\x01\x12\x1b\n" +
+	"\x17INSTALLMENT_STATUS_PAID\x10\x02\x12\x1e\n" +
+	"\x1aINSTALLMENT_STATUS_OVERDUE\x10\x03\x12 \n" +
+	"\x1cINSTALLMENT_STATUS_CANCELLED\x10\x04*\xd2\x02\n" +
+	"\x11TransactionStatus\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dTRANSACTION_STATUS_AUTHORIZED\x10\x01\x12\x1b\n" +
+	"\x17TRANSACTION_STATUS_PAID\x10\x02\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_VOIDED\x10\x03\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_EXPIRED\x10\x04\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_REFUNDED\x10\x05\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x06\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_FAILED\x10\a\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_REVIEW\x10\b\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_REJECTED\x10\t*\xb8\x01\n" +
+	"\x12StatementEntryType\x12 \n" +
+	"\x1cSTATEMENT_ENTRY_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
+	"\x1bSTATEMENT_ENTRY_TYPE_CREDIT\x10\x01\x12\x1e\n" +
+	"\x1aSTATEMENT_ENTRY_TYPE_DEBIT\x10\x02\x12\x1d\n" +
+	"\x19STATEMENT_ENTRY_TYPE_HOLD\x10\x03\x12 \n" +
+	"\x1cSTATEMENT_ENTRY_TYPE_RELEASE\x10\x04*s\n" +
+	"\vRiskOutcome\x12\x18\n" +
+	"\x14RISK_OUTCOME_UNKNOWN\x10\x00\x12\x18\n" +
+	"\x14RISK_OUTCOME_APPROVE\x10\x01\x12\x17\n" +
+	"\x13RISK_OUTCOME_REVIEW\x10\x02\x12\x17\n" +
+	"\x13RISK_OUTCOME_REJECT\x10\x032\xc9\v\n" +
 	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseB3Z1payment-service/internal/grpc/paymentpb;paymentpbb\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12T\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponse\x12]\n" +
+	"\x10AuthorizePayment\x12#.payment.v1.AuthorizePaymentRequest\x1a$.payment.v1.AuthorizePaymentResponse\x12W\n" +
+	"\x0eCapturePayment\x12!.payment.v1.CapturePaymentRequest\x1a\".payment.v1.CapturePaymentResponse\x12`\n" +
+	"\x11VoidAuthorization\x12$.payment.v1.VoidAuthorizationRequest\x1a%.payment.v1.VoidAuthorizationResponse\x12]\n" +
+	"\x10GetPaymentStatus\x12#.payment.v1.GetPaymentStatusRequest\x1a$.payment.v1.GetPaymentStatusResponse\x12^\n" +
+	"\x15CreateInvestorAccount\x12(.payment.v1.CreateInvestorAccountRequest\x1a\x1b.payment.v1.InvestorAccount\x12\\\n" +
+	"\x14DepositInvestorFunds\x12'.payment.v1.DepositInvestorFundsRequest\x1a\x1b.payment.v1.InvestorAccount\x12V\n" +
+	"\x11SetInvestorLimits\x12$.payment.v1.SetInvestorLimitsRequest\x1a\x1b.payment.v1.InvestorAccount\x12X\n" +
+	"\x12GetInvestorAccount\x12%.payment.v1.GetInvestorAccountRequest\x1a\x1b.payment.v1.InvestorAccount\x12i\n" +
+	"\x14GetInvestorStatement\x12'.payment.v1.GetInvestorStatementRequest\x1a(.payment.v1.GetInvestorStatementResponse\x12X\n" +
+	"\x12GetInstallmentPlan\x12%.payment.v1.GetInstallmentPlanRequest\x1a\x1b.payment.v1.InstallmentPlan\x12U\n" +
+	"\x0eApprovePayment\x12 .payment.v1.ReviewPaymentRequest\x1a!.payment.v1.ReviewPaymentResponse\x12T\n" +
+	"\rRejectPayment\x12 .payment.v1.ReviewPaymentRequest\x1a!.payment.v1.ReviewPaymentResponse\x12`\n" +
+	"\x11ListRiskDecisions\x12$.payment.v1.ListRiskDecisionsRequest\x1a%.payment.v1.ListRiskDecisionsResponse\x12]\n" +
+	"\x10ListTransactions\x12#.payment.v1.ListTransactionsRequest\x1a$.payment.v1.ListTransactionsResponseB*Z(payment-service/grpc/paymentpb;paymentpbb\x06proto3"
 
 var (
 	file_proto_payment_proto_rawDescOnce sync.Once
@@ -215,22 +3047,129 @@ func file_proto_payment_proto_rawDescGZIP() []byte {
 	return file_proto_payment_proto_rawDescData
 }
 
-var file_proto_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_proto_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(PaymentMethod)(0),                   // 0: payment.v1.PaymentMethod
+	(InstallmentStatus)(0),               // 1: payment.v1.InstallmentStatus
+	(TransactionStatus)(0),               // 2: payment.v1.TransactionStatus
+	(StatementEntryType)(0),              // 3: payment.v1.StatementEntryType
+	(RiskOutcome)(0),                     // 4: payment.v1.RiskOutcome
+	(*PayOrderRequest)(nil),              // 5: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),             // 6: payment.v1.PayOrderResponse
+	(*Installment)(nil),                  // 7: payment.v1.Installment
+	(*InstallmentPlan)(nil),              // 8: payment.v1.InstallmentPlan
+	(*GetInstallmentPlanRequest)(nil),    // 9: payment.v1.GetInstallmentPlanRequest
+	(*RefundPaymentRequest)(nil),         // 10: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),        // 11: payment.v1.RefundPaymentResponse
+	(*GetPaymentStatusRequest)(nil),      // 12: payment.v1.GetPaymentStatusRequest
+	(*GetPaymentStatusResponse)(nil),     // 13: payment.v1.GetPaymentStatusResponse
+	(*AuthorizePaymentRequest)(nil),      // 14: payment.v1.AuthorizePaymentRequest
+	(*AuthorizePaymentResponse)(nil),     // 15: payment.v1.AuthorizePaymentResponse
+	(*CapturePaymentRequest)(nil),        // 16: payment.v1.CapturePaymentRequest
+	(*CapturePaymentResponse)(nil),       // 17: payment.v1.CapturePaymentResponse
+	(*VoidAuthorizationRequest)(nil),     // 18: payment.v1.VoidAuthorizationRequest
+	(*VoidAuthorizationResponse)(nil),    // 19: payment.v1.VoidAuthorizationResponse
+	(*InvestorAccount)(nil),              // 20: payment.v1.InvestorAccount
+	(*CreateInvestorAccountRequest)(nil), // 21: payment.v1.CreateInvestorAccountRequest
+	(*DepositInvestorFundsRequest)(nil),  // 22: payment.v1.DepositInvestorFundsRequest
+	(*SetInvestorLimitsRequest)(nil),     // 23: payment.v1.SetInvestorLimitsRequest
+	(*GetInvestorAccountRequest)(nil),    // 24: payment.v1.GetInvestorAccountRequest
+	(*StatementEntry)(nil),               // 25: payment.v1.StatementEntry
+	(*GetInvestorStatementRequest)(nil),  // 26: payment.v1.GetInvestorStatementRequest
+	(*GetInvestorStatementResponse)(nil), // 27: payment.v1.GetInvestorStatementResponse
+	(*RiskReason)(nil),                   // 28: payment.v1.RiskReason
+	(*RiskDecision)(nil),                 // 29: payment.v1.RiskDecision
+	(*ReviewPaymentRequest)(nil),         // 30: payment.v1.ReviewPaymentRequest
+	(*ReviewPaymentResponse)(nil),        // 31: payment.v1.ReviewPaymentResponse
+	(*ListRiskDecisionsRequest)(nil),     // 32: payment.v1.ListRiskDecisionsRequest
+	(*ListRiskDecisionsResponse)(nil),    // 33: payment.v1.ListRiskDecisionsResponse
+	(*Transaction)(nil),                  // 34: payment.v1.Transaction
+	(*ListTransactionsRequest)(nil),      // 35: payment.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),     // 36: payment.v1.ListTransactionsResponse
+	(*timestamppb.Timestamp)(nil),        // 37: google.protobuf.Timestamp
 }
 var file_proto_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	2,  // 1: payment.v1.PayOrderResponse.status:type_name -> payment.v1.TransactionStatus
+	37, // 2: payment.v1.PayOrderResponse.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: payment.v1.PayOrderResponse.installment_plan:type_name -> payment.v1.InstallmentPlan
+	37, // 4: payment.v1.Installment.due_date:type_name -> google.protobuf.Timestamp
+	1,  // 5: payment.v1.Installment.status:type_name -> payment.v1.InstallmentStatus
+	37, // 6: payment.v1.Installment.paid_at:type_name -> google.protobuf.Timestamp
+	37, // 7: payment.v1.InstallmentPlan.next_due_date:type_name -> google.protobuf.Timestamp
+	7,  // 8: payment.v1.InstallmentPlan.installments:type_name -> payment.v1.Installment
+	2,  // 9: payment.v1.GetPaymentStatusResponse.status:type_name -> payment.v1.TransactionStatus
+	37, // 10: payment.v1.GetPaymentStatusResponse.expires_at:type_name -> google.protobuf.Timestamp
+	37, // 11: payment.v1.GetPaymentStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: payment.v1.GetPaymentStatusResponse.payment_method:type_name -> payment.v1.PaymentMethod
+	37, // 13: payment.v1.GetPaymentStatusResponse.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: payment.v1.AuthorizePaymentRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	37, // 15: payment.v1.AuthorizePaymentResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: payment.v1.CapturePaymentResponse.status:type_name -> payment.v1.TransactionStatus
+	2,  // 17: payment.v1.VoidAuthorizationResponse.status:type_name -> payment.v1.TransactionStatus
+	37, // 18: payment.v1.InvestorAccount.created_at:type_name -> google.protobuf.Timestamp
+	37, // 19: payment.v1.InvestorAccount.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 20: payment.v1.StatementEntry.type:type_name -> payment.v1.StatementEntryType
+	37, // 21: payment.v1.StatementEntry.created_at:type_name -> google.protobuf.Timestamp
+	37, // 22: payment.v1.GetInvestorStatementRequest.from:type_name -> google.protobuf.Timestamp
+	37, // 23: payment.v1.GetInvestorStatementRequest.to:type_name -> google.protobuf.Timestamp
+	20, // 24: payment.v1.GetInvestorStatementResponse.account:type_name -> payment.v1.InvestorAccount
+	25, // 25: payment.v1.GetInvestorStatementResponse.entries:type_name -> payment.v1.StatementEntry
+	4,  // 26: payment.v1.RiskReason.outcome:type_name -> payment.v1.RiskOutcome
+	0,  // 27: payment.v1.RiskDecision.payment_method:type_name -> payment.v1.PaymentMethod
+	4,  // 28: payment.v1.RiskDecision.outcome:type_name -> payment.v1.RiskOutcome
+	28, // 29: payment.v1.RiskDecision.reasons:type_name -> payment.v1.RiskReason
+	37, // 30: payment.v1.RiskDecision.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 31: payment.v1.ReviewPaymentResponse.status:type_name -> payment.v1.TransactionStatus
+	29, // 32: payment.v1.ReviewPaymentResponse.decision:type_name -> payment.v1.RiskDecision
+	4,  // 33: payment.v1.ListRiskDecisionsRequest.outcome:type_name -> payment.v1.RiskOutcome
+	29, // 34: payment.v1.ListRiskDecisionsResponse.decisions:type_name -> payment.v1.RiskDecision
+	0,  // 35: payment.v1.Transaction.payment_method:type_name -> payment.v1.PaymentMethod
+	2,  // 36: payment.v1.Transaction.status:type_name -> payment.v1.TransactionStatus
+	37, // 37: payment.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	37, // 38: payment.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 39: payment.v1.ListTransactionsRequest.created_from:type_name -> google.protobuf.Timestamp
+	37, // 40: payment.v1.ListTransactionsRequest.created_to:type_name -> google.protobuf.Timestamp
+	2,  // 41: payment.v1.ListTransactionsRequest.statuses:type_name -> payment.v1.TransactionStatus
+	34, // 42: payment.v1.ListTransactionsResponse.transactions:type_name -> payment.v1.Transaction
+	5,  // 43: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	10, // 44: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	14, // 45: payment.v1.PaymentService.AuthorizePayment:input_type -> payment.v1.AuthorizePaymentRequest
+	16, // 46: payment.v1.PaymentService.CapturePayment:input_type -> payment.v1.CapturePaymentRequest
+	18, // 47: payment.v1.PaymentService.VoidAuthorization:input_type -> payment.v1.VoidAuthorizationRequest
+	12, // 48: payment.v1.PaymentService.GetPaymentStatus:input_type -> payment.v1.GetPaymentStatusRequest
+	21, // 49: payment.v1.PaymentService.CreateInvestorAccount:input_type -> payment.v1.CreateInvestorAccountRequest
+	22, // 50: payment.v1.PaymentService.DepositInvestorFunds:input_type -> payment.v1.DepositInvestorFundsRequest
+	23, // 51: payment.v1.PaymentService.SetInvestorLimits:input_type -> payment.v1.SetInvestorLimitsRequest
+	24, // 52: payment.v1.PaymentService.GetInvestorAccount:input_type -> payment.v1.GetInvestorAccountRequest
+	26, // 53: payment.v1.PaymentService.GetInvestorStatement:input_type -> payment.v1.GetInvestorStatementRequest
+	9,  // 54: payment.v1.PaymentService.GetInstallmentPlan:input_type -> payment.v1.GetInstallmentPlanRequest
+	30, // 55: payment.v1.PaymentService.ApprovePayment:input_type -> payment.v1.ReviewPaymentRequest
+	30, // 56: payment.v1.PaymentService.RejectPayment:input_type -> payment.v1.ReviewPaymentRequest
+	32, // 57: payment.v1.PaymentService.ListRiskDecisions:input_type -> payment.v1.ListRiskDecisionsRequest
+	35, // 58: payment.v1.PaymentService.ListTransactions:input_type -> payment.v1.ListTransactionsRequest
+	6,  // 59: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	11, // 60: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	15, // 61: payment.v1.PaymentService.AuthorizePayment:output_type -> payment.v1.AuthorizePaymentResponse
+	17, // 62: payment.v1.PaymentService.CapturePayment:output_type -> payment.v1.CapturePaymentResponse
+	19, // 63: payment.v1.PaymentService.VoidAuthorization:output_type -> payment.v1.VoidAuthorizationResponse
+	13, // 64: payment.v1.PaymentService.GetPaymentStatus:output_type -> payment.v1.GetPaymentStatusResponse
+	20, // 65: payment.v1.PaymentService.CreateInvestorAccount:output_type -> payment.v1.InvestorAccount
+	20, // 66: payment.v1.PaymentService.DepositInvestorFunds:output_type -> payment.v1.InvestorAccount
+	20, // 67: payment.v1.PaymentService.SetInvestorLimits:output_type -> payment.v1.InvestorAccount
+	20, // 68: payment.v1.PaymentService.GetInvestorAccount:output_type -> payment.v1.InvestorAccount
+	27, // 69: payment.v1.PaymentService.GetInvestorStatement:output_type -> payment.v1.GetInvestorStatementResponse
+	8,  // 70: payment.v1.PaymentService.GetInstallmentPlan:output_type -> payment.v1.InstallmentPlan
+	31, // 71: payment.v1.PaymentService.ApprovePayment:output_type -> payment.v1.ReviewPaymentResponse
+	31, // 72: payment.v1.PaymentService.RejectPayment:output_type -> payment.v1.ReviewPaymentResponse
+	33, // 73: payment.v1.PaymentService.ListRiskDecisions:output_type -> payment.v1.ListRiskDecisionsResponse
+	36, // 74: payment.v1.PaymentService.ListTransactions:output_type -> payment.v1.ListTransactionsResponse
+	59, // [59:75] is the sub-list for method output_type
+	43, // [43:59] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_payment_proto_init() }
@@ -243,8 +3182,8 @@ func file_proto_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_payment_proto_rawDesc), len(file_proto_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      5,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
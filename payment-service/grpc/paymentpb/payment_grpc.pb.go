@@ -19,14 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_PayOrder_FullMethodName = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_PayOrder_FullMethodName              = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_RefundPayment_FullMethodName         = "/payment.v1.PaymentService/RefundPayment"
+	PaymentService_AuthorizePayment_FullMethodName      = "/payment.v1.PaymentService/AuthorizePayment"
+	PaymentService_CapturePayment_FullMethodName        = "/payment.v1.PaymentService/CapturePayment"
+	PaymentService_VoidAuthorization_FullMethodName     = "/payment.v1.PaymentService/VoidAuthorization"
+	PaymentService_GetPaymentStatus_FullMethodName      = "/payment.v1.PaymentService/GetPaymentStatus"
+	PaymentService_CreateInvestorAccount_FullMethodName = "/payment.v1.PaymentService/CreateInvestorAccount"
+	PaymentService_DepositInvestorFunds_FullMethodName  = "/payment.v1.PaymentService/DepositInvestorFunds"
+	PaymentService_SetInvestorLimits_FullMethodName     = "/payment.v1.PaymentService/SetInvestorLimits"
+	PaymentService_GetInvestorAccount_FullMethodName    = "/payment.v1.PaymentService/GetInvestorAccount"
+	PaymentService_GetInvestorStatement_FullMethodName  = "/payment.v1.PaymentService/GetInvestorStatement"
+	PaymentService_GetInstallmentPlan_FullMethodName    = "/payment.v1.PaymentService/GetInstallmentPlan"
+	PaymentService_ApprovePayment_FullMethodName        = "/payment.v1.PaymentService/ApprovePayment"
+	PaymentService_RejectPayment_FullMethodName         = "/payment.v1.PaymentService/RejectPayment"
+	PaymentService_ListRiskDecisions_FullMethodName     = "/payment.v1.PaymentService/ListRiskDecisions"
+	PaymentService_ListTransactions_FullMethodName      = "/payment.v1.PaymentService/ListTransactions"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PaymentServiceClient interface {
+	// PayOrder authorizes and captures the payment in one step.
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error)
+	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error)
+	VoidAuthorization(ctx context.Context, in *VoidAuthorizationRequest, opts ...grpc.CallOption) (*VoidAuthorizationResponse, error)
+	GetPaymentStatus(ctx context.Context, in *GetPaymentStatusRequest, opts ...grpc.CallOption) (*GetPaymentStatusResponse, error)
+	CreateInvestorAccount(ctx context.Context, in *CreateInvestorAccountRequest, opts ...grpc.CallOption) (*InvestorAccount, error)
+	DepositInvestorFunds(ctx context.Context, in *DepositInvestorFundsRequest, opts ...grpc.CallOption) (*InvestorAccount, error)
+	SetInvestorLimits(ctx context.Context, in *SetInvestorLimitsRequest, opts ...grpc.CallOption) (*InvestorAccount, error)
+	GetInvestorAccount(ctx context.Context, in *GetInvestorAccountRequest, opts ...grpc.CallOption) (*InvestorAccount, error)
+	GetInvestorStatement(ctx context.Context, in *GetInvestorStatementRequest, opts ...grpc.CallOption) (*GetInvestorStatementResponse, error)
+	GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error)
+	ApprovePayment(ctx context.Context, in *ReviewPaymentRequest, opts ...grpc.CallOption) (*ReviewPaymentResponse, error)
+	RejectPayment(ctx context.Context, in *ReviewPaymentRequest, opts ...grpc.CallOption) (*ReviewPaymentResponse, error)
+	ListRiskDecisions(ctx context.Context, in *ListRiskDecisionsRequest, opts ...grpc.CallOption) (*ListRiskDecisionsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -47,11 +78,177 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 	return out, nil
 }
 
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AuthorizePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapturePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) VoidAuthorization(ctx context.Context, in *VoidAuthorizationRequest, opts ...grpc.CallOption) (*VoidAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidAuthorizationResponse)
+	err := c.cc.Invoke(ctx, PaymentService_VoidAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentStatus(ctx context.Context, in *GetPaymentStatusRequest, opts ...grpc.CallOption) (*GetPaymentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentStatusResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateInvestorAccount(ctx context.Context, in *CreateInvestorAccountRequest, opts ...grpc.CallOption) (*InvestorAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestorAccount)
+	err := c.cc.Invoke(ctx, PaymentService_CreateInvestorAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) DepositInvestorFunds(ctx context.Context, in *DepositInvestorFundsRequest, opts ...grpc.CallOption) (*InvestorAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestorAccount)
+	err := c.cc.Invoke(ctx, PaymentService_DepositInvestorFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SetInvestorLimits(ctx context.Context, in *SetInvestorLimitsRequest, opts ...grpc.CallOption) (*InvestorAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestorAccount)
+	err := c.cc.Invoke(ctx, PaymentService_SetInvestorLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvestorAccount(ctx context.Context, in *GetInvestorAccountRequest, opts ...grpc.CallOption) (*InvestorAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvestorAccount)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvestorAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvestorStatement(ctx context.Context, in *GetInvestorStatementRequest, opts ...grpc.CallOption) (*GetInvestorStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestorStatementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvestorStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*InstallmentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstallmentPlan)
+	err := c.cc.Invoke(ctx, PaymentService_GetInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ApprovePayment(ctx context.Context, in *ReviewPaymentRequest, opts ...grpc.CallOption) (*ReviewPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ApprovePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RejectPayment(ctx context.Context, in *ReviewPaymentRequest, opts ...grpc.CallOption) (*ReviewPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RejectPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListRiskDecisions(ctx context.Context, in *ListRiskDecisionsRequest, opts ...grpc.CallOption) (*ListRiskDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskDecisionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListRiskDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
+	// PayOrder authorizes and captures the payment in one step.
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error)
+	CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error)
+	VoidAuthorization(context.Context, *VoidAuthorizationRequest) (*VoidAuthorizationResponse, error)
+	GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error)
+	CreateInvestorAccount(context.Context, *CreateInvestorAccountRequest) (*InvestorAccount, error)
+	DepositInvestorFunds(context.Context, *DepositInvestorFundsRequest) (*InvestorAccount, error)
+	SetInvestorLimits(context.Context, *SetInvestorLimitsRequest) (*InvestorAccount, error)
+	GetInvestorAccount(context.Context, *GetInvestorAccountRequest) (*InvestorAccount, error)
+	GetInvestorStatement(context.Context, *GetInvestorStatementRequest) (*GetInvestorStatementResponse, error)
+	GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*InstallmentPlan, error)
+	ApprovePayment(context.Context, *ReviewPaymentRequest) (*ReviewPaymentResponse, error)
+	RejectPayment(context.Context, *ReviewPaymentRequest) (*ReviewPaymentResponse, error)
+	ListRiskDecisions(context.Context, *ListRiskDecisionsRequest) (*ListRiskDecisionsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -65,6 +262,51 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PayOrder not implemented")
 }
+func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) VoidAuthorization(context.Context, *VoidAuthorizationRequest) (*VoidAuthorizationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidAuthorization not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentStatus not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateInvestorAccount(context.Context, *CreateInvestorAccountRequest) (*InvestorAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvestorAccount not implemented")
+}
+func (UnimplementedPaymentServiceServer) DepositInvestorFunds(context.Context, *DepositInvestorFundsRequest) (*InvestorAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method DepositInvestorFunds not implemented")
+}
+func (UnimplementedPaymentServiceServer) SetInvestorLimits(context.Context, *SetInvestorLimitsRequest) (*InvestorAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetInvestorLimits not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvestorAccount(context.Context, *GetInvestorAccountRequest) (*InvestorAccount, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvestorAccount not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvestorStatement(context.Context, *GetInvestorStatementRequest) (*GetInvestorStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvestorStatement not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*InstallmentPlan, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInstallmentPlan not implemented")
+}
+func (UnimplementedPaymentServiceServer) ApprovePayment(context.Context, *ReviewPaymentRequest) (*ReviewPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApprovePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) RejectPayment(context.Context, *ReviewPaymentRequest) (*ReviewPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListRiskDecisions(context.Context, *ListRiskDecisionsRequest) (*ListRiskDecisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRiskDecisions not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +346,276 @@ func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_AuthorizePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AuthorizePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, req.(*AuthorizePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_VoidAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).VoidAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_VoidAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).VoidAuthorization(ctx, req.(*VoidAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentStatus(ctx, req.(*GetPaymentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateInvestorAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvestorAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateInvestorAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateInvestorAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateInvestorAccount(ctx, req.(*CreateInvestorAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DepositInvestorFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositInvestorFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DepositInvestorFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DepositInvestorFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DepositInvestorFunds(ctx, req.(*DepositInvestorFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SetInvestorLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvestorLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SetInvestorLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SetInvestorLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SetInvestorLimits(ctx, req.(*SetInvestorLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvestorAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestorAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvestorAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvestorAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvestorAccount(ctx, req.(*GetInvestorAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvestorStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestorStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvestorStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvestorStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvestorStatement(ctx, req.(*GetInvestorStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, req.(*GetInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ApprovePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ApprovePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ApprovePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ApprovePayment(ctx, req.(*ReviewPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RejectPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RejectPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RejectPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RejectPayment(ctx, req.(*ReviewPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListRiskDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListRiskDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListRiskDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListRiskDecisions(ctx, req.(*ListRiskDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +627,66 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayOrder",
 			Handler:    _PaymentService_PayOrder_Handler,
 		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _PaymentService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "AuthorizePayment",
+			Handler:    _PaymentService_AuthorizePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _PaymentService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "VoidAuthorization",
+			Handler:    _PaymentService_VoidAuthorization_Handler,
+		},
+		{
+			MethodName: "GetPaymentStatus",
+			Handler:    _PaymentService_GetPaymentStatus_Handler,
+		},
+		{
+			MethodName: "CreateInvestorAccount",
+			Handler:    _PaymentService_CreateInvestorAccount_Handler,
+		},
+		{
+			MethodName: "DepositInvestorFunds",
+			Handler:    _PaymentService_DepositInvestorFunds_Handler,
+		},
+		{
+			MethodName: "SetInvestorLimits",
+			Handler:    _PaymentService_SetInvestorLimits_Handler,
+		},
+		{
+			MethodName: "GetInvestorAccount",
+			Handler:    _PaymentService_GetInvestorAccount_Handler,
+		},
+		{
+			MethodName: "GetInvestorStatement",
+			Handler:    _PaymentService_GetInvestorStatement_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlan",
+			Handler:    _PaymentService_GetInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "ApprovePayment",
+			Handler:    _PaymentService_ApprovePayment_Handler,
+		},
+		{
+			MethodName: "RejectPayment",
+			Handler:    _PaymentService_RejectPayment_Handler,
+		},
+		{
+			MethodName: "ListRiskDecisions",
+			Handler:    _PaymentService_ListRiskDecisions_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _PaymentService_ListTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/payment.proto",
@@ -0,0 +1,27 @@
+package migrator
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+func Run(dsn string, migrationDir string) error {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return fmt.Errorf("oped db error: %w", err)
+	}
+	defer db.Close()
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		return err
+	}
+
+	if err := goose.Up(db, migrationDir); err != nil {
+		return fmt.Errorf("goose up error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+type InstallmentStatus string
+
+const (
+	InstallmentScheduled InstallmentStatus = "SCHEDULED"
+	InstallmentPaid      InstallmentStatus = "PAID"
+	// InstallmentOverdue is an installment past its due date that could not
+	// be collected yet; collection is retried.
+	InstallmentOverdue InstallmentStatus = "OVERDUE"
+	// InstallmentCancelled is an installment left unpaid when the payment
+	// was refunded.
+	InstallmentCancelled InstallmentStatus = "CANCELLED"
+)
+
+// Installment is one monthly payment of an installment plan. Amount is
+// Principal plus Interest.
+type Installment struct {
+	Number      int
+	DueDate     time.Time
+	Principal   float64
+	Interest    float64
+	Amount      float64
+	Status      InstallmentStatus
+	PaidAt      time.Time
+	CollectRef  string
+	Attempts    int
+	FailureCode string
+}
+
+// Open reports whether the installment is still to be paid.
+func (i Installment) Open() bool {
+	return i.Status == InstallmentScheduled || i.Status == InstallmentOverdue
+}
+
+// InstallmentPlan splits a credit payment into monthly installments at
+// AnnualRate percent a year.
+type InstallmentPlan struct {
+	Months       int
+	AnnualRate   float64
+	Installments []Installment
+}
+
+// Total is what the customer pays over the whole plan, interest included.
+func (p *InstallmentPlan) Total() float64 {
+	return p.sum(func(i Installment) bool { return true }, func(i Installment) float64 { return i.Amount })
+}
+
+// Paid is what the customer has paid so far.
+func (p *InstallmentPlan) Paid() float64 {
+	return p.sum(func(i Installment) bool { return i.Status == InstallmentPaid }, func(i Installment) float64 { return i.Amount })
+}
+
+// PrincipalPaid is the part of the loan the customer has paid back.
+func (p *InstallmentPlan) PrincipalPaid() float64 {
+	return p.sum(func(i Installment) bool { return i.Status == InstallmentPaid }, func(i Installment) float64 { return i.Principal })
+}
+
+// Remaining is what is left to pay, interest included.
+func (p *InstallmentPlan) Remaining() float64 {
+	return p.sum(Installment.Open, func(i Installment) float64 { return i.Amount })
+}
+
+// Overdue is what should have been paid already but was not.
+func (p *InstallmentPlan) Overdue() float64 {
+	return p.sum(func(i Installment) bool { return i.Status == InstallmentOverdue }, func(i Installment) float64 { return i.Amount })
+}
+
+// Next returns the earliest installment still to be paid, nil if there is
+// none.
+func (p *InstallmentPlan) Next() *Installment {
+	for i := range p.Installments {
+		if p.Installments[i].Open() {
+			return &p.Installments[i]
+		}
+	}
+	return nil
+}
+
+// Clone returns a copy of the plan that shares nothing with it.
+func (p *InstallmentPlan) Clone() *InstallmentPlan {
+	if p == nil {
+		return nil
+	}
+	c := *p
+	c.Installments = append([]Installment(nil), p.Installments...)
+	return &c
+}
+
+func (p *InstallmentPlan) sum(match func(Installment) bool, value func(Installment) float64) float64 {
+	var total float64
+	for _, i := range p.Installments {
+		if match(i) {
+			total += value(i)
+		}
+	}
+	return roundCents(total)
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"payment-service/grpc/paymentpb"
+	"time"
+)
+
+type RiskOutcome string
+
+const (
+	RiskApprove RiskOutcome = "APPROVE"
+	// RiskReview holds the payment until an operator approves or rejects
+	// it.
+	RiskReview RiskOutcome = "REVIEW"
+	RiskReject RiskOutcome = "REJECT"
+)
+
+// RiskRejectedCode is the failure code of payments the risk checks or an
+// operator rejected.
+const RiskRejectedCode = "RISK_REJECTED"
+
+// Severity orders the outcomes from APPROVE to REJECT.
+func (o RiskOutcome) Severity() int {
+	switch o {
+	case RiskReview:
+		return 1
+	case RiskReject:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// RiskReason is a rule that fired and the outcome it asked for.
+type RiskReason struct {
+	Rule    string
+	Outcome RiskOutcome
+	Message string
+}
+
+// RiskDecision is the outcome of the risk checks of a payment, or of an
+// operator's review when Operator is set. TransactionUUID is empty for
+// payments rejected before a transaction was made.
+type RiskDecision struct {
+	UUID            string
+	OrderUUID       string
+	UserUUID        string
+	Method          paymentpb.PaymentMethod
+	Amount          float64
+	Outcome         RiskOutcome
+	Reasons         []RiskReason
+	TransactionUUID string
+	Operator        string
+	CreatedAt       time.Time
+}
+
+// DecisionFilter selects risk decisions; empty fields match everything.
+type DecisionFilter struct {
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	Outcome         RiskOutcome
+}
+
+// Match reports whether d passes the filter.
+func (f DecisionFilter) Match(d *RiskDecision) bool {
+	return (f.OrderUUID == "" || d.OrderUUID == f.OrderUUID) &&
+		(f.UserUUID == "" || d.UserUUID == f.UserUUID) &&
+		(f.TransactionUUID == "" || d.TransactionUUID == f.TransactionUUID) &&
+		(f.Outcome == "" || d.Outcome == f.Outcome)
+}
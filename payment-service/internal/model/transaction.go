@@ -0,0 +1,138 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"payment-service/grpc/paymentpb"
+	"time"
+)
+
+var (
+	ErrNotFound = errors.New("transaction not found")
+	// ErrAuthorizationExpired is returned when capturing an authorization
+	// after its hold ran out.
+	ErrAuthorizationExpired = errors.New("authorization expired")
+	// ErrWrongStatus is returned for an operation the transaction status does
+	// not allow, such as capturing a voided authorization.
+	ErrWrongStatus   = errors.New("operation not allowed in the transaction status")
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidCallback is returned for a provider callback that cannot be
+	// applied as sent.
+	ErrInvalidCallback = errors.New("invalid callback")
+	// ErrInvalidPlan is returned for an installment plan that is not
+	// offered or not possible for the payment method.
+	ErrInvalidPlan = errors.New("invalid installment plan")
+	// ErrRiskRejected is returned for a payment the risk checks did not let
+	// through.
+	ErrRiskRejected = errors.New("payment rejected by risk checks")
+	// ErrAlreadyPaid is returned for a payment of an order that already has
+	// a different one, see PaymentConflictError.
+	ErrAlreadyPaid = errors.New("order already has a payment")
+)
+
+// PaymentConflictError is a payment of an order that is not a repeat of
+// Existing, the payment the order already has.
+type PaymentConflictError struct {
+	Existing *Transaction
+	// Detail tells what differs.
+	Detail string
+}
+
+func (e *PaymentConflictError) Error() string {
+	return fmt.Sprintf("%v: transaction %s is %s: %s", ErrAlreadyPaid, e.Existing.UUID, e.Existing.Status, e.Detail)
+}
+
+func (e *PaymentConflictError) Unwrap() error {
+	return ErrAlreadyPaid
+}
+
+type TransactionStatus string
+
+const (
+	TransactionPending    TransactionStatus = "PENDING"
+	TransactionAuthorized TransactionStatus = "AUTHORIZED"
+	TransactionPaid       TransactionStatus = "PAID"
+	TransactionVoided     TransactionStatus = "VOIDED"
+	TransactionExpired    TransactionStatus = "EXPIRED"
+	TransactionRefunded   TransactionStatus = "REFUNDED"
+	TransactionFailed     TransactionStatus = "FAILED"
+	// TransactionReview is a payment the risk checks held for an operator;
+	// it is not charged until approved.
+	TransactionReview TransactionStatus = "REVIEW"
+	// TransactionRejected is a payment an operator rejected on review.
+	TransactionRejected TransactionStatus = "REJECTED"
+)
+
+// Final reports whether the outcome of the payment is known: it is not
+// waiting for the provider or an operator anymore.
+func (s TransactionStatus) Final() bool {
+	return s != TransactionPending && s != TransactionReview
+}
+
+// Transaction is a payment of an order. Provider and ProviderRef tell which
+// provider charged it and under which reference, for refunds.
+//
+// A one-step payment is PAID right away. A two-step one starts AUTHORIZED
+// with Amount held until ExpiresAt; capturing takes CapturedAmount of it and
+// makes it PAID, voiding or expiry release the hold.
+//
+// An asynchronous payment is PENDING until the provider callback makes it
+// PAID or FAILED; without a callback by ExpiresAt it is EXPIRED.
+//
+// A payment the risk checks hold is in REVIEW until ExpiresAt: an operator
+// approving it charges it like a new payment, rejecting makes it REJECTED.
+//
+// A payment made in installments has a Plan: the credit provider pays the
+// whole amount and collects the installments from the customer monthly.
+//
+// IdempotencyKey is the key the client paid with, if any; PayOrder with the
+// same key returns the transaction instead of paying again.
+type Transaction struct {
+	UUID           string
+	OrderUUID      string
+	UserUUID       string
+	IdempotencyKey string
+	Method         paymentpb.PaymentMethod
+	Amount         float64
+	CapturedAmount float64
+	Provider       string
+	ProviderRef    string
+	CaptureRef     string
+	Status         TransactionStatus
+	ExpiresAt      time.Time
+	VoidReason     string
+	FailureCode    string
+	FailureMessage string
+	RefundUUID     string
+	RefundRef      string
+	Plan           *InstallmentPlan
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TransactionFilter selects the transactions created in [CreatedFrom,
+// CreatedTo) with one of Statuses; zero fields match everything.
+type TransactionFilter struct {
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Statuses    []TransactionStatus
+}
+
+// Match reports whether tx passes the filter.
+func (f TransactionFilter) Match(tx *Transaction) bool {
+	if !f.CreatedFrom.IsZero() && tx.CreatedAt.Before(f.CreatedFrom) {
+		return false
+	}
+	if !f.CreatedTo.IsZero() && !tx.CreatedAt.Before(f.CreatedTo) {
+		return false
+	}
+	if len(f.Statuses) == 0 {
+		return true
+	}
+	for _, s := range f.Statuses {
+		if tx.Status == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+// Package notify tells order-service about the outcome of asynchronous
+// payments.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"payment-service/internal/model"
+	"time"
+)
+
+// Event is the body posted to the webhook. It only says which payment has
+// changed; the receiver is expected to read the status with
+// GetPaymentStatus rather than trust the body.
+type Event struct {
+	TransactionUUID string `json:"transaction_uuid"`
+	OrderUUID       string `json:"order_uuid"`
+	Status          string `json:"status"`
+}
+
+// WebhookNotifier posts payment events as JSON to a URL. Any non-2xx
+// response is an error.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{url: url, client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, tx *model.Transaction) error {
+	body, err := json.Marshal(Event{
+		TransactionUUID: tx.UUID,
+		OrderUUID:       tx.OrderUUID,
+		Status:          string(tx.Status),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryAccountStore keeps the investor accounts in memory; they are lost on
+// restart.
+type MemoryAccountStore struct {
+	mu       sync.RWMutex
+	accounts map[string]InvestorAccount
+	entries  map[string][]StatementEntry
+}
+
+func NewMemoryAccountStore() *MemoryAccountStore {
+	return &MemoryAccountStore{
+		accounts: make(map[string]InvestorAccount),
+		entries:  make(map[string][]StatementEntry),
+	}
+}
+
+func (m *MemoryAccountStore) CreateAccount(ctx context.Context, a *InvestorAccount) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.accounts[a.UserUUID]; ok {
+		return ErrAccountExists
+	}
+	m.accounts[a.UserUUID] = *a
+	return nil
+}
+
+func (m *MemoryAccountStore) Account(ctx context.Context, userID string) (*InvestorAccount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	a, ok := m.accounts[userID]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	return &a, nil
+}
+
+func (m *MemoryAccountStore) SetLimits(ctx context.Context, a *InvestorAccount) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.accounts[a.UserUUID]
+	if !ok {
+		return ErrAccountNotFound
+	}
+	stored.PerOrderLimit = a.PerOrderLimit
+	stored.MonthlyLimit = a.MonthlyLimit
+	stored.UpdatedAt = a.UpdatedAt
+	m.accounts[a.UserUUID] = stored
+	return nil
+}
+
+func (m *MemoryAccountStore) Record(ctx context.Context, a *InvestorAccount, e StatementEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.accounts[a.UserUUID]
+	if !ok {
+		return ErrAccountNotFound
+	}
+	stored.Balance = a.Balance
+	stored.Held = a.Held
+	stored.UpdatedAt = a.UpdatedAt
+	m.accounts[a.UserUUID] = stored
+	m.entries[a.UserUUID] = append(m.entries[a.UserUUID], e)
+	return nil
+}
+
+func (m *MemoryAccountStore) Entries(ctx context.Context, userID string, from, to time.Time) ([]StatementEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var entries []StatementEntry
+	for _, e := range m.entries[userID] {
+		if (!from.IsZero() && e.CreatedAt.Before(from)) || (!to.IsZero() && !e.CreatedAt.Before(to)) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].CreatedAt.Before(entries[j].CreatedAt) })
+	return entries, nil
+}
+
+func (m *MemoryAccountStore) Hold(ctx context.Context, userID, ref string) (*StatementEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var hold *StatementEntry
+	for _, e := range m.entries[userID] {
+		if e.Reference != ref {
+			continue
+		}
+		switch e.Type {
+		case EntryHold:
+			hold = &e
+		case EntryRelease:
+			return nil, ErrHoldNotFound
+		}
+	}
+	if hold == nil {
+		return nil, ErrHoldNotFound
+	}
+	return hold, nil
+}
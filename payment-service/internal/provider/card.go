@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardGateway simulates an acquiring gateway: it declines payments above
+// the configured card limit and approves the rest.
+type CardGateway struct {
+	name string
+	cfg  ProviderConfig
+}
+
+func NewCardGateway(name string, cfg ProviderConfig) *CardGateway {
+	return &CardGateway{name: name, cfg: cfg}
+}
+
+func (g *CardGateway) Name() string {
+	return g.name
+}
+
+func (g *CardGateway) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := g.approve(ctx, req); err != nil {
+		return nil, err
+	}
+	return &Charge{ChargeRequest: req, Provider: g.name, Reference: "card_" + uuid.NewString()}, nil
+}
+
+// Authorize puts a hold on the card; the same limit applies as to charges.
+func (g *CardGateway) Authorize(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := g.approve(ctx, req); err != nil {
+		return nil, err
+	}
+	return &Charge{
+		ChargeRequest: req,
+		Provider:      g.name,
+		Reference:     "card_auth_" + uuid.NewString(),
+		ExpiresAt:     time.Now().Add(g.cfg.authorizationTTL()),
+	}, nil
+}
+
+func (g *CardGateway) Capture(ctx context.Context, auth Charge, amount float64) (string, error) {
+	if err := wait(ctx, g.name, g.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	return "card_capture_" + uuid.NewString(), nil
+}
+
+func (g *CardGateway) Void(ctx context.Context, auth Charge) error {
+	return wait(ctx, g.name, g.cfg.Latency.Duration)
+}
+
+func (g *CardGateway) approve(ctx context.Context, req ChargeRequest) error {
+	if err := checkAmount(g.name, req.Amount); err != nil {
+		return err
+	}
+	if err := wait(ctx, g.name, g.cfg.Latency.Duration); err != nil {
+		return err
+	}
+	if g.cfg.DeclineOver > 0 && req.Amount > g.cfg.DeclineOver {
+		return newError(g.name, CodeDeclined, "amount %.2f is over the card limit", req.Amount)
+	}
+	return nil
+}
+
+func (g *CardGateway) Refund(ctx context.Context, charge Charge, reason string) (string, error) {
+	if err := wait(ctx, g.name, g.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	return "card_refund_" + uuid.NewString(), nil
+}
+
+func checkAmount(provider string, amount float64) error {
+	if amount <= 0 {
+		return newError(provider, CodeInvalidRequest, "amount must be greater than 0")
+	}
+	return nil
+}
+
+// wait simulates the provider latency; a context that ends first is a
+// timeout.
+func wait(ctx context.Context, provider string, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return newError(provider, CodeTimeout, "no response from the provider: %v", ctx.Err())
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config routes the payment methods to providers and configures the
+// providers by name.
+type Config struct {
+	// Routes maps a PaymentMethod name to a provider name. A method without
+	// a route cannot be paid with.
+	Routes    map[string]string         `json:"routes"`
+	Providers map[string]ProviderConfig `json:"providers"`
+	// InstallmentPlans are the terms a CREDIT_CARD payment can be split
+	// into; none means no installments.
+	InstallmentPlans []InstallmentPlan `json:"installment_plans,omitempty"`
+}
+
+// InstallmentPlan is an installment term offered to customers: the number
+// of monthly installments and the annual interest rate in percent.
+type InstallmentPlan struct {
+	Months     int     `json:"months"`
+	AnnualRate float64 `json:"annual_rate"`
+}
+
+// ProviderConfig holds the settings of all provider kinds; each kind reads
+// its own.
+type ProviderConfig struct {
+	// Kind is one of card, sbp, credit_line and investor_fund.
+	Kind string `json:"kind"`
+	// FakeMode skips the simulation: "approve" approves every payment,
+	// "pending" leaves every charge pending, a failure code such as
+	// "DECLINED" fails every payment with it.
+	FakeMode string   `json:"fake_mode,omitempty"`
+	Latency  Duration `json:"latency,omitempty"`
+	// AuthorizationTTL is how long an authorization holds the money,
+	// DefaultAuthorizationTTL if not set. SBP cannot authorize.
+	AuthorizationTTL Duration `json:"authorization_ttl,omitempty"`
+
+	// DeclineOver declines card payments and credit line installments above
+	// the amount, 0 for no limit.
+	DeclineOver float64 `json:"decline_over,omitempty"`
+	// QRTTL is how long an SBP QR code can be paid.
+	QRTTL Duration `json:"qr_ttl,omitempty"`
+	// Async makes SBP payments pending until the bank confirms them through
+	// the callback endpoint, instead of simulating the confirmation.
+	Async bool `json:"async,omitempty"`
+	// CreditLimit is the credit line of every user.
+	CreditLimit float64 `json:"credit_limit,omitempty"`
+	// InvestorAccounts are opened by the investor fund on start.
+	InvestorAccounts []InvestorAccountConfig `json:"investor_accounts,omitempty"`
+}
+
+// InvestorAccountConfig is an investor account opened with Balance on it,
+// see InvestorAccount for the limits.
+type InvestorAccountConfig struct {
+	UserUUID      string  `json:"user_uuid"`
+	Balance       float64 `json:"balance"`
+	PerOrderLimit float64 `json:"per_order_limit,omitempty"`
+	MonthlyLimit  float64 `json:"monthly_limit,omitempty"`
+}
+
+// authorizationTTL returns the configured AuthorizationTTL or the default.
+func (c ProviderConfig) authorizationTTL() time.Duration {
+	if c.AuthorizationTTL.Duration > 0 {
+		return c.AuthorizationTTL.Duration
+	}
+	return DefaultAuthorizationTTL
+}
+
+// Duration is a time.Duration written as "1m30s" in the config.
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// DefaultConfig routes every method to its own simulator with generous
+// limits.
+func DefaultConfig() Config {
+	return Config{
+		Routes: map[string]string{
+			"CARD":           "card",
+			"SBP":            "sbp",
+			"CREDIT_CARD":    "credit_line",
+			"INVESTOR_MONEY": "investor_fund",
+		},
+		Providers: map[string]ProviderConfig{
+			"card":          {Kind: KindCard},
+			"sbp":           {Kind: KindSBP, QRTTL: Duration{15 * time.Minute}, Async: true},
+			"credit_line":   {Kind: KindCreditLine, CreditLimit: 10_000_000},
+			"investor_fund": {Kind: KindInvestorFund},
+		},
+		InstallmentPlans: []InstallmentPlan{
+			{Months: 3, AnnualRate: 0},
+			{Months: 6, AnnualRate: 9.9},
+			{Months: 12, AnnualRate: 14.9},
+		},
+	}
+}
+
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreditLine simulates a bank credit line of the same limit for every user.
+// Charges use the line up and refunds free it again.
+type CreditLine struct {
+	name string
+	cfg  ProviderConfig
+
+	mu   sync.Mutex
+	used map[string]float64
+}
+
+func NewCreditLine(name string, cfg ProviderConfig) *CreditLine {
+	return &CreditLine{name: name, cfg: cfg, used: make(map[string]float64)}
+}
+
+func (c *CreditLine) Name() string {
+	return c.name
+}
+
+func (c *CreditLine) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := c.use(ctx, req); err != nil {
+		return nil, err
+	}
+	return &Charge{ChargeRequest: req, Provider: c.name, Reference: "credit_" + uuid.NewString()}, nil
+}
+
+func (c *CreditLine) Refund(ctx context.Context, charge Charge, reason string) (string, error) {
+	if err := wait(ctx, c.name, c.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	c.free(charge.UserUUID, charge.Amount)
+	return "credit_refund_" + uuid.NewString(), nil
+}
+
+// Authorize uses the line up like a charge; the part that is not captured
+// is freed on capture.
+func (c *CreditLine) Authorize(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := c.use(ctx, req); err != nil {
+		return nil, err
+	}
+	return &Charge{
+		ChargeRequest: req,
+		Provider:      c.name,
+		Reference:     "credit_auth_" + uuid.NewString(),
+		ExpiresAt:     time.Now().Add(c.cfg.authorizationTTL()),
+	}, nil
+}
+
+func (c *CreditLine) Capture(ctx context.Context, auth Charge, amount float64) (string, error) {
+	if err := wait(ctx, c.name, c.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	c.free(auth.UserUUID, auth.Amount-amount)
+	return "credit_capture_" + uuid.NewString(), nil
+}
+
+func (c *CreditLine) Void(ctx context.Context, auth Charge) error {
+	if err := wait(ctx, c.name, c.cfg.Latency.Duration); err != nil {
+		return err
+	}
+	c.free(auth.UserUUID, auth.Amount)
+	return nil
+}
+
+// CollectInstallment simulates the monthly debit of the customer's account:
+// installments above DeclineOver are declined. The collected principal frees
+// the line.
+func (c *CreditLine) CollectInstallment(ctx context.Context, charge Charge, principal, amount float64) (string, error) {
+	if err := checkAmount(c.name, amount); err != nil {
+		return "", err
+	}
+	if err := wait(ctx, c.name, c.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	if c.cfg.DeclineOver > 0 && amount > c.cfg.DeclineOver {
+		return "", newError(c.name, CodeDeclined, "installment of %.2f declined by the customer's bank", amount)
+	}
+	c.free(charge.UserUUID, principal)
+	return "credit_installment_" + uuid.NewString(), nil
+}
+
+func (c *CreditLine) use(ctx context.Context, req ChargeRequest) error {
+	if err := checkAmount(c.name, req.Amount); err != nil {
+		return err
+	}
+	if err := wait(ctx, c.name, c.cfg.Latency.Duration); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if available := c.cfg.CreditLimit - c.used[req.UserUUID]; req.Amount > available {
+		return newError(c.name, CodeLimitExceeded, "credit line has %.2f available, %.2f requested", available, req.Amount)
+	}
+	c.used[req.UserUUID] += req.Amount
+	return nil
+}
+
+func (c *CreditLine) free(user string, amount float64) {
+	c.mu.Lock()
+	c.used[user] = max(c.used[user]-amount, 0)
+	c.mu.Unlock()
+}
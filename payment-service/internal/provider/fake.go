@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// FakeApprove is the fake mode that approves every payment.
+	FakeApprove = "approve"
+	// FakePending leaves every charge pending until a callback arrives.
+	FakePending = "pending"
+)
+
+// Fake replaces the simulation of a provider with a fixed outcome: every
+// charge, refund and authorization step is approved, or fails with the same
+// code. It authorizes and collects installments only if the wrapped provider
+// can.
+type Fake struct {
+	Provider
+	mode string
+}
+
+// NewFake wraps p; mode is FakeApprove, FakePending or a failure Code.
+func NewFake(p Provider, mode string) (*Fake, error) {
+	if mode != FakeApprove && mode != FakePending && !Code(mode).Valid() {
+		return nil, fmt.Errorf("provider %s: unknown fake mode %q", p.Name(), mode)
+	}
+	return &Fake{Provider: p, mode: mode}, nil
+}
+
+func (f *Fake) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	switch f.mode {
+	case FakeApprove:
+		return &Charge{ChargeRequest: req, Provider: f.Name(), Reference: "fake_" + uuid.NewString()}, nil
+	case FakePending:
+		return &Charge{ChargeRequest: req, Provider: f.Name(), Reference: "fake_" + uuid.NewString(), Pending: true}, nil
+	}
+	return nil, newError(f.Name(), Code(f.mode), "fake mode")
+}
+
+func (f *Fake) Refund(ctx context.Context, charge Charge, reason string) (string, error) {
+	if f.failing() {
+		return "", newError(f.Name(), Code(f.mode), "fake mode")
+	}
+	return "fake_refund_" + uuid.NewString(), nil
+}
+
+func (f *Fake) Authorize(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if f.failing() {
+		return nil, newError(f.Name(), Code(f.mode), "fake mode")
+	}
+	return &Charge{
+		ChargeRequest: req,
+		Provider:      f.Name(),
+		Reference:     "fake_auth_" + uuid.NewString(),
+		ExpiresAt:     time.Now().Add(DefaultAuthorizationTTL),
+	}, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, auth Charge, amount float64) (string, error) {
+	if f.failing() {
+		return "", newError(f.Name(), Code(f.mode), "fake mode")
+	}
+	return "fake_capture_" + uuid.NewString(), nil
+}
+
+func (f *Fake) Void(ctx context.Context, auth Charge) error {
+	if f.failing() {
+		return newError(f.Name(), Code(f.mode), "fake mode")
+	}
+	return nil
+}
+
+// failing reports whether the fake mode is a failure code. Only charges can
+// be left pending; everything else succeeds in FakePending.
+func (f *Fake) failing() bool {
+	return f.mode != FakeApprove && f.mode != FakePending
+}
+
+func (f *Fake) CollectInstallment(ctx context.Context, charge Charge, principal, amount float64) (string, error) {
+	if f.failing() {
+		return "", newError(f.Name(), Code(f.mode), "fake mode")
+	}
+	return "fake_installment_" + uuid.NewString(), nil
+}
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAccountNotFound = errors.New("investor account not found")
+	ErrAccountExists   = errors.New("investor account already exists")
+)
+
+// InvestorAccount is the money a user keeps with the investor fund.
+// Balance includes the Held part, which is reserved by authorizations and
+// cannot be spent. PerOrderLimit caps a single payment and MonthlyLimit the
+// payments of a calendar month (UTC), holds included; 0 means no limit.
+type InvestorAccount struct {
+	UserUUID      string
+	Balance       float64
+	Held          float64
+	PerOrderLimit float64
+	MonthlyLimit  float64
+	// SpentThisMonth is what the account paid for orders in the current
+	// month net of refunds, holds included.
+	SpentThisMonth float64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Available is the part of the balance that can be spent.
+func (a InvestorAccount) Available() float64 {
+	return a.Balance - a.Held
+}
+
+type EntryType string
+
+const (
+	// EntryCredit is a deposit or a refund.
+	EntryCredit EntryType = "CREDIT"
+	// EntryDebit is a payment.
+	EntryDebit EntryType = "DEBIT"
+	// EntryHold reserves money for an authorization, EntryRelease returns
+	// the part of it that was not captured.
+	EntryHold    EntryType = "HOLD"
+	EntryRelease EntryType = "RELEASE"
+)
+
+// StatementEntry is a movement on an investor account. OrderUUID is empty
+// for deposits. Balance and Available are the account values after it.
+type StatementEntry struct {
+	ID          string
+	Type        EntryType
+	Amount      float64
+	OrderUUID   string
+	Reference   string
+	Description string
+	Balance     float64
+	Available   float64
+	CreatedAt   time.Time
+}
+
+// AccountStore keeps the investor accounts and their statements.
+type AccountStore interface {
+	// CreateAccount returns ErrAccountExists if the user has an account.
+	CreateAccount(ctx context.Context, a *InvestorAccount) error
+	// Account returns ErrAccountNotFound if the user has no account.
+	Account(ctx context.Context, userID string) (*InvestorAccount, error)
+	// SetLimits saves the limits of a.
+	SetLimits(ctx context.Context, a *InvestorAccount) error
+	// Record saves the balance of a together with e, the entry that
+	// changed it.
+	Record(ctx context.Context, a *InvestorAccount, e StatementEntry) error
+	// Entries returns the entries of the account made in [from, to),
+	// oldest first. A zero from or to leaves that end open.
+	Entries(ctx context.Context, userID string, from, to time.Time) ([]StatementEntry, error)
+	// Hold returns the HOLD entry of the account with reference ref,
+	// ErrHoldNotFound if there is none or it has been released.
+	Hold(ctx context.Context, userID, ref string) (*StatementEntry, error)
+}
+
+// ErrHoldNotFound is returned by AccountStore.Hold.
+var ErrHoldNotFound = errors.New("investor hold not found")
+
+// InvestorFund pays orders out of the investor accounts of the users.
+// Charges debit the account, authorizations hold the amount until it is
+// captured or released.
+type InvestorFund struct {
+	name     string
+	cfg      ProviderConfig
+	now      func() time.Time
+	accounts AccountStore
+
+	// mu serializes the changes of the accounts, so that every payment is
+	// checked against the balance the one before it left.
+	mu sync.Mutex
+}
+
+// NewInvestorFund returns the investor fund keeping its accounts in
+// accounts. The accounts of cfg are opened unless accounts already has
+// them.
+func NewInvestorFund(name string, cfg ProviderConfig, accounts AccountStore) (*InvestorFund, error) {
+	f := &InvestorFund{
+		name:     name,
+		cfg:      cfg,
+		now:      time.Now,
+		accounts: accounts,
+	}
+	ctx := context.Background()
+	for _, c := range cfg.InvestorAccounts {
+		a := &InvestorAccount{
+			UserUUID:      c.UserUUID,
+			PerOrderLimit: c.PerOrderLimit,
+			MonthlyLimit:  c.MonthlyLimit,
+			CreatedAt:     f.now(),
+			UpdatedAt:     f.now(),
+		}
+		err := accounts.CreateAccount(ctx, a)
+		if errors.Is(err, ErrAccountExists) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("provider %s: open investor account %s: %w", name, c.UserUUID, err)
+		}
+		if c.Balance > 0 {
+			if err := f.record(ctx, a, EntryCredit, c.Balance, "", "", "opening balance"); err != nil {
+				return nil, fmt.Errorf("provider %s: open investor account %s: %w", name, c.UserUUID, err)
+			}
+		}
+	}
+	return f, nil
+}
+
+func (f *InvestorFund) Name() string {
+	return f.name
+}
+
+func (f *InvestorFund) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	ref := "fund_" + uuid.NewString()
+	if err := f.spend(ctx, req, EntryDebit, ref); err != nil {
+		return nil, err
+	}
+	return &Charge{ChargeRequest: req, Provider: f.name, Reference: ref}, nil
+}
+
+func (f *InvestorFund) Refund(ctx context.Context, charge Charge, reason string) (string, error) {
+	if err := wait(ctx, f.name, f.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	ref := "fund_refund_" + uuid.NewString()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, err := f.accounts.Account(ctx, charge.UserUUID)
+	if errors.Is(err, ErrAccountNotFound) {
+		return "", newError(f.name, CodeInvalidRequest, "user %s has no investor account", charge.UserUUID)
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := f.record(ctx, a, EntryCredit, charge.Amount, charge.OrderUUID, ref, "refund: "+reason); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
+
+// Authorize holds the amount on the account of the user; the part that is
+// not captured is released on capture.
+func (f *InvestorFund) Authorize(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	ref := "fund_auth_" + uuid.NewString()
+	if err := f.spend(ctx, req, EntryHold, ref); err != nil {
+		return nil, err
+	}
+	return &Charge{
+		ChargeRequest: req,
+		Provider:      f.name,
+		Reference:     ref,
+		ExpiresAt:     f.now().Add(f.cfg.authorizationTTL()),
+	}, nil
+}
+
+func (f *InvestorFund) Capture(ctx context.Context, auth Charge, amount float64) (string, error) {
+	if err := wait(ctx, f.name, f.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	ref := "fund_capture_" + uuid.NewString()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, err := f.release(ctx, auth)
+	if err != nil {
+		return "", err
+	}
+	if err := f.record(ctx, a, EntryDebit, amount, auth.OrderUUID, ref, "payment captured"); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
+
+func (f *InvestorFund) Void(ctx context.Context, auth Charge) error {
+	if err := wait(ctx, f.name, f.cfg.Latency.Duration); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err := f.release(ctx, auth)
+	return err
+}
+
+// OpenAccount opens an investor account for the user with the given limits
+// and no money on it.
+func (f *InvestorFund) OpenAccount(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*InvestorAccount, error) {
+	if userID == "" {
+		return nil, newError(f.name, CodeInvalidRequest, "user is required")
+	}
+	if err := checkLimits(f.name, perOrderLimit, monthlyLimit); err != nil {
+		return nil, err
+	}
+	now := f.now()
+	a := &InvestorAccount{
+		UserUUID:      userID,
+		PerOrderLimit: perOrderLimit,
+		MonthlyLimit:  monthlyLimit,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := f.accounts.CreateAccount(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Deposit credits amount to the account of the user.
+func (f *InvestorFund) Deposit(ctx context.Context, userID string, amount float64, reference string) (*InvestorAccount, error) {
+	if err := checkAmount(f.name, amount); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, err := f.accounts.Account(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.record(ctx, a, EntryCredit, amount, "", reference, "deposit"); err != nil {
+		return nil, err
+	}
+	return f.snapshot(ctx, a)
+}
+
+// SetLimits replaces the spending limits of the account; payments made so
+// far are not affected.
+func (f *InvestorFund) SetLimits(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*InvestorAccount, error) {
+	if err := checkLimits(f.name, perOrderLimit, monthlyLimit); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, err := f.accounts.Account(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	a.PerOrderLimit = perOrderLimit
+	a.MonthlyLimit = monthlyLimit
+	a.UpdatedAt = f.now()
+	if err := f.accounts.SetLimits(ctx, a); err != nil {
+		return nil, err
+	}
+	return f.snapshot(ctx, a)
+}
+
+// Account returns the account of the user.
+func (f *InvestorFund) Account(ctx context.Context, userID string) (*InvestorAccount, error) {
+	a, err := f.accounts.Account(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return f.snapshot(ctx, a)
+}
+
+// Statement returns the entries of the account made in [from, to), oldest
+// first. A zero from or to leaves that end open.
+func (f *InvestorFund) Statement(ctx context.Context, userID string, from, to time.Time) (*InvestorAccount, []StatementEntry, error) {
+	a, err := f.Account(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := f.accounts.Entries(ctx, userID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, entries, nil
+}
+
+// spend checks the limits and the available money of the account and
+// debits or holds req.Amount.
+func (f *InvestorFund) spend(ctx context.Context, req ChargeRequest, typ EntryType, ref string) error {
+	if err := checkAmount(f.name, req.Amount); err != nil {
+		return err
+	}
+	if err := wait(ctx, f.name, f.cfg.Latency.Duration); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, err := f.accounts.Account(ctx, req.UserUUID)
+	if errors.Is(err, ErrAccountNotFound) {
+		return newError(f.name, CodeInsufficientFunds, "user %s has no investor account", req.UserUUID)
+	}
+	if err != nil {
+		return err
+	}
+	if a.PerOrderLimit > 0 && req.Amount > a.PerOrderLimit {
+		return newError(f.name, CodeLimitExceeded, "%.2f requested, the per-order limit is %.2f", req.Amount, a.PerOrderLimit)
+	}
+	spent, err := f.spentThisMonth(ctx, a)
+	if err != nil {
+		return err
+	}
+	if a.MonthlyLimit > 0 && spent+req.Amount > a.MonthlyLimit {
+		return newError(f.name, CodeLimitExceeded, "%.2f requested, %.2f of the monthly limit %.2f is left", req.Amount, max(a.MonthlyLimit-spent, 0), a.MonthlyLimit)
+	}
+	if req.Amount > a.Available() {
+		return newError(f.name, CodeInsufficientFunds, "investor account has %.2f available, %.2f requested", a.Available(), req.Amount)
+	}
+	return f.record(ctx, a, typ, req.Amount, req.OrderUUID, ref, "order payment")
+}
+
+// release drops the hold of auth and returns its account.
+func (f *InvestorFund) release(ctx context.Context, auth Charge) (*InvestorAccount, error) {
+	hold, err := f.accounts.Hold(ctx, auth.UserUUID, auth.Reference)
+	if errors.Is(err, ErrHoldNotFound) {
+		return nil, newError(f.name, CodeInvalidRequest, "no hold %s", auth.Reference)
+	}
+	if err != nil {
+		return nil, err
+	}
+	a, err := f.accounts.Account(ctx, auth.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.record(ctx, a, EntryRelease, hold.Amount, hold.OrderUUID, auth.Reference, "hold released"); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// record applies an entry to the account and appends it to the statement.
+func (f *InvestorFund) record(ctx context.Context, a *InvestorAccount, typ EntryType, amount float64, orderID, ref, description string) error {
+	switch typ {
+	case EntryCredit:
+		a.Balance += amount
+	case EntryDebit:
+		a.Balance -= amount
+	case EntryHold:
+		a.Held += amount
+	case EntryRelease:
+		a.Held -= amount
+	}
+	a.UpdatedAt = f.now()
+	return f.accounts.Record(ctx, a, StatementEntry{
+		ID:          uuid.NewString(),
+		Type:        typ,
+		Amount:      amount,
+		OrderUUID:   orderID,
+		Reference:   ref,
+		Description: description,
+		Balance:     a.Balance,
+		Available:   a.Available(),
+		CreatedAt:   a.UpdatedAt,
+	})
+}
+
+// spentThisMonth is what the account paid for orders in the current month
+// net of refunds, plus what it holds.
+func (f *InvestorFund) spentThisMonth(ctx context.Context, a *InvestorAccount) (float64, error) {
+	now := f.now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	entries, err := f.accounts.Entries(ctx, a.UserUUID, start, time.Time{})
+	if err != nil {
+		return 0, err
+	}
+	spent := a.Held
+	for _, e := range entries {
+		if e.OrderUUID == "" {
+			continue
+		}
+		switch e.Type {
+		case EntryDebit:
+			spent += e.Amount
+		case EntryCredit:
+			spent -= e.Amount
+		}
+	}
+	return max(spent, 0), nil
+}
+
+// snapshot returns a with SpentThisMonth set.
+func (f *InvestorFund) snapshot(ctx context.Context, a *InvestorAccount) (*InvestorAccount, error) {
+	spent, err := f.spentThisMonth(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	a.SpentThisMonth = spent
+	return a, nil
+}
+
+func checkLimits(provider string, perOrder, monthly float64) error {
+	if perOrder < 0 || monthly < 0 {
+		return newError(provider, CodeInvalidRequest, "limits must not be negative")
+	}
+	return nil
+}
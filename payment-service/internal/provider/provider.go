@@ -0,0 +1,135 @@
+// Package provider holds the payment providers behind the payment methods
+// and the router that picks one for a payment. The providers simulate real
+// gateways; every one of them can be switched to a fake mode for tests.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"payment-service/grpc/paymentpb"
+	"time"
+)
+
+// Provider charges and refunds payments of the methods routed to it.
+type Provider interface {
+	Name() string
+	Charge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	Refund(ctx context.Context, charge Charge, reason string) (string, error)
+}
+
+// Authorizer is implemented by the providers that can hold money for a
+// later capture. Capture takes amount, at most the authorized one, and
+// releases the rest of the hold; Void releases all of it.
+type Authorizer interface {
+	Authorize(ctx context.Context, req ChargeRequest) (*Charge, error)
+	Capture(ctx context.Context, auth Charge, amount float64) (string, error)
+	Void(ctx context.Context, auth Charge) error
+}
+
+// InstallmentCollector is implemented by the providers that lend the money
+// of installment plans. CollectInstallment takes an installment of amount
+// from the customer, principal of which pays back the loan of charge.
+type InstallmentCollector interface {
+	CollectInstallment(ctx context.Context, charge Charge, principal, amount float64) (string, error)
+}
+
+// DefaultAuthorizationTTL is how long an authorization holds the money if
+// the provider config does not say otherwise.
+const DefaultAuthorizationTTL = 7 * 24 * time.Hour
+
+// AuthorizerOf returns p as an Authorizer, or an UNSUPPORTED_METHOD error if
+// the provider can only charge in one step.
+func AuthorizerOf(p Provider) (Authorizer, error) {
+	if f, ok := p.(*Fake); ok {
+		if _, ok := f.Provider.(Authorizer); !ok {
+			return nil, newError(p.Name(), CodeUnsupportedMethod, "provider cannot authorize payments")
+		}
+	}
+	a, ok := p.(Authorizer)
+	if !ok {
+		return nil, newError(p.Name(), CodeUnsupportedMethod, "provider cannot authorize payments")
+	}
+	return a, nil
+}
+
+// CollectorOf returns p as an InstallmentCollector, or an
+// UNSUPPORTED_METHOD error if the provider does not lend money.
+func CollectorOf(p Provider) (InstallmentCollector, error) {
+	if f, ok := p.(*Fake); ok {
+		if _, ok := f.Provider.(InstallmentCollector); !ok {
+			return nil, newError(p.Name(), CodeUnsupportedMethod, "provider cannot collect installments")
+		}
+	}
+	c, ok := p.(InstallmentCollector)
+	if !ok {
+		return nil, newError(p.Name(), CodeUnsupportedMethod, "provider cannot collect installments")
+	}
+	return c, nil
+}
+
+type ChargeRequest struct {
+	OrderUUID string
+	UserUUID  string
+	Method    paymentpb.PaymentMethod
+	Amount    float64
+}
+
+// Charge is what a provider reports about a successful charge or
+// authorization. Reference is the provider's own ID of the charge, needed to
+// refund it. ExpiresAt is set for authorizations and pending charges.
+//
+// A Pending charge is accepted but not paid yet: the provider reports the
+// outcome later through the callback endpoint, by ExpiresAt at the latest.
+type Charge struct {
+	ChargeRequest
+	Provider  string
+	Reference string
+	ExpiresAt time.Time
+	Pending   bool
+}
+
+// Code is a provider failure code. The handler maps it to a gRPC status and
+// passes it on as the reason of the error.
+type Code string
+
+const (
+	CodeDeclined          Code = "DECLINED"
+	CodeInsufficientFunds Code = "INSUFFICIENT_FUNDS"
+	CodeLimitExceeded     Code = "LIMIT_EXCEEDED"
+	CodeInvalidRequest    Code = "INVALID_REQUEST"
+	CodeUnsupportedMethod Code = "UNSUPPORTED_METHOD"
+	CodeExpired           Code = "EXPIRED"
+	CodeUnavailable       Code = "UNAVAILABLE"
+	CodeTimeout           Code = "TIMEOUT"
+)
+
+var codes = map[Code]bool{
+	CodeDeclined:          true,
+	CodeInsufficientFunds: true,
+	CodeLimitExceeded:     true,
+	CodeInvalidRequest:    true,
+	CodeUnsupportedMethod: true,
+	CodeExpired:           true,
+	CodeUnavailable:       true,
+	CodeTimeout:           true,
+}
+
+// Valid reports whether c is one of the known failure codes.
+func (c Code) Valid() bool {
+	return codes[c]
+}
+
+// Error is a failure reported by a provider.
+type Error struct {
+	Provider string
+	Code     Code
+	Message  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Provider, e.Code, e.Message)
+}
+
+func newError(provider string, code Code, format string, args ...any) *Error {
+	return &Error{Provider: provider, Code: code, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"payment-service/grpc/paymentpb"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProviderTest struct {
+	suite.Suite
+}
+
+func charge(method paymentpb.PaymentMethod, user string, amount float64) ChargeRequest {
+	return ChargeRequest{OrderUUID: "order-1", UserUUID: user, Method: method, Amount: amount}
+}
+
+func (s *ProviderTest) requireCode(err error, code Code) {
+	var perr *Error
+	s.Require().True(errors.As(err, &perr), "expected a provider error, got %v", err)
+	s.Equal(code, perr.Code)
+}
+
+func (s *ProviderTest) fund(cfg ProviderConfig) *InvestorFund {
+	f, err := NewInvestorFund("fund", cfg, NewMemoryAccountStore())
+	s.Require().NoError(err)
+	return f
+}
+
+func (s *ProviderTest) TestRouter_DefaultRoutes() {
+	r, err := NewRouter(DefaultConfig())
+	s.Require().NoError(err)
+
+	for method, kind := range map[paymentpb.PaymentMethod]string{
+		paymentpb.PaymentMethod_CARD:           "card",
+		paymentpb.PaymentMethod_SBP:            "sbp",
+		paymentpb.PaymentMethod_CREDIT_CARD:    "credit_line",
+		paymentpb.PaymentMethod_INVESTOR_MONEY: "investor_fund",
+	} {
+		p, err := r.For(method)
+		s.Require().NoError(err)
+		s.Equal(kind, p.Name())
+	}
+
+	_, err = r.For(paymentpb.PaymentMethod_UNKNOWN)
+	s.requireCode(err, CodeUnsupportedMethod)
+}
+
+func (s *ProviderTest) TestRouter_InvalidConfig() {
+	_, err := NewRouter(Config{Routes: map[string]string{"CARD": "missing"}})
+	s.Error(err)
+
+	_, err = NewRouter(Config{Providers: map[string]ProviderConfig{"card": {Kind: "cash"}}})
+	s.Error(err)
+
+	_, err = NewRouter(Config{Providers: map[string]ProviderConfig{"card": {Kind: KindCard, FakeMode: "maybe"}}})
+	s.Error(err)
+}
+
+func (s *ProviderTest) TestRouter_SharedProvider() {
+	r, err := NewRouter(Config{
+		Routes:    map[string]string{"CARD": "acquirer", "CREDIT_CARD": "acquirer"},
+		Providers: map[string]ProviderConfig{"acquirer": {Kind: KindCard}},
+	})
+	s.Require().NoError(err)
+
+	card, _ := r.For(paymentpb.PaymentMethod_CARD)
+	credit, _ := r.For(paymentpb.PaymentMethod_CREDIT_CARD)
+	s.Same(card, credit)
+	_, err = r.For(paymentpb.PaymentMethod_SBP)
+	s.requireCode(err, CodeUnsupportedMethod)
+}
+
+func (s *ProviderTest) TestFakeModes() {
+	r, err := NewRouter(Config{
+		Routes: map[string]string{"CARD": "card", "SBP": "sbp"},
+		Providers: map[string]ProviderConfig{
+			"card": {Kind: KindCard, FakeMode: FakeApprove, DeclineOver: 1},
+			"sbp":  {Kind: KindSBP, FakeMode: string(CodeUnavailable)},
+		},
+	})
+	s.Require().NoError(err)
+	ctx := context.Background()
+
+	card, _ := r.For(paymentpb.PaymentMethod_CARD)
+	c, err := card.Charge(ctx, charge(paymentpb.PaymentMethod_CARD, "u-1", 100))
+	s.Require().NoError(err)
+	s.Equal("card", c.Provider)
+
+	sbp, _ := r.For(paymentpb.PaymentMethod_SBP)
+	_, err = sbp.Charge(ctx, charge(paymentpb.PaymentMethod_SBP, "u-1", 100))
+	s.requireCode(err, CodeUnavailable)
+}
+
+func (s *ProviderTest) TestCardGateway_DeclinesOverLimit() {
+	g := NewCardGateway("card", ProviderConfig{DeclineOver: 1000})
+	ctx := context.Background()
+
+	_, err := g.Charge(ctx, charge(paymentpb.PaymentMethod_CARD, "u-1", 1000))
+	s.NoError(err)
+	_, err = g.Charge(ctx, charge(paymentpb.PaymentMethod_CARD, "u-1", 1000.01))
+	s.requireCode(err, CodeDeclined)
+	_, err = g.Charge(ctx, charge(paymentpb.PaymentMethod_CARD, "u-1", 0))
+	s.requireCode(err, CodeInvalidRequest)
+}
+
+func (s *ProviderTest) TestCardGateway_Timeout() {
+	g := NewCardGateway("card", ProviderConfig{Latency: Duration{time.Second}})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := g.Charge(ctx, charge(paymentpb.PaymentMethod_CARD, "u-1", 10))
+	s.requireCode(err, CodeTimeout)
+}
+
+func (s *ProviderTest) TestSBP_QRCode() {
+	p := NewSBP("sbp", ProviderConfig{QRTTL: Duration{time.Minute}})
+
+	c, err := p.Charge(context.Background(), charge(paymentpb.PaymentMethod_SBP, "u-1", 1234.5))
+	s.Require().NoError(err)
+	s.Contains(c.Reference, "https://qr.nspk.ru/AS")
+	s.Contains(c.Reference, "sum=123450")
+
+	slow := NewSBP("sbp", ProviderConfig{QRTTL: Duration{time.Minute}, Latency: Duration{2 * time.Minute}})
+	_, err = slow.Charge(context.Background(), charge(paymentpb.PaymentMethod_SBP, "u-1", 10))
+	s.requireCode(err, CodeExpired)
+}
+
+func (s *ProviderTest) TestCreditLine_LimitPerUser() {
+	c := NewCreditLine("credit", ProviderConfig{CreditLimit: 1000})
+	ctx := context.Background()
+
+	first, err := c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 700))
+	s.Require().NoError(err)
+	_, err = c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 400))
+	s.requireCode(err, CodeLimitExceeded)
+	_, err = c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-2", 400))
+	s.NoError(err)
+
+	_, err = c.Refund(ctx, *first, "cancelled")
+	s.Require().NoError(err)
+	_, err = c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 1000))
+	s.NoError(err)
+}
+
+func (s *ProviderTest) TestInvestorFund_Balance() {
+	f := s.fund(ProviderConfig{InvestorAccounts: []InvestorAccountConfig{{UserUUID: "u-1", Balance: 500}}})
+	ctx := context.Background()
+
+	_, err := f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 300))
+	s.Require().NoError(err)
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 300))
+	s.requireCode(err, CodeInsufficientFunds)
+	s.ErrorContains(err, "investor account has 200.00 available, 300.00 requested")
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-2", 10))
+	s.requireCode(err, CodeInsufficientFunds)
+	s.ErrorContains(err, "user u-2 has no investor account")
+}
+
+func (s *ProviderTest) TestInvestorFund_Limits() {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	f := s.fund(ProviderConfig{})
+	f.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err := f.OpenAccount(ctx, "u-1", 1000, 1500)
+	s.Require().NoError(err)
+	_, err = f.OpenAccount(ctx, "u-1", 0, 0)
+	s.ErrorIs(err, ErrAccountExists)
+	_, err = f.Deposit(ctx, "u-1", 5000, "transfer-1")
+	s.Require().NoError(err)
+
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 1000.01))
+	s.requireCode(err, CodeLimitExceeded)
+	first, err := f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 1000))
+	s.Require().NoError(err)
+	_, err = f.Authorize(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 600))
+	s.requireCode(err, CodeLimitExceeded)
+	s.ErrorContains(err, "500.00 of the monthly limit 1500.00 is left")
+
+	// A refund frees the monthly limit again.
+	_, err = f.Refund(ctx, *first, "cancelled")
+	s.Require().NoError(err)
+	_, err = f.Authorize(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 600))
+	s.Require().NoError(err)
+
+	// Next month starts from zero.
+	now = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	a, err := f.Account(ctx, "u-1")
+	s.Require().NoError(err)
+	s.Equal(600.0, a.SpentThisMonth)
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 900))
+	s.NoError(err)
+
+	_, err = f.SetLimits(ctx, "u-1", 0, 0)
+	s.Require().NoError(err)
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 3000))
+	s.NoError(err)
+	_, err = f.Deposit(ctx, "u-2", 100, "")
+	s.ErrorIs(err, ErrAccountNotFound)
+}
+
+func (s *ProviderTest) TestInvestorFund_Statement() {
+	f := s.fund(ProviderConfig{InvestorAccounts: []InvestorAccountConfig{{UserUUID: "u-1", Balance: 1000}}})
+	ctx := context.Background()
+
+	auth, err := f.Authorize(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 400))
+	s.Require().NoError(err)
+	_, err = f.Capture(ctx, *auth, 300)
+	s.Require().NoError(err)
+
+	a, entries, err := f.Statement(ctx, "u-1", time.Time{}, time.Time{})
+	s.Require().NoError(err)
+	s.Equal(700.0, a.Balance)
+	s.Equal(0.0, a.Held)
+	s.Equal(300.0, a.SpentThisMonth)
+	var types []EntryType
+	for _, e := range entries {
+		types = append(types, e.Type)
+	}
+	s.Equal([]EntryType{EntryCredit, EntryHold, EntryRelease, EntryDebit}, types)
+	s.Equal(600.0, entries[1].Available)
+	s.Equal(700.0, entries[3].Balance)
+	s.Equal("order-1", entries[3].OrderUUID)
+
+	_, entries, err = f.Statement(ctx, "u-1", time.Now().Add(time.Hour), time.Time{})
+	s.Require().NoError(err)
+	s.Empty(entries)
+}
+
+func (s *ProviderTest) TestInvestorFund_VoidReleasesHold() {
+	f := s.fund(ProviderConfig{InvestorAccounts: []InvestorAccountConfig{{UserUUID: "u-1", Balance: 500}}})
+	ctx := context.Background()
+
+	auth, err := f.Authorize(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 400))
+	s.Require().NoError(err)
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 200))
+	s.requireCode(err, CodeInsufficientFunds)
+
+	s.Require().NoError(f.Void(ctx, *auth))
+	_, err = f.Charge(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 500))
+	s.NoError(err)
+}
+
+func (s *ProviderTest) TestInvestorFund_KeepsStoredAccounts() {
+	cfg := ProviderConfig{InvestorAccounts: []InvestorAccountConfig{{UserUUID: "u-1", Balance: 500}}}
+	store := NewMemoryAccountStore()
+	ctx := context.Background()
+
+	f, err := NewInvestorFund("fund", cfg, store)
+	s.Require().NoError(err)
+	auth, err := f.Authorize(ctx, charge(paymentpb.PaymentMethod_INVESTOR_MONEY, "u-1", 400))
+	s.Require().NoError(err)
+
+	// A restarted fund neither credits the opening balance again nor
+	// forgets the hold.
+	f, err = NewInvestorFund("fund", cfg, store)
+	s.Require().NoError(err)
+	a, err := f.Account(ctx, "u-1")
+	s.Require().NoError(err)
+	s.Equal(500.0, a.Balance)
+	s.Equal(400.0, a.Held)
+	_, err = f.Capture(ctx, *auth, 400)
+	s.Require().NoError(err)
+	s.requireCode(f.Void(ctx, *auth), CodeInvalidRequest)
+}
+
+func (s *ProviderTest) TestCreditLine_PartialCaptureFreesRest() {
+	c := NewCreditLine("credit", ProviderConfig{CreditLimit: 1000, AuthorizationTTL: Duration{time.Hour}})
+	ctx := context.Background()
+
+	auth, err := c.Authorize(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 800))
+	s.Require().NoError(err)
+	s.WithinDuration(time.Now().Add(time.Hour), auth.ExpiresAt, time.Minute)
+	_, err = c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 300))
+	s.requireCode(err, CodeLimitExceeded)
+
+	_, err = c.Capture(ctx, *auth, 500)
+	s.Require().NoError(err)
+	_, err = c.Charge(ctx, charge(paymentpb.PaymentMethod_CREDIT_CARD, "u-1", 500))
+	s.NoError(err)
+}
+
+func (s *ProviderTest) TestAuthorizerOf() {
+	_, err := AuthorizerOf(NewCardGateway("card", ProviderConfig{}))
+	s.NoError(err)
+	_, err = AuthorizerOf(NewSBP("sbp", ProviderConfig{}))
+	s.requireCode(err, CodeUnsupportedMethod)
+
+	fake, err := NewFake(NewSBP("sbp", ProviderConfig{}), FakeApprove)
+	s.Require().NoError(err)
+	_, err = AuthorizerOf(fake)
+	s.requireCode(err, CodeUnsupportedMethod)
+}
+
+func (s *ProviderTest) TestCollectorOf() {
+	_, err := CollectorOf(NewCreditLine("credit", ProviderConfig{}))
+	s.NoError(err)
+	_, err = CollectorOf(NewCardGateway("card", ProviderConfig{}))
+	s.requireCode(err, CodeUnsupportedMethod)
+
+	fake, err := NewFake(NewCardGateway("card", ProviderConfig{}), FakeApprove)
+	s.Require().NoError(err)
+	_, err = CollectorOf(fake)
+	s.requireCode(err, CodeUnsupportedMethod)
+}
+
+func TestProviderTest(t *testing.T) {
+	suite.Run(t, new(ProviderTest))
+}
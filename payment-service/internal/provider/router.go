@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"payment-service/grpc/paymentpb"
+	"sort"
+)
+
+const (
+	KindCard         = "card"
+	KindSBP          = "sbp"
+	KindCreditLine   = "credit_line"
+	KindInvestorFund = "investor_fund"
+)
+
+// Router picks the provider of a payment method.
+type Router struct {
+	routes    map[paymentpb.PaymentMethod]Provider
+	providers map[string]Provider
+	accounts  AccountStore
+}
+
+// Option configures the optional parts of the providers NewRouter builds.
+type Option func(*Router)
+
+// WithAccountStore keeps the investor accounts in store instead of memory.
+func WithAccountStore(store AccountStore) Option {
+	return func(r *Router) {
+		r.accounts = store
+	}
+}
+
+// NewRouter builds the providers of cfg and checks that every route points
+// to one of them.
+func NewRouter(cfg Config, opts ...Option) (*Router, error) {
+	r := &Router{
+		routes:    make(map[paymentpb.PaymentMethod]Provider),
+		providers: make(map[string]Provider),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.accounts == nil {
+		r.accounts = NewMemoryAccountStore()
+	}
+	names := make([]string, 0, len(cfg.Providers))
+	for name := range cfg.Providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p, err := r.newProvider(name, cfg.Providers[name])
+		if err != nil {
+			return nil, err
+		}
+		r.providers[name] = p
+	}
+	for method, name := range cfg.Routes {
+		m, ok := paymentpb.PaymentMethod_value[method]
+		if !ok || m == int32(paymentpb.PaymentMethod_UNKNOWN) {
+			return nil, fmt.Errorf("route of unknown payment method %q", method)
+		}
+		p, ok := r.providers[name]
+		if !ok {
+			return nil, fmt.Errorf("payment method %s is routed to unknown provider %q", method, name)
+		}
+		r.routes[paymentpb.PaymentMethod(m)] = p
+	}
+	for _, plan := range cfg.InstallmentPlans {
+		if plan.Months < 2 || plan.AnnualRate < 0 {
+			return nil, fmt.Errorf("invalid installment plan of %d months at %.2f%%", plan.Months, plan.AnnualRate)
+		}
+	}
+	return r, nil
+}
+
+func (r *Router) newProvider(name string, cfg ProviderConfig) (Provider, error) {
+	var (
+		p   Provider
+		err error
+	)
+	switch cfg.Kind {
+	case KindCard:
+		p = NewCardGateway(name, cfg)
+	case KindSBP:
+		p = NewSBP(name, cfg)
+	case KindCreditLine:
+		p = NewCreditLine(name, cfg)
+	case KindInvestorFund:
+		if p, err = NewInvestorFund(name, cfg, r.accounts); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("provider %s: unknown kind %q", name, cfg.Kind)
+	}
+	if cfg.FakeMode == "" {
+		return p, nil
+	}
+	return NewFake(p, cfg.FakeMode)
+}
+
+// For returns the provider of the payment method.
+func (r *Router) For(method paymentpb.PaymentMethod) (Provider, error) {
+	p, ok := r.routes[method]
+	if !ok {
+		return nil, newError("router", CodeUnsupportedMethod, "payment method %s is not available", method)
+	}
+	return p, nil
+}
+
+// Provider returns a provider by name, the one a past charge was made with.
+func (r *Router) Provider(name string) (Provider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// InvestorFund returns the investor fund INVESTOR_MONEY is routed to, the
+// one that keeps the investor accounts.
+func (r *Router) InvestorFund() (*InvestorFund, error) {
+	p, err := r.For(paymentpb.PaymentMethod_INVESTOR_MONEY)
+	if err != nil {
+		return nil, err
+	}
+	if f, ok := p.(*Fake); ok {
+		p = f.Provider
+	}
+	fund, ok := p.(*InvestorFund)
+	if !ok {
+		return nil, newError(p.Name(), CodeUnsupportedMethod, "provider has no investor accounts")
+	}
+	return fund, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SBP simulates the Faster Payments System QR flow: a QR code is issued for
+// the amount and the customer confirms it in the bank app. In async mode the
+// payment stays pending until the bank callback arrives. Otherwise the
+// confirmation is simulated to arrive after the provider latency; a QR code
+// not confirmed within its TTL expires.
+type SBP struct {
+	name string
+	cfg  ProviderConfig
+}
+
+func NewSBP(name string, cfg ProviderConfig) *SBP {
+	return &SBP{name: name, cfg: cfg}
+}
+
+func (p *SBP) Name() string {
+	return p.name
+}
+
+func (p *SBP) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if err := checkAmount(p.name, req.Amount); err != nil {
+		return nil, err
+	}
+	qrID := "AS" + uuid.NewString()
+	if p.cfg.Async {
+		c := &Charge{ChargeRequest: req, Provider: p.name, Reference: qrPayload(qrID, req.Amount), Pending: true}
+		if ttl := p.cfg.QRTTL.Duration; ttl > 0 {
+			c.ExpiresAt = time.Now().Add(ttl)
+		}
+		return c, nil
+	}
+	if ttl := p.cfg.QRTTL.Duration; ttl > 0 && p.cfg.Latency.Duration > ttl {
+		return nil, newError(p.name, CodeExpired, "QR code %s was not paid within %s", qrID, ttl)
+	}
+	if err := wait(ctx, p.name, p.cfg.Latency.Duration); err != nil {
+		return nil, err
+	}
+	return &Charge{ChargeRequest: req, Provider: p.name, Reference: qrPayload(qrID, req.Amount)}, nil
+}
+
+func (p *SBP) Refund(ctx context.Context, charge Charge, reason string) (string, error) {
+	if err := wait(ctx, p.name, p.cfg.Latency.Duration); err != nil {
+		return "", err
+	}
+	return "sbp_refund_" + uuid.NewString(), nil
+}
+
+// qrPayload is the link encoded in the QR code, the sum in kopecks.
+func qrPayload(qrID string, amount float64) string {
+	return fmt.Sprintf("https://qr.nspk.ru/%s?type=02&sum=%d&cur=RUB", qrID, int64(math.Round(amount*100)))
+}
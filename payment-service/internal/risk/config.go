@@ -0,0 +1,94 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"time"
+)
+
+// DefaultReviewTTL is how long a payment waits for an operator if the
+// config does not say otherwise.
+const DefaultReviewTTL = 24 * time.Hour
+
+// Config holds the risk rules. A rule that is left out does not fire.
+type Config struct {
+	// Velocity limits how often and how much a user pays.
+	Velocity []VelocityRule `json:"velocity,omitempty"`
+	// Methods maps a PaymentMethod name to the amounts checked for it.
+	Methods map[string]AmountRule `json:"methods,omitempty"`
+	// FirstOrder checks the amount of a user's first payment, when the user
+	// has never paid successfully before.
+	FirstOrder *AmountRule `json:"first_order,omitempty"`
+	// ReviewTTL is how long a payment can wait in REVIEW before it expires,
+	// DefaultReviewTTL if not set.
+	ReviewTTL provider.Duration `json:"review_ttl,omitempty"`
+}
+
+// VelocityRule fires when, with the payment being checked, the user made
+// more than MaxPayments payment attempts or attempted more than MaxAmount in
+// Window. Zero limits are not checked. Outcome is REVIEW or REJECT, REJECT if
+// not set.
+type VelocityRule struct {
+	Window      provider.Duration `json:"window"`
+	MaxPayments int               `json:"max_payments,omitempty"`
+	MaxAmount   float64           `json:"max_amount,omitempty"`
+	Outcome     model.RiskOutcome `json:"outcome,omitempty"`
+}
+
+// AmountRule sends payments above ReviewOver to review and rejects the ones
+// above RejectOver; 0 turns a threshold off.
+type AmountRule struct {
+	ReviewOver float64 `json:"review_over,omitempty"`
+	RejectOver float64 `json:"reject_over,omitempty"`
+}
+
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return cfg, cfg.validate()
+}
+
+func (c Config) validate() error {
+	for i, v := range c.Velocity {
+		if v.Window.Duration <= 0 {
+			return fmt.Errorf("velocity rule %d: window must be positive", i)
+		}
+		if v.MaxPayments < 0 || v.MaxAmount < 0 {
+			return fmt.Errorf("velocity rule %d: limits must not be negative", i)
+		}
+		if v.Outcome != "" && v.Outcome != model.RiskReview && v.Outcome != model.RiskReject {
+			return fmt.Errorf("velocity rule %d: outcome must be REVIEW or REJECT, not %q", i, v.Outcome)
+		}
+	}
+	for method, rule := range c.Methods {
+		if m, ok := paymentpb.PaymentMethod_value[method]; !ok || m == int32(paymentpb.PaymentMethod_UNKNOWN) {
+			return fmt.Errorf("amount rule of unknown payment method %q", method)
+		}
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("amount rule of %s: %w", method, err)
+		}
+	}
+	if c.FirstOrder != nil {
+		if err := c.FirstOrder.validate(); err != nil {
+			return fmt.Errorf("first order rule: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r AmountRule) validate() error {
+	if r.ReviewOver < 0 || r.RejectOver < 0 {
+		return fmt.Errorf("thresholds must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package risk
+
+import (
+	"fmt"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"time"
+)
+
+// Rule names, as reported in the reasons of a decision.
+const (
+	RuleVelocity     = "velocity"
+	RuleMethodAmount = "method_amount"
+	RuleFirstOrder   = "first_order"
+)
+
+// Payment is what the engine checks: who pays how much and how.
+type Payment struct {
+	UserUUID string
+	Method   paymentpb.PaymentMethod
+	Amount   float64
+}
+
+// Engine evaluates the rules of a Config. It keeps no state: the history of
+// the user is passed in.
+type Engine struct {
+	cfg Config
+}
+
+// NewEngine returns an engine of cfg, or an error if cfg is not valid.
+func NewEngine(cfg Config) (*Engine, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &Engine{cfg: cfg}, nil
+}
+
+// ReviewTTL is how long a payment can wait for an operator.
+func (e *Engine) ReviewTTL() time.Duration {
+	if e.cfg.ReviewTTL.Duration > 0 {
+		return e.cfg.ReviewTTL.Duration
+	}
+	return DefaultReviewTTL
+}
+
+// Evaluate checks p made at now against the rules, history being the earlier
+// transactions of the user. The outcome is the most severe one of the rules
+// that fired, APPROVE if none did; all of them are given as reasons.
+func (e *Engine) Evaluate(p Payment, history []*model.Transaction, now time.Time) (model.RiskOutcome, []model.RiskReason) {
+	var reasons []model.RiskReason
+	for _, v := range e.cfg.Velocity {
+		if r, ok := velocity(v, p, history, now); ok {
+			reasons = append(reasons, r)
+		}
+	}
+	if rule, ok := e.cfg.Methods[p.Method.String()]; ok {
+		if r, ok := amount(rule, RuleMethodAmount, p.Amount, fmt.Sprintf("%s payment", p.Method)); ok {
+			reasons = append(reasons, r)
+		}
+	}
+	if e.cfg.FirstOrder != nil && firstOrder(history) {
+		if r, ok := amount(*e.cfg.FirstOrder, RuleFirstOrder, p.Amount, "first payment of the user"); ok {
+			reasons = append(reasons, r)
+		}
+	}
+
+	outcome := model.RiskApprove
+	for _, r := range reasons {
+		if r.Outcome.Severity() > outcome.Severity() {
+			outcome = r.Outcome
+		}
+	}
+	return outcome, reasons
+}
+
+// velocity counts the attempts of the user in the window of v. Payments an
+// operator rejected do not count, the money never moved.
+func velocity(v VelocityRule, p Payment, history []*model.Transaction, now time.Time) (model.RiskReason, bool) {
+	count, total := 1, p.Amount
+	since := now.Add(-v.Window.Duration)
+	for _, tx := range history {
+		if tx.Status == model.TransactionRejected || !tx.CreatedAt.After(since) {
+			continue
+		}
+		count++
+		total += tx.Amount
+	}
+	outcome := v.Outcome
+	if outcome == "" {
+		outcome = model.RiskReject
+	}
+	switch {
+	case v.MaxPayments > 0 && count > v.MaxPayments:
+		return model.RiskReason{
+			Rule:    RuleVelocity,
+			Outcome: outcome,
+			Message: fmt.Sprintf("%d payments in %s, at most %d allowed", count, v.Window.Duration, v.MaxPayments),
+		}, true
+	case v.MaxAmount > 0 && total > v.MaxAmount:
+		return model.RiskReason{
+			Rule:    RuleVelocity,
+			Outcome: outcome,
+			Message: fmt.Sprintf("%.2f paid in %s, at most %.2f allowed", total, v.Window.Duration, v.MaxAmount),
+		}, true
+	}
+	return model.RiskReason{}, false
+}
+
+func amount(r AmountRule, rule string, value float64, what string) (model.RiskReason, bool) {
+	switch {
+	case r.RejectOver > 0 && value > r.RejectOver:
+		return model.RiskReason{
+			Rule:    rule,
+			Outcome: model.RiskReject,
+			Message: fmt.Sprintf("%s of %.2f is above %.2f", what, value, r.RejectOver),
+		}, true
+	case r.ReviewOver > 0 && value > r.ReviewOver:
+		return model.RiskReason{
+			Rule:    rule,
+			Outcome: model.RiskReview,
+			Message: fmt.Sprintf("%s of %.2f is above %.2f and needs a review", what, value, r.ReviewOver),
+		}, true
+	}
+	return model.RiskReason{}, false
+}
+
+// firstOrder reports whether the user has never paid successfully.
+func firstOrder(history []*model.Transaction) bool {
+	for _, tx := range history {
+		if tx.Status == model.TransactionPaid || tx.Status == model.TransactionRefunded {
+			return false
+		}
+	}
+	return true
+}
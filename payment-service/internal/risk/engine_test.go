@@ -0,0 +1,127 @@
+package risk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EngineTest struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *EngineTest) SetupTest() {
+	s.now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *EngineTest) engine(cfg Config) *Engine {
+	e, err := NewEngine(cfg)
+	s.Require().NoError(err)
+	return e
+}
+
+func (s *EngineTest) paid(ago time.Duration, amount float64) *model.Transaction {
+	return &model.Transaction{UserUUID: "user-1", Amount: amount, Status: model.TransactionPaid, CreatedAt: s.now.Add(-ago)}
+}
+
+func card(amount float64) Payment {
+	return Payment{UserUUID: "user-1", Method: paymentpb.PaymentMethod_CARD, Amount: amount}
+}
+
+func (s *EngineTest) TestNoRules() {
+	outcome, reasons := s.engine(Config{}).Evaluate(card(1e9), nil, s.now)
+	s.Equal(model.RiskApprove, outcome)
+	s.Empty(reasons)
+}
+
+func (s *EngineTest) TestVelocity() {
+	e := s.engine(Config{Velocity: []VelocityRule{
+		{Window: provider.Duration{Duration: time.Hour}, MaxPayments: 2, Outcome: model.RiskReview},
+		{Window: provider.Duration{Duration: 24 * time.Hour}, MaxAmount: 1000},
+	}})
+	history := []*model.Transaction{s.paid(2*time.Hour, 500), s.paid(30*time.Minute, 100)}
+
+	outcome, reasons := e.Evaluate(card(100), history, s.now)
+	s.Equal(model.RiskApprove, outcome)
+	s.Empty(reasons)
+
+	history = append(history, s.paid(time.Minute, 100))
+	outcome, reasons = e.Evaluate(card(100), history, s.now)
+	s.Equal(model.RiskReview, outcome)
+	s.Require().Len(reasons, 1)
+	s.Equal(RuleVelocity, reasons[0].Rule)
+	s.Equal("3 payments in 1h0m0s, at most 2 allowed", reasons[0].Message)
+
+	// Over the daily amount as well: the most severe outcome wins.
+	outcome, reasons = e.Evaluate(card(400), history, s.now)
+	s.Equal(model.RiskReject, outcome)
+	s.Len(reasons, 2)
+
+	// Payments rejected on review do not count.
+	history[2].Status = model.TransactionRejected
+	outcome, _ = e.Evaluate(card(100), history, s.now)
+	s.Equal(model.RiskApprove, outcome)
+}
+
+func (s *EngineTest) TestMethodAmount() {
+	e := s.engine(Config{Methods: map[string]AmountRule{"CARD": {ReviewOver: 100, RejectOver: 1000}}})
+
+	outcome, _ := e.Evaluate(card(100), nil, s.now)
+	s.Equal(model.RiskApprove, outcome)
+	outcome, _ = e.Evaluate(card(101), nil, s.now)
+	s.Equal(model.RiskReview, outcome)
+	outcome, reasons := e.Evaluate(card(1001), nil, s.now)
+	s.Equal(model.RiskReject, outcome)
+	s.Equal(RuleMethodAmount, reasons[0].Rule)
+
+	outcome, _ = e.Evaluate(Payment{UserUUID: "user-1", Method: paymentpb.PaymentMethod_SBP, Amount: 5000}, nil, s.now)
+	s.Equal(model.RiskApprove, outcome)
+}
+
+func (s *EngineTest) TestFirstOrder() {
+	e := s.engine(Config{FirstOrder: &AmountRule{ReviewOver: 500}})
+
+	outcome, reasons := e.Evaluate(card(600), nil, s.now)
+	s.Equal(model.RiskReview, outcome)
+	s.Require().Len(reasons, 1)
+	s.Equal(RuleFirstOrder, reasons[0].Rule)
+
+	// A failed attempt is no order yet, a paid one is.
+	failed := s.paid(time.Hour, 50)
+	failed.Status = model.TransactionFailed
+	outcome, _ = e.Evaluate(card(600), []*model.Transaction{failed}, s.now)
+	s.Equal(model.RiskReview, outcome)
+	outcome, _ = e.Evaluate(card(600), []*model.Transaction{failed, s.paid(time.Hour, 50)}, s.now)
+	s.Equal(model.RiskApprove, outcome)
+}
+
+func (s *EngineTest) TestLoadConfig() {
+	cfg, err := LoadConfig(filepath.Join("..", "..", "config", "risk.json"))
+	s.Require().NoError(err)
+	s.NotEmpty(cfg.Velocity)
+	s.Equal(24*time.Hour, s.engine(cfg).ReviewTTL())
+
+	path := filepath.Join(s.T().TempDir(), "risk.json")
+	for _, bad := range []string{
+		`{"velocity": [{"max_payments": 3}]}`,
+		`{"velocity": [{"window": "1h", "outcome": "APPROVE"}]}`,
+		`{"methods": {"CASH": {"review_over": 1}}}`,
+		`{"first_order": {"reject_over": -1}}`,
+	} {
+		s.Require().NoError(os.WriteFile(path, []byte(bad), 0o600))
+		_, err := LoadConfig(path)
+		s.Error(err, bad)
+	}
+}
+
+func TestEngineTest(t *testing.T) {
+	suite.Run(t, new(EngineTest))
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+)
+
+// Callback is the outcome of a pending payment as reported by its provider,
+// which knows the payment by Reference. A failed payment carries the
+// provider failure code, DECLINED if none is given.
+type Callback struct {
+	Provider  string
+	Reference string
+	Succeeded bool
+	Code      provider.Code
+	Message   string
+}
+
+// Notifier tells the payer that an asynchronous payment has its outcome.
+type Notifier interface {
+	Notify(ctx context.Context, tx *model.Transaction) error
+}
+
+// Status returns the transaction; a pending or reviewed payment past its
+// expiry is expired first, so callers never see a stale PENDING or REVIEW.
+func (s *Service) Status(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	tx, err := s.repo.Get(ctx, transactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if s.due(tx) {
+		return s.expireIfDue(ctx, transactionUUID)
+	}
+	return tx, nil
+}
+
+// due reports whether tx is a payment still waiting past its expiry.
+func (s *Service) due(tx *model.Transaction) bool {
+	return !tx.Status.Final() && !tx.ExpiresAt.IsZero() && !s.now().Before(tx.ExpiresAt)
+}
+
+// expireIfDue expires the payment if it is still due once its order is
+// locked; the callback may have confirmed it in the meantime.
+func (s *Service) expireIfDue(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if s.due(tx) {
+		if err := s.expirePending(ctx, tx, ""); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// Complete makes a pending payment PAID or FAILED. The provider is the
+// source of truth, so a callback is applied even if it arrives after
+// ExpiresAt as long as the payment has not been expired yet. A repeated
+// callback with the same outcome returns the transaction unchanged.
+func (s *Service) Complete(ctx context.Context, cb Callback) (*model.Transaction, error) {
+	if !cb.Succeeded {
+		if cb.Code == "" {
+			cb.Code = provider.CodeDeclined
+		}
+		if !cb.Code.Valid() {
+			return nil, fmt.Errorf("%w: unknown failure code %q", model.ErrInvalidCallback, cb.Code)
+		}
+	}
+	found, err := s.repo.GetByProviderRef(ctx, cb.Provider, cb.Reference)
+	if err != nil {
+		return nil, err
+	}
+	tx, unlock, err := s.lockTransaction(ctx, found.UUID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	to := model.TransactionPaid
+	if !cb.Succeeded {
+		to = model.TransactionFailed
+	}
+	if tx.Status != model.TransactionPending {
+		if tx.Status == to {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w: transaction is %s", model.ErrWrongStatus, tx.Status)
+	}
+
+	tx.Status = to
+	if cb.Succeeded {
+		tx.CapturedAmount = tx.Amount
+	} else {
+		tx.FailureCode = string(cb.Code)
+		tx.FailureMessage = cb.Message
+	}
+	tx.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, tx); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, tx)
+	return tx, nil
+}
+
+// expirePending expires a payment waiting for the provider or a review with
+// message, by default saying it was not confirmed or reviewed in time.
+func (s *Service) expirePending(ctx context.Context, tx *model.Transaction, message string) error {
+	tx.FailureMessage = message
+	if message == "" {
+		tx.FailureMessage = "payment was not confirmed in time"
+		if tx.Status == model.TransactionReview {
+			tx.FailureMessage = "payment was not reviewed in time"
+		}
+	}
+	tx.Status = model.TransactionExpired
+	tx.FailureCode = string(provider.CodeExpired)
+	tx.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, tx); err != nil {
+		return err
+	}
+	s.notify(ctx, tx)
+	return nil
+}
+
+// notify reports the outcome to the notifier. A lost notification is only
+// logged: the payer polls the status as well.
+func (s *Service) notify(ctx context.Context, tx *model.Transaction) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.Notify(ctx, tx); err != nil {
+		log.Printf("не удалось сообщить о результате оплаты %s заказа %s: %v", tx.UUID, tx.OrderUUID, err)
+	}
+}
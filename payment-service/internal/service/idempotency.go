@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"sync"
+)
+
+// orderLocks serialises the payments of each order and the changes of their
+// status, so that a retry racing the request it repeats waits for it instead
+// of charging again, and a payment is not both confirmed and given up on. The
+// zero value is ready to use.
+type orderLocks struct {
+	mu    sync.Mutex
+	locks map[string]*orderLock
+}
+
+type orderLock struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+// lock locks the order and returns the function unlocking it.
+func (l *orderLocks) lock(orderUUID string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*orderLock)
+	}
+	ol, ok := l.locks[orderUUID]
+	if !ok {
+		ol = &orderLock{}
+		l.locks[orderUUID] = ol
+	}
+	ol.waiters++
+	l.mu.Unlock()
+
+	ol.mu.Lock()
+	return func() {
+		ol.mu.Unlock()
+		l.mu.Lock()
+		ol.waiters--
+		if ol.waiters == 0 {
+			delete(l.locks, orderUUID)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// lockTransaction locks the order of the transaction and returns the
+// transaction as stored once locked, with the function unlocking the order.
+func (s *Service) lockTransaction(ctx context.Context, transactionUUID string) (*model.Transaction, func(), error) {
+	tx, err := s.repo.Get(ctx, transactionUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	unlock := s.payments.lock(tx.OrderUUID)
+	if tx, err = s.repo.Get(ctx, transactionUUID); err != nil {
+		unlock()
+		return nil, nil, err
+	}
+	return tx, unlock, nil
+}
+
+// previousPayment returns the payment of the order req repeats, nil if req
+// is a new one. A request with the idempotency key of an earlier payment of
+// the order repeats it whatever its status; otherwise the payment the order
+// has in progress or made (PENDING, REVIEW, AUTHORIZED or PAID) is repeated.
+// Failed, expired, rejected, voided and refunded payments let the order be
+// paid again. A repeat that differs from the payment fails with a
+// model.PaymentConflictError.
+func (s *Service) previousPayment(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error) {
+	txs, err := s.repo.ListByOrder(ctx, req.OrderUuid)
+	if err != nil {
+		return nil, err
+	}
+	var prev *model.Transaction
+	for _, tx := range txs {
+		if req.IdempotencyKey != "" && tx.IdempotencyKey == req.IdempotencyKey {
+			prev = tx
+			break
+		}
+		if holdsPayment(tx.Status) {
+			prev = tx
+		}
+	}
+	if prev == nil {
+		return nil, nil
+	}
+	if detail := differs(prev, req); detail != "" {
+		return nil, &model.PaymentConflictError{Existing: prev, Detail: detail}
+	}
+	return prev, nil
+}
+
+// previousAuthorization returns the authorization of the order req repeats,
+// nil if the order has no payment in progress or made. Any other payment of
+// the order, or an authorization with another method or amount, fails with a
+// model.PaymentConflictError.
+func (s *Service) previousAuthorization(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error) {
+	txs, err := s.repo.ListByOrder(ctx, req.OrderUuid)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		if !holdsPayment(tx.Status) {
+			continue
+		}
+		var detail string
+		switch {
+		case tx.Status != model.TransactionAuthorized:
+			detail = "not an authorization"
+		case tx.Method != req.PaymentMethod:
+			detail = fmt.Sprintf("authorized with %s, not %s", tx.Method, req.PaymentMethod)
+		case math.Abs(tx.Amount-req.Amount) >= 0.005:
+			detail = fmt.Sprintf("authorized %.2f, not %.2f", tx.Amount, req.Amount)
+		default:
+			return tx, nil
+		}
+		return nil, &model.PaymentConflictError{Existing: tx, Detail: detail}
+	}
+	return nil, nil
+}
+
+func holdsPayment(status model.TransactionStatus) bool {
+	switch status {
+	case model.TransactionPending, model.TransactionReview, model.TransactionAuthorized, model.TransactionPaid:
+		return true
+	}
+	return false
+}
+
+// differs tells how req is not a repeat of tx, "" if it is.
+func differs(tx *model.Transaction, req *paymentpb.PayOrderRequest) string {
+	switch {
+	case req.IdempotencyKey != "" && tx.IdempotencyKey != "" && req.IdempotencyKey != tx.IdempotencyKey:
+		return fmt.Sprintf("paid under idempotency key %q", tx.IdempotencyKey)
+	case tx.Status == model.TransactionAuthorized || tx.CaptureRef != "":
+		return "paid in two steps"
+	case tx.Method != req.PaymentMethod:
+		return fmt.Sprintf("paid with %s, not %s", tx.Method, req.PaymentMethod)
+	case math.Abs(tx.Amount-req.Amount) >= 0.005:
+		return fmt.Sprintf("paid %.2f, not %.2f", tx.Amount, req.Amount)
+	// A pending payment has no plan until the provider confirms it.
+	case tx.Status != model.TransactionPending && planMonths(tx) != int(req.InstallmentMonths):
+		return fmt.Sprintf("installment term %d months, not %d", planMonths(tx), req.InstallmentMonths)
+	}
+	return ""
+}
+
+func planMonths(tx *model.Transaction) int {
+	if tx.Plan == nil {
+		return 0
+	}
+	return tx.Plan.Months
+}
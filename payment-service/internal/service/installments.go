@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"time"
+)
+
+// WithInstallmentPlans offers the plans for CREDIT_CARD payments.
+func WithInstallmentPlans(plans []provider.InstallmentPlan) Option {
+	return func(s *Service) {
+		s.plans = make(map[int]float64, len(plans))
+		for _, p := range plans {
+			s.plans[p.Months] = p.AnnualRate
+		}
+	}
+}
+
+// checkPlan returns the annual rate of the installment term requested by
+// req, or ErrInvalidPlan if the term is not offered for the method.
+func (s *Service) checkPlan(req *paymentpb.PayOrderRequest) (float64, error) {
+	if req.PaymentMethod != paymentpb.PaymentMethod_CREDIT_CARD {
+		return 0, fmt.Errorf("%w: installments are only available for CREDIT_CARD", model.ErrInvalidPlan)
+	}
+	rate, ok := s.plans[int(req.InstallmentMonths)]
+	if !ok {
+		return 0, fmt.Errorf("%w: no %d-month plan is offered", model.ErrInvalidPlan, req.InstallmentMonths)
+	}
+	return rate, nil
+}
+
+// newPlan splits amount into equal monthly installments (an annuity) at
+// annualRate percent, the first due a month after start. Rounding is
+// settled in the last installment.
+func newPlan(amount float64, months int, annualRate float64, start time.Time) *model.InstallmentPlan {
+	r := annualRate / 100 / 12
+	payment := amount / float64(months)
+	if r > 0 {
+		payment = amount * r / (1 - math.Pow(1+r, -float64(months)))
+	}
+	payment = roundCents(payment)
+
+	plan := &model.InstallmentPlan{Months: months, AnnualRate: annualRate}
+	balance := amount
+	for n := 1; n <= months; n++ {
+		interest := roundCents(balance * r)
+		principal := roundCents(payment - interest)
+		if n == months {
+			principal = roundCents(balance)
+		}
+		balance = roundCents(balance - principal)
+		plan.Installments = append(plan.Installments, model.Installment{
+			Number:    n,
+			DueDate:   start.AddDate(0, n, 0),
+			Principal: principal,
+			Interest:  interest,
+			Amount:    roundCents(principal + interest),
+			Status:    model.InstallmentScheduled,
+		})
+	}
+	return plan
+}
+
+// InstallmentPlan returns the transaction with its installment plan;
+// ErrNotFound if it was paid at once.
+func (s *Service) InstallmentPlan(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	tx, err := s.repo.Get(ctx, transactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Plan == nil {
+		return nil, fmt.Errorf("%w: transaction %s has no installment plan", model.ErrNotFound, tx.UUID)
+	}
+	return tx, nil
+}
+
+// CollectInstallments collects the installments that are due. An
+// installment the provider fails to collect becomes OVERDUE and is retried
+// on the next run; the later installments of the plan wait for it. It
+// returns how many installments were paid.
+func (s *Service) CollectInstallments(ctx context.Context) (int, error) {
+	now := s.now()
+	due, err := s.repo.ListInstallmentsDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	var paid int
+	var errs []error
+	for _, tx := range due {
+		n, err := s.collect(ctx, tx, now)
+		paid += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transaction %s: %w", tx.UUID, err))
+		}
+	}
+	return paid, errors.Join(errs...)
+}
+
+func (s *Service) collect(ctx context.Context, tx *model.Transaction, now time.Time) (int, error) {
+	p, ok := s.router.Provider(tx.Provider)
+	if !ok {
+		return 0, fmt.Errorf("provider %s of transaction %s is not configured", tx.Provider, tx.UUID)
+	}
+	c, err := provider.CollectorOf(p)
+	if err != nil {
+		return 0, err
+	}
+	var paid int
+	for next := tx.Plan.Next(); next != nil && !next.DueDate.After(now); next = tx.Plan.Next() {
+		next.Attempts++
+		ref, err := c.CollectInstallment(ctx, chargeOf(tx), next.Principal, next.Amount)
+		var perr *provider.Error
+		if errors.As(err, &perr) {
+			next.Status = model.InstallmentOverdue
+			next.FailureCode = string(perr.Code)
+			break
+		}
+		if err != nil {
+			return paid, err
+		}
+		next.Status = model.InstallmentPaid
+		next.PaidAt = now
+		next.CollectRef = ref
+		next.FailureCode = ""
+		paid++
+	}
+	tx.UpdatedAt = now
+	return paid, s.repo.Update(ctx, tx)
+}
+
+// RunInstallments collects due installments every interval until ctx is
+// done.
+func RunInstallments(ctx context.Context, s PaymentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.CollectInstallments(ctx)
+		if err != nil {
+			log.Printf("не удалось списать платежи по рассрочке: %v", err)
+		}
+		if n > 0 {
+			log.Printf("списано платежей по рассрочке: %d", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cancelPlan cancels the installments of a refunded payment that are not
+// paid yet.
+func cancelPlan(plan *model.InstallmentPlan) {
+	if plan == nil {
+		return
+	}
+	for i := range plan.Installments {
+		if plan.Installments[i].Open() {
+			plan.Installments[i].Status = model.InstallmentCancelled
+		}
+	}
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"payment-service/internal/provider"
+	"time"
+)
+
+// OpenInvestorAccount opens an account with the investor fund INVESTOR_MONEY
+// is routed to. Payments move money on it through the provider like any
+// other charge; the methods below only manage it.
+func (s *Service) OpenInvestorAccount(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*provider.InvestorAccount, error) {
+	f, err := s.router.InvestorFund()
+	if err != nil {
+		return nil, err
+	}
+	return f.OpenAccount(ctx, userID, perOrderLimit, monthlyLimit)
+}
+
+func (s *Service) DepositInvestorFunds(ctx context.Context, userID string, amount float64, reference string) (*provider.InvestorAccount, error) {
+	f, err := s.router.InvestorFund()
+	if err != nil {
+		return nil, err
+	}
+	return f.Deposit(ctx, userID, amount, reference)
+}
+
+func (s *Service) SetInvestorLimits(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*provider.InvestorAccount, error) {
+	f, err := s.router.InvestorFund()
+	if err != nil {
+		return nil, err
+	}
+	return f.SetLimits(ctx, userID, perOrderLimit, monthlyLimit)
+}
+
+func (s *Service) InvestorAccount(ctx context.Context, userID string) (*provider.InvestorAccount, error) {
+	f, err := s.router.InvestorFund()
+	if err != nil {
+		return nil, err
+	}
+	return f.Account(ctx, userID)
+}
+
+func (s *Service) InvestorStatement(ctx context.Context, userID string, from, to time.Time) (*provider.InvestorAccount, []provider.StatementEntry, error) {
+	f, err := s.router.InvestorFund()
+	if err != nil {
+		return nil, nil, err
+	}
+	return f.Statement(ctx, userID, from, to)
+}
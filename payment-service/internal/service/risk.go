@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"payment-service/internal/risk"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RuleManualReview is the rule of the decisions operators make.
+const RuleManualReview = "manual_review"
+
+// ruleTwoStep rejects authorizations the rules would send to review.
+const ruleTwoStep = "two_step"
+
+// WithRiskEngine checks payments with e before they are made.
+func WithRiskEngine(e *risk.Engine) Option {
+	return func(s *Service) {
+		s.risk = e
+	}
+}
+
+// assess runs the risk checks of req and returns the decision, not stored
+// yet. Without a risk engine every payment is approved.
+func (s *Service) assess(ctx context.Context, req *paymentpb.PayOrderRequest, authorize bool) (*model.RiskDecision, error) {
+	d := &model.RiskDecision{
+		UUID:      uuid.NewString(),
+		OrderUUID: req.OrderUuid,
+		UserUUID:  req.UserUuid,
+		Method:    req.PaymentMethod,
+		Amount:    req.Amount,
+		Outcome:   model.RiskApprove,
+		CreatedAt: s.now(),
+	}
+	if s.risk == nil {
+		return d, nil
+	}
+	history, err := s.repo.ListByUser(ctx, req.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+	d.Outcome, d.Reasons = s.risk.Evaluate(risk.Payment{
+		UserUUID: req.UserUuid,
+		Method:   req.PaymentMethod,
+		Amount:   req.Amount,
+	}, history, d.CreatedAt)
+	if authorize && d.Outcome == model.RiskReview {
+		d.Outcome = model.RiskReject
+		d.Reasons = append(d.Reasons, model.RiskReason{
+			Rule:    ruleTwoStep,
+			Outcome: model.RiskReject,
+			Message: "an authorization cannot wait for a review",
+		})
+	}
+	return d, nil
+}
+
+// rejection is the error of a payment the risk checks rejected, with the
+// reasons that rejected it.
+func rejection(d *model.RiskDecision) error {
+	var msgs []string
+	for _, r := range d.Reasons {
+		if r.Outcome == model.RiskReject {
+			msgs = append(msgs, r.Message)
+		}
+	}
+	return fmt.Errorf("%w: %s", model.ErrRiskRejected, strings.Join(msgs, "; "))
+}
+
+// ApprovePayment charges the payment an operator approved. A charge the
+// provider refuses makes the payment FAILED with the provider code; it is
+// returned without an error, the review itself went through.
+func (s *Service) ApprovePayment(ctx context.Context, transactionUUID, operator, comment string) (*model.Transaction, *model.RiskDecision, error) {
+	tx, unlock, err := s.inReview(ctx, transactionUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+	p, err := s.router.For(tx.Method)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.charge(ctx, p, tx); err != nil {
+		var perr *provider.Error
+		if !errors.As(err, &perr) {
+			return nil, nil, err
+		}
+		tx.Status = model.TransactionFailed
+		tx.Provider = perr.Provider
+		tx.FailureCode = string(perr.Code)
+		tx.FailureMessage = perr.Message
+		tx.ExpiresAt = time.Time{}
+		tx.Plan = nil
+		tx.UpdatedAt = s.now()
+	}
+	return s.review(ctx, tx, model.RiskApprove, operator, comment)
+}
+
+// RejectPayment makes the payment an operator rejected REJECTED; it was
+// never charged.
+func (s *Service) RejectPayment(ctx context.Context, transactionUUID, operator, comment string) (*model.Transaction, *model.RiskDecision, error) {
+	tx, unlock, err := s.inReview(ctx, transactionUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+	tx.Status = model.TransactionRejected
+	tx.FailureCode = model.RiskRejectedCode
+	tx.FailureMessage = "rejected on review"
+	if comment != "" {
+		tx.FailureMessage += ": " + comment
+	}
+	tx.ExpiresAt = time.Time{}
+	tx.Plan = nil
+	tx.UpdatedAt = s.now()
+	return s.review(ctx, tx, model.RiskReject, operator, comment)
+}
+
+func (s *Service) RiskDecisions(ctx context.Context, f model.DecisionFilter, awaitingReview bool) ([]*model.RiskDecision, error) {
+	if awaitingReview {
+		f.Outcome = model.RiskReview
+	}
+	decisions, err := s.repo.ListDecisions(ctx, f)
+	if err != nil || !awaitingReview {
+		return decisions, err
+	}
+	var waiting []*model.RiskDecision
+	for _, d := range decisions {
+		if d.TransactionUUID == "" {
+			continue
+		}
+		tx, err := s.Status(ctx, d.TransactionUUID)
+		if err != nil {
+			return nil, err
+		}
+		if tx.Status == model.TransactionReview {
+			waiting = append(waiting, d)
+		}
+	}
+	return waiting, nil
+}
+
+// inReview locks the order of the transaction and returns the transaction if
+// it still waits for an operator, with the function unlocking the order; so
+// two operators cannot both decide, nor charge it twice. A review past its
+// expiry expires the payment instead.
+func (s *Service) inReview(ctx context.Context, transactionUUID string) (*model.Transaction, func(), error) {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.due(tx) {
+		if err := s.expirePending(ctx, tx, ""); err != nil {
+			unlock()
+			return nil, nil, err
+		}
+	}
+	if tx.Status != model.TransactionReview {
+		unlock()
+		return nil, nil, fmt.Errorf("%w: transaction is %s, not in review", model.ErrWrongStatus, tx.Status)
+	}
+	return tx, unlock, nil
+}
+
+// review stores the reviewed transaction with the operator's decision and
+// tells the payer if the payment has its outcome.
+func (s *Service) review(ctx context.Context, tx *model.Transaction, outcome model.RiskOutcome, operator, comment string) (*model.Transaction, *model.RiskDecision, error) {
+	if err := s.repo.Update(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+	d := &model.RiskDecision{
+		UUID:            uuid.NewString(),
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		Method:          tx.Method,
+		Amount:          tx.Amount,
+		Outcome:         outcome,
+		Reasons:         []model.RiskReason{{Rule: RuleManualReview, Outcome: outcome, Message: comment}},
+		TransactionUUID: tx.UUID,
+		Operator:        operator,
+		CreatedAt:       s.now(),
+	}
+	if err := s.repo.SaveDecision(ctx, d); err != nil {
+		return nil, nil, err
+	}
+	if tx.Status.Final() {
+		s.notify(ctx, tx)
+	}
+	return tx, d, nil
+}
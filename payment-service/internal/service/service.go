@@ -0,0 +1,493 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"payment-service/internal/risk"
+	repo "payment-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentService interface {
+	Pay(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error)
+	// Refund returns the refund transaction UUID; refunding a transaction
+	// again returns the same UUID.
+	Refund(ctx context.Context, transactionUUID, reason string) (string, error)
+	Authorize(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error)
+	// Capture takes amount of an authorization, all of it if amount is 0.
+	Capture(ctx context.Context, transactionUUID string, amount float64) (*model.Transaction, error)
+	Void(ctx context.Context, transactionUUID, reason string) (*model.Transaction, error)
+	// Transactions returns the transactions that match f, oldest first.
+	Transactions(ctx context.Context, f model.TransactionFilter) ([]*model.Transaction, error)
+	// Status returns the transaction with its current status.
+	Status(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// Complete applies the outcome of a pending payment reported by its
+	// provider.
+	Complete(ctx context.Context, cb Callback) (*model.Transaction, error)
+	// ExpireTransactions releases the authorizations whose hold has run
+	// out, fails the pending payments nobody confirmed or reviewed in time
+	// and returns how many there were.
+	ExpireTransactions(ctx context.Context) (int, error)
+
+	// The investor accounts INVESTOR_MONEY is paid from.
+	OpenInvestorAccount(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*provider.InvestorAccount, error)
+	DepositInvestorFunds(ctx context.Context, userID string, amount float64, reference string) (*provider.InvestorAccount, error)
+	SetInvestorLimits(ctx context.Context, userID string, perOrderLimit, monthlyLimit float64) (*provider.InvestorAccount, error)
+	InvestorAccount(ctx context.Context, userID string) (*provider.InvestorAccount, error)
+	// InvestorStatement lists the entries of the account made in
+	// [from, to); zero times leave the range open.
+	InvestorStatement(ctx context.Context, userID string, from, to time.Time) (*provider.InvestorAccount, []provider.StatementEntry, error)
+
+	// InstallmentPlan returns the transaction of a payment made in
+	// installments.
+	InstallmentPlan(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// CollectInstallments collects the installments that are due and
+	// returns how many were paid.
+	CollectInstallments(ctx context.Context) (int, error)
+
+	// ApprovePayment charges a payment held in REVIEW; RejectPayment makes
+	// it REJECTED. Both record the operator's decision.
+	ApprovePayment(ctx context.Context, transactionUUID, operator, comment string) (*model.Transaction, *model.RiskDecision, error)
+	RejectPayment(ctx context.Context, transactionUUID, operator, comment string) (*model.Transaction, *model.RiskDecision, error)
+	// RiskDecisions returns the decisions that match f; with awaitingReview
+	// only the REVIEW decisions of payments still waiting for an operator.
+	RiskDecisions(ctx context.Context, f model.DecisionFilter, awaitingReview bool) ([]*model.RiskDecision, error)
+}
+
+type Service struct {
+	repo     repo.TransactionRepo
+	router   *provider.Router
+	notifier Notifier
+	// plans maps the installment terms offered, in months, to their
+	// annual rate.
+	plans map[int]float64
+	// risk checks the payments before they are made; nil approves all.
+	risk *risk.Engine
+	// payments keeps two payments of an order from running at once.
+	payments orderLocks
+	now      func() time.Time
+}
+
+// Option configures the optional parts of Service.
+type Option func(*Service)
+
+// WithNotifier reports the outcome of asynchronous payments to n.
+func WithNotifier(n Notifier) Option {
+	return func(s *Service) {
+		s.notifier = n
+	}
+}
+
+func NewPaymentService(r repo.TransactionRepo, router *provider.Router, opts ...Option) PaymentService {
+	s := &Service{repo: r, router: router, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Pay charges the order with the provider the payment method is routed to.
+// If the provider confirms payments asynchronously, the transaction is
+// PENDING until Complete is called or it expires. With InstallmentMonths
+// set, the payment gets an installment plan at the rate configured for the
+// term.
+//
+// The risk checks run before the charge: a rejected payment fails with
+// ErrRiskRejected, one sent to review is stored in REVIEW uncharged.
+//
+// Pay is idempotent: a repeat of an earlier payment of the order, by its
+// idempotency key or while the order has a payment in progress or made,
+// returns that payment; one that differs from it fails with a
+// PaymentConflictError (see previousPayment).
+func (s *Service) Pay(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error) {
+	unlock := s.payments.lock(req.OrderUuid)
+	defer unlock()
+	if prev, err := s.previousPayment(ctx, req); err != nil || prev != nil {
+		return prev, err
+	}
+
+	var rate float64
+	if req.InstallmentMonths > 0 {
+		var err error
+		if rate, err = s.checkPlan(req); err != nil {
+			return nil, err
+		}
+	}
+	p, err := s.router.For(req.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+	if req.InstallmentMonths > 0 {
+		if _, err := provider.CollectorOf(p); err != nil {
+			return nil, err
+		}
+	}
+	decision, err := s.assess(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	tx := &model.Transaction{
+		UUID:           uuid.NewString(),
+		OrderUUID:      req.OrderUuid,
+		UserUUID:       req.UserUuid,
+		IdempotencyKey: req.IdempotencyKey,
+		Method:         req.PaymentMethod,
+		Amount:         req.Amount,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if req.InstallmentMonths > 0 {
+		tx.Plan = newPlan(req.Amount, int(req.InstallmentMonths), rate, now)
+	}
+	switch decision.Outcome {
+	case model.RiskReject:
+		if err := s.repo.SaveDecision(ctx, decision); err != nil {
+			return nil, err
+		}
+		return nil, rejection(decision)
+	case model.RiskReview:
+		tx.Status = model.TransactionReview
+		tx.ExpiresAt = now.Add(s.risk.ReviewTTL())
+	default:
+		if err := s.charge(ctx, p, tx); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.Create(ctx, tx); err != nil {
+		return nil, err
+	}
+	decision.TransactionUUID = tx.UUID
+	if err := s.repo.SaveDecision(ctx, decision); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// charge charges tx with p. A charge the provider confirms later leaves tx
+// PENDING and without an installment plan; otherwise the plan starts now.
+func (s *Service) charge(ctx context.Context, p provider.Provider, tx *model.Transaction) error {
+	charge, err := p.Charge(ctx, provider.ChargeRequest{
+		OrderUUID: tx.OrderUUID,
+		UserUUID:  tx.UserUUID,
+		Method:    tx.Method,
+		Amount:    tx.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	tx.Provider = charge.Provider
+	tx.ProviderRef = charge.Reference
+	tx.UpdatedAt = now
+	if charge.Pending {
+		tx.Status = model.TransactionPending
+		tx.ExpiresAt = charge.ExpiresAt
+		tx.Plan = nil
+		return nil
+	}
+	tx.Status = model.TransactionPaid
+	tx.CapturedAmount = tx.Amount
+	tx.ExpiresAt = time.Time{}
+	if tx.Plan != nil {
+		tx.Plan = newPlan(tx.Amount, tx.Plan.Months, tx.Plan.AnnualRate, now)
+	}
+	return nil
+}
+
+func (s *Service) Transactions(ctx context.Context, f model.TransactionFilter) ([]*model.Transaction, error) {
+	return s.repo.List(ctx, f)
+}
+
+// Refund gives the payment back. Refunding a refunded transaction returns
+// its refund again, so a retry is safe.
+func (s *Service) Refund(ctx context.Context, transactionUUID, reason string) (string, error) {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+	switch tx.Status {
+	case model.TransactionRefunded:
+		return tx.RefundUUID, nil
+	case model.TransactionPaid:
+	default:
+		return "", fmt.Errorf("%w: cannot refund a %s transaction", model.ErrWrongStatus, tx.Status)
+	}
+	p, ok := s.router.Provider(tx.Provider)
+	if !ok {
+		return "", fmt.Errorf("provider %s of transaction %s is not configured", tx.Provider, tx.UUID)
+	}
+	ref, err := p.Refund(ctx, chargeOf(tx), reason)
+	if err != nil {
+		return "", err
+	}
+	tx.Status = model.TransactionRefunded
+	tx.RefundUUID = uuid.NewString()
+	tx.RefundRef = ref
+	cancelPlan(tx.Plan)
+	tx.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, tx); err != nil {
+		return "", err
+	}
+	return tx.RefundUUID, nil
+}
+
+// Authorize holds the order amount with the provider the payment method is
+// routed to, for a later capture. Methods whose provider can only charge in
+// one step fail with UNSUPPORTED_METHOD. An authorization cannot wait for a
+// review, so the risk checks either let it through or reject it.
+//
+// Like Pay, Authorize is idempotent: a repeat of the authorization the order
+// holds returns it, while authorizing an order that has another payment in
+// progress or made fails with a PaymentConflictError.
+func (s *Service) Authorize(ctx context.Context, req *paymentpb.PayOrderRequest) (*model.Transaction, error) {
+	unlock := s.payments.lock(req.OrderUuid)
+	defer unlock()
+	if prev, err := s.previousAuthorization(ctx, req); err != nil || prev != nil {
+		return prev, err
+	}
+
+	p, err := s.router.For(req.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+	a, err := provider.AuthorizerOf(p)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := s.assess(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+	if decision.Outcome == model.RiskReject {
+		if err := s.repo.SaveDecision(ctx, decision); err != nil {
+			return nil, err
+		}
+		return nil, rejection(decision)
+	}
+	auth, err := a.Authorize(ctx, provider.ChargeRequest{
+		OrderUUID: req.OrderUuid,
+		UserUUID:  req.UserUuid,
+		Method:    req.PaymentMethod,
+		Amount:    req.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	tx := &model.Transaction{
+		UUID:        uuid.NewString(),
+		OrderUUID:   req.OrderUuid,
+		UserUUID:    req.UserUuid,
+		Method:      req.PaymentMethod,
+		Amount:      req.Amount,
+		Provider:    auth.Provider,
+		ProviderRef: auth.Reference,
+		Status:      model.TransactionAuthorized,
+		ExpiresAt:   auth.ExpiresAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.Create(ctx, tx); err != nil {
+		return nil, err
+	}
+	decision.TransactionUUID = tx.UUID
+	if err := s.repo.SaveDecision(ctx, decision); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Capture takes amount of the authorization and releases the rest of the
+// hold; only one capture is possible. Capturing a captured transaction again
+// with the same amount returns it unchanged, so a retry is safe. An
+// authorization past its expiry is released and ErrAuthorizationExpired is
+// returned.
+func (s *Service) Capture(ctx context.Context, transactionUUID string, amount float64) (*model.Transaction, error) {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if amount < 0 {
+		return nil, fmt.Errorf("%w: capture amount must not be negative", model.ErrInvalidAmount)
+	}
+	switch tx.Status {
+	case model.TransactionAuthorized:
+	case model.TransactionPaid:
+		if tx.ExpiresAt.IsZero() || (amount != 0 && amount != tx.CapturedAmount) {
+			return nil, fmt.Errorf("%w: transaction is already captured for %.2f", model.ErrWrongStatus, tx.CapturedAmount)
+		}
+		return tx, nil
+	case model.TransactionExpired:
+		return nil, model.ErrAuthorizationExpired
+	default:
+		return nil, fmt.Errorf("%w: cannot capture a %s transaction", model.ErrWrongStatus, tx.Status)
+	}
+	if amount == 0 {
+		amount = tx.Amount
+	}
+	if amount > tx.Amount {
+		return nil, fmt.Errorf("%w: cannot capture %.2f of %.2f authorized", model.ErrInvalidAmount, amount, tx.Amount)
+	}
+	if !s.now().Before(tx.ExpiresAt) {
+		if err := s.release(ctx, tx, model.TransactionExpired, "authorization expired"); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrAuthorizationExpired
+	}
+
+	a, err := s.authorizer(tx)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := a.Capture(ctx, chargeOf(tx), amount)
+	if err != nil {
+		return nil, err
+	}
+	tx.Status = model.TransactionPaid
+	tx.CapturedAmount = amount
+	tx.CaptureRef = ref
+	tx.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Void releases the hold of an authorization. Voiding a voided or expired
+// authorization does nothing, the money is released either way.
+//
+// A payment still waiting for the provider or a review is expired instead,
+// for a payer that gave up waiting: a confirmation arriving later is refused
+// rather than charging for an order that is not waiting for it anymore.
+func (s *Service) Void(ctx context.Context, transactionUUID, reason string) (*model.Transaction, error) {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	switch tx.Status {
+	case model.TransactionAuthorized:
+	case model.TransactionVoided, model.TransactionExpired:
+		return tx, nil
+	case model.TransactionPending, model.TransactionReview:
+		if err := s.expirePending(ctx, tx, reason); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	default:
+		return nil, fmt.Errorf("%w: cannot void a %s transaction", model.ErrWrongStatus, tx.Status)
+	}
+	if err := s.release(ctx, tx, model.TransactionVoided, reason); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (s *Service) ExpireTransactions(ctx context.Context) (int, error) {
+	expiring, err := s.repo.ListExpiring(ctx, s.now())
+	if err != nil {
+		return 0, err
+	}
+	var errs []error
+	for _, tx := range expiring {
+		if !tx.Status.Final() {
+			_, err = s.expireIfDue(ctx, tx.UUID)
+		} else {
+			err = s.expireAuthorization(ctx, tx.UUID)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transaction %s: %w", tx.UUID, err))
+		}
+	}
+	return len(expiring) - len(errs), errors.Join(errs...)
+}
+
+// RunExpiry expires transactions every interval until ctx is done.
+func RunExpiry(ctx context.Context, s PaymentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.ExpireTransactions(ctx)
+		if err != nil {
+			log.Printf("не удалось обработать просроченные транзакции: %v", err)
+		}
+		if n > 0 {
+			log.Printf("просрочено транзакций: %d", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// release voids the authorization with its provider and moves it to status.
+// expireAuthorization releases an authorization past its expiry, unless it
+// was captured or voided while its order was being locked.
+func (s *Service) expireAuthorization(ctx context.Context, transactionUUID string) error {
+	tx, unlock, err := s.lockTransaction(ctx, transactionUUID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if tx.Status != model.TransactionAuthorized || s.now().Before(tx.ExpiresAt) {
+		return nil
+	}
+	return s.release(ctx, tx, model.TransactionExpired, "authorization expired")
+}
+
+func (s *Service) release(ctx context.Context, tx *model.Transaction, status model.TransactionStatus, reason string) error {
+	a, err := s.authorizer(tx)
+	if err != nil {
+		return err
+	}
+	if err := a.Void(ctx, chargeOf(tx)); err != nil {
+		return err
+	}
+	tx.Status = status
+	tx.VoidReason = reason
+	tx.UpdatedAt = s.now()
+	return s.repo.Update(ctx, tx)
+}
+
+func (s *Service) authorizer(tx *model.Transaction) (provider.Authorizer, error) {
+	p, ok := s.router.Provider(tx.Provider)
+	if !ok {
+		return nil, fmt.Errorf("provider %s of transaction %s is not configured", tx.Provider, tx.UUID)
+	}
+	return provider.AuthorizerOf(p)
+}
+
+// chargeOf rebuilds the provider charge of tx. Once captured, the charge is
+// only the captured amount, less the principal of the installments paid.
+func chargeOf(tx *model.Transaction) provider.Charge {
+	amount := tx.Amount
+	if tx.Status == model.TransactionPaid {
+		amount = tx.CapturedAmount
+	}
+	if tx.Plan != nil {
+		amount = roundCents(amount - tx.Plan.PrincipalPaid())
+	}
+	return provider.Charge{
+		ChargeRequest: provider.ChargeRequest{
+			OrderUUID: tx.OrderUUID,
+			UserUUID:  tx.UserUUID,
+			Method:    tx.Method,
+			Amount:    amount,
+		},
+		Provider:  tx.Provider,
+		Reference: tx.ProviderRef,
+		ExpiresAt: tx.ExpiresAt,
+	}
+}
@@ -0,0 +1,685 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"payment-service/internal/provider"
+	"payment-service/internal/risk"
+	repo "payment-service/repository"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTest struct {
+	suite.Suite
+	ctx      context.Context
+	repo     *repo.MemoryRepo
+	svc      *Service
+	now      time.Time
+	notified []model.Transaction
+}
+
+type notifierFunc func(ctx context.Context, tx *model.Transaction) error
+
+func (f notifierFunc) Notify(ctx context.Context, tx *model.Transaction) error {
+	return f(ctx, tx)
+}
+
+func (s *ServiceTest) SetupTest() {
+	router, err := provider.NewRouter(provider.Config{
+		Routes: map[string]string{"CARD": "card", "SBP": "sbp", "CREDIT_CARD": "credit"},
+		Providers: map[string]provider.ProviderConfig{
+			"card":   {Kind: provider.KindCard, AuthorizationTTL: provider.Duration{Duration: time.Hour}},
+			"sbp":    {Kind: provider.KindSBP, Async: true, QRTTL: provider.Duration{Duration: 15 * time.Minute}},
+			"credit": {Kind: provider.KindCreditLine, CreditLimit: 1000, DeclineOver: 200},
+		},
+	})
+	s.Require().NoError(err)
+	s.ctx = context.Background()
+	s.repo = repo.NewMemoryRepo()
+	s.now = time.Now()
+	s.notified = nil
+	s.svc = &Service{repo: s.repo, router: router, plans: map[int]float64{3: 0, 12: 12}, now: func() time.Time { return s.now }}
+	s.svc.notifier = notifierFunc(func(_ context.Context, tx *model.Transaction) error {
+		s.notified = append(s.notified, *tx)
+		return nil
+	})
+}
+
+func TestServiceTest(t *testing.T) {
+	suite.Run(t, new(ServiceTest))
+}
+
+func payRequest(method paymentpb.PaymentMethod, amount float64) *paymentpb.PayOrderRequest {
+	return &paymentpb.PayOrderRequest{OrderUuid: "order-1", UserUuid: "user-1", PaymentMethod: method, Amount: amount}
+}
+
+func (s *ServiceTest) TestAuthorize_PartialCapture() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 800))
+	s.Require().NoError(err)
+	s.Equal(model.TransactionAuthorized, auth.Status)
+	s.False(auth.ExpiresAt.IsZero())
+
+	tx, err := s.svc.Capture(s.ctx, auth.UUID, 600)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, tx.Status)
+	s.Equal(600.0, tx.CapturedAmount)
+	s.NotEmpty(tx.CaptureRef)
+
+	// The released 200 can be used again.
+	other := payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 400)
+	other.OrderUuid = "order-2"
+	_, err = s.svc.Pay(s.ctx, other)
+	s.NoError(err)
+
+	again, err := s.svc.Capture(s.ctx, auth.UUID, 0)
+	s.Require().NoError(err)
+	s.Equal(tx.CaptureRef, again.CaptureRef)
+	_, err = s.svc.Capture(s.ctx, auth.UUID, 700)
+	s.ErrorIs(err, model.ErrWrongStatus)
+}
+
+func (s *ServiceTest) TestCapture_InvalidAmount() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+
+	_, err = s.svc.Capture(s.ctx, auth.UUID, 100.01)
+	s.ErrorIs(err, model.ErrInvalidAmount)
+	_, err = s.svc.Capture(s.ctx, auth.UUID, -1)
+	s.ErrorIs(err, model.ErrInvalidAmount)
+}
+
+func (s *ServiceTest) TestCapture_Expired() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+
+	s.now = auth.ExpiresAt
+	_, err = s.svc.Capture(s.ctx, auth.UUID, 0)
+	s.ErrorIs(err, model.ErrAuthorizationExpired)
+
+	stored, err := s.repo.Get(s.ctx, auth.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, stored.Status)
+
+	voided, err := s.svc.Void(s.ctx, auth.UUID, "cancelled")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, voided.Status)
+}
+
+func (s *ServiceTest) TestVoid() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 1000))
+	s.Require().NoError(err)
+
+	tx, err := s.svc.Void(s.ctx, auth.UUID, "order cancelled")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionVoided, tx.Status)
+	s.Equal("order cancelled", tx.VoidReason)
+
+	_, err = s.svc.Capture(s.ctx, auth.UUID, 0)
+	s.ErrorIs(err, model.ErrWrongStatus)
+	_, err = s.svc.Refund(s.ctx, auth.UUID, "cancelled")
+	s.ErrorIs(err, model.ErrWrongStatus)
+	// The whole line is free again.
+	_, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 1000))
+	s.NoError(err)
+}
+
+func (s *ServiceTest) TestVoid_Pending() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+
+	expired, err := s.svc.Void(s.ctx, tx.UUID, "payment timed out")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, expired.Status)
+	s.Equal("payment timed out", expired.FailureMessage)
+	s.Require().Len(s.notified, 1)
+
+	// A confirmation arriving after the payer gave up is refused.
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Succeeded: true})
+	s.ErrorIs(err, model.ErrWrongStatus)
+	again, err := s.svc.Void(s.ctx, tx.UUID, "payment timed out")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, again.Status)
+}
+
+func (s *ServiceTest) TestAuthorize_UnsupportedMethod() {
+	_, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 100))
+	var perr *provider.Error
+	s.Require().True(errors.As(err, &perr))
+	s.Equal(provider.CodeUnsupportedMethod, perr.Code)
+}
+
+func (s *ServiceTest) TestExpireAuthorizations() {
+	old, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+	req := payRequest(paymentpb.PaymentMethod_CARD, 100)
+	req.OrderUuid = "order-2"
+	paid, err := s.svc.Authorize(s.ctx, req)
+	s.Require().NoError(err)
+	_, err = s.svc.Capture(s.ctx, paid.UUID, 0)
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(2 * time.Hour)
+	n, err := s.svc.ExpireTransactions(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	stored, err := s.repo.Get(s.ctx, old.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, stored.Status)
+	stored, err = s.repo.Get(s.ctx, paid.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, stored.Status)
+}
+
+func (s *ServiceTest) TestAuthorize_Idempotent() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 300))
+	s.Require().NoError(err)
+
+	again, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 300))
+	s.Require().NoError(err)
+	s.Equal(auth.UUID, again.UUID)
+
+	var conflict *model.PaymentConflictError
+	_, err = s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 400))
+	s.Require().ErrorAs(err, &conflict)
+	s.Equal(auth.UUID, conflict.Existing.UUID)
+	_, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 300))
+	s.ErrorIs(err, model.ErrAlreadyPaid)
+
+	// Once voided, the order can be authorized again.
+	_, err = s.svc.Void(s.ctx, auth.UUID, "order changed")
+	s.Require().NoError(err)
+	other, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 400))
+	s.Require().NoError(err)
+	s.NotEqual(auth.UUID, other.UUID)
+}
+
+// concurrently runs f n times at once and returns the errors it returned.
+func concurrently(n int, f func(i int) error) []error {
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = f(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (s *ServiceTest) TestAuthorize_Concurrent() {
+	uuids := make([]string, 4)
+	errs := concurrently(len(uuids), func(i int) error {
+		tx, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 300))
+		if err == nil {
+			uuids[i] = tx.UUID
+		}
+		return err
+	})
+	for i, err := range errs {
+		s.Require().NoError(err)
+		s.Equal(uuids[0], uuids[i])
+	}
+	txs, err := s.repo.ListByOrder(s.ctx, "order-1")
+	s.Require().NoError(err)
+	s.Len(txs, 1)
+}
+
+func (s *ServiceTest) TestCapture_Concurrent() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+
+	refs := make([]string, 4)
+	errs := concurrently(len(refs), func(i int) error {
+		tx, err := s.svc.Capture(s.ctx, auth.UUID, 0)
+		if err == nil {
+			refs[i] = tx.CaptureRef
+		}
+		return err
+	})
+	for i, err := range errs {
+		s.Require().NoError(err)
+		s.Equal(refs[0], refs[i])
+	}
+}
+
+func (s *ServiceTest) TestRefund_Concurrent() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+
+	refunds := make([]string, 4)
+	errs := concurrently(len(refunds), func(i int) error {
+		var err error
+		refunds[i], err = s.svc.Refund(s.ctx, tx.UUID, "order cancelled")
+		return err
+	})
+	for i, err := range errs {
+		s.Require().NoError(err)
+		s.Equal(refunds[0], refunds[i])
+	}
+	stored, err := s.repo.Get(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(refunds[0], stored.RefundUUID)
+}
+
+func (s *ServiceTest) TestExpireAuthorizations_CapturedMeanwhile() {
+	auth, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+	// The expiry job listed the authorization, then it was captured before
+	// the job got to it.
+	_, err = s.svc.Capture(s.ctx, auth.UUID, 0)
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(2 * time.Hour)
+	s.Require().NoError(s.svc.expireAuthorization(s.ctx, auth.UUID))
+	stored, err := s.repo.Get(s.ctx, auth.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, stored.Status)
+}
+
+func (s *ServiceTest) TestPay_PendingUntilCallback() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPending, tx.Status)
+	s.Contains(tx.ProviderRef, "https://qr.nspk.ru/")
+	s.WithinDuration(time.Now().Add(15*time.Minute), tx.ExpiresAt, time.Minute)
+
+	paid, err := s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Succeeded: true})
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, paid.Status)
+	s.Equal(150.0, paid.CapturedAmount)
+	s.Require().Len(s.notified, 1)
+	s.Equal(tx.UUID, s.notified[0].UUID)
+
+	again, err := s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Succeeded: true})
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, again.Status)
+	s.Len(s.notified, 1)
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef})
+	s.ErrorIs(err, model.ErrWrongStatus)
+
+	// A paid async payment is refunded like any other.
+	_, err = s.svc.Refund(s.ctx, tx.UUID, "cancelled")
+	s.NoError(err)
+}
+
+func (s *ServiceTest) TestComplete_Failed() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Code: "NOPE"})
+	s.ErrorIs(err, model.ErrInvalidCallback)
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "card", Reference: tx.ProviderRef, Succeeded: true})
+	s.ErrorIs(err, model.ErrNotFound)
+
+	failed, err := s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Message: "cancelled in the bank app"})
+	s.Require().NoError(err)
+	s.Equal(model.TransactionFailed, failed.Status)
+	s.Equal(string(provider.CodeDeclined), failed.FailureCode)
+	s.Equal("cancelled in the bank app", failed.FailureMessage)
+}
+
+func (s *ServiceTest) TestStatus_ExpiresPending() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+
+	got, err := s.svc.Status(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPending, got.Status)
+
+	s.now = tx.ExpiresAt
+	got, err = s.svc.Status(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, got.Status)
+	s.Equal(string(provider.CodeExpired), got.FailureCode)
+	s.Len(s.notified, 1)
+
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef, Succeeded: true})
+	s.ErrorIs(err, model.ErrWrongStatus)
+}
+
+func (s *ServiceTest) TestExpireTransactions_Pending() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+
+	s.now = s.now.Add(time.Hour)
+	n, err := s.svc.ExpireTransactions(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(1, n)
+	stored, err := s.repo.Get(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, stored.Status)
+}
+
+func (s *ServiceTest) TestNewPlan() {
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	plan := newPlan(100, 3, 0, start)
+	s.Require().Len(plan.Installments, 3)
+	s.Equal([]float64{33.33, 33.33, 33.34}, []float64{plan.Installments[0].Amount, plan.Installments[1].Amount, plan.Installments[2].Amount})
+	s.Equal(100.0, plan.Total())
+	s.Equal(start.AddDate(0, 1, 0), plan.Installments[0].DueDate)
+
+	// 1000 over 12 months at 12% a year: 88.85 a month.
+	plan = newPlan(1000, 12, 12, start)
+	var principal float64
+	for _, i := range plan.Installments[:11] {
+		s.Equal(88.85, i.Amount)
+		principal += i.Principal
+	}
+	s.Equal(10.0, plan.Installments[0].Interest)
+	s.InDelta(1000, principal+plan.Installments[11].Principal, 0.001)
+	s.InDelta(88.85, plan.Installments[11].Amount, 0.05)
+}
+
+func (s *ServiceTest) TestPay_InvalidPlan() {
+	req := payRequest(paymentpb.PaymentMethod_CARD, 150)
+	req.InstallmentMonths = 3
+	_, err := s.svc.Pay(s.ctx, req)
+	s.ErrorIs(err, model.ErrInvalidPlan)
+
+	req = payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 150)
+	req.InstallmentMonths = 6
+	_, err = s.svc.Pay(s.ctx, req)
+	s.ErrorIs(err, model.ErrInvalidPlan)
+
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 150))
+	s.Require().NoError(err)
+	_, err = s.svc.InstallmentPlan(s.ctx, tx.UUID)
+	s.ErrorIs(err, model.ErrNotFound)
+}
+
+func (s *ServiceTest) TestCollectInstallments() {
+	req := payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 900)
+	req.InstallmentMonths = 3
+	tx, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().NotNil(tx.Plan)
+	s.Equal(900.0, tx.Plan.Remaining())
+
+	// Nothing is due before the first month is over.
+	n, err := s.svc.CollectInstallments(s.ctx)
+	s.Require().NoError(err)
+	s.Zero(n)
+
+	// Installments of 300 are above the 200 the bank lets through.
+	s.now = s.now.AddDate(0, 1, 0)
+	n, err = s.svc.CollectInstallments(s.ctx)
+	s.Require().NoError(err)
+	s.Zero(n)
+	got, err := s.svc.InstallmentPlan(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	first := got.Plan.Installments[0]
+	s.Equal(model.InstallmentOverdue, first.Status)
+	s.Equal(string(provider.CodeDeclined), first.FailureCode)
+	s.Equal(1, first.Attempts)
+	s.Equal(300.0, got.Plan.Overdue())
+	s.Equal(&got.Plan.Installments[0], got.Plan.Next())
+}
+
+func (s *ServiceTest) TestCollectInstallments_PaidAndRefunded() {
+	req := payRequest(paymentpb.PaymentMethod_CREDIT_CARD, 450)
+	req.InstallmentMonths = 3
+	tx, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+
+	// Two months late both due installments are collected in one run.
+	s.now = s.now.AddDate(0, 2, 0)
+	n, err := s.svc.CollectInstallments(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(2, n)
+	got, err := s.svc.InstallmentPlan(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(300.0, got.Plan.Paid())
+	s.Equal(150.0, got.Plan.Remaining())
+	s.Equal(model.InstallmentPaid, got.Plan.Installments[1].Status)
+	s.Equal(s.now, got.Plan.Installments[1].PaidAt)
+	s.Equal(3, got.Plan.Next().Number)
+
+	_, err = s.svc.Refund(s.ctx, tx.UUID, "returned")
+	s.Require().NoError(err)
+	got, err = s.svc.InstallmentPlan(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.InstallmentCancelled, got.Plan.Installments[2].Status)
+	s.Zero(got.Plan.Remaining())
+	s.Nil(got.Plan.Next())
+}
+
+func (s *ServiceTest) withRisk(cfg risk.Config) {
+	e, err := risk.NewEngine(cfg)
+	s.Require().NoError(err)
+	s.svc.risk = e
+}
+
+func (s *ServiceTest) TestPay_RiskRejected() {
+	s.withRisk(risk.Config{Methods: map[string]risk.AmountRule{"CARD": {RejectOver: 100}}})
+
+	_, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.ErrorIs(err, model.ErrRiskRejected)
+	s.ErrorContains(err, "CARD payment of 150.00 is above 100.00")
+
+	decisions, err := s.svc.RiskDecisions(s.ctx, model.DecisionFilter{OrderUUID: "order-1"}, false)
+	s.Require().NoError(err)
+	s.Require().Len(decisions, 1)
+	s.Equal(model.RiskReject, decisions[0].Outcome)
+	s.Empty(decisions[0].TransactionUUID)
+
+	// Approved payments have their decision stored as well.
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 50))
+	s.Require().NoError(err)
+	decisions, err = s.svc.RiskDecisions(s.ctx, model.DecisionFilter{TransactionUUID: tx.UUID}, false)
+	s.Require().NoError(err)
+	s.Require().Len(decisions, 1)
+	s.Equal(model.RiskApprove, decisions[0].Outcome)
+}
+
+func (s *ServiceTest) TestPay_ReviewApproved() {
+	s.withRisk(risk.Config{FirstOrder: &risk.AmountRule{ReviewOver: 100}})
+
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.Require().NoError(err)
+	s.Equal(model.TransactionReview, tx.Status)
+	s.Empty(tx.Provider)
+	s.Equal(s.now.Add(risk.DefaultReviewTTL), tx.ExpiresAt)
+
+	waiting, err := s.svc.RiskDecisions(s.ctx, model.DecisionFilter{}, true)
+	s.Require().NoError(err)
+	s.Require().Len(waiting, 1)
+	s.Equal(risk.RuleFirstOrder, waiting[0].Reasons[0].Rule)
+
+	approved, d, err := s.svc.ApprovePayment(s.ctx, tx.UUID, "alice", "called the customer")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionPaid, approved.Status)
+	s.Equal(150.0, approved.CapturedAmount)
+	s.Equal("card", approved.Provider)
+	s.True(approved.ExpiresAt.IsZero())
+	s.Equal("alice", d.Operator)
+	s.Equal(model.RiskApprove, d.Outcome)
+	s.Require().Len(s.notified, 1)
+
+	_, _, err = s.svc.RejectPayment(s.ctx, tx.UUID, "bob", "")
+	s.ErrorIs(err, model.ErrWrongStatus)
+	waiting, err = s.svc.RiskDecisions(s.ctx, model.DecisionFilter{}, true)
+	s.Require().NoError(err)
+	s.Empty(waiting)
+	all, err := s.svc.RiskDecisions(s.ctx, model.DecisionFilter{TransactionUUID: tx.UUID}, false)
+	s.Require().NoError(err)
+	s.Len(all, 2)
+}
+
+func (s *ServiceTest) TestPay_ReviewDecidedOnce() {
+	s.withRisk(risk.Config{FirstOrder: &risk.AmountRule{ReviewOver: 100}})
+
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionReview, tx.Status)
+
+	errs := concurrently(4, func(i int) error {
+		if i%2 == 0 {
+			_, _, err := s.svc.ApprovePayment(s.ctx, tx.UUID, "alice", "")
+			return err
+		}
+		_, _, err := s.svc.RejectPayment(s.ctx, tx.UUID, "bob", "")
+		return err
+	})
+
+	decided := 0
+	for _, err := range errs {
+		if err == nil {
+			decided++
+			continue
+		}
+		s.ErrorIs(err, model.ErrWrongStatus)
+	}
+	s.Equal(1, decided)
+	s.Len(s.notified, 1)
+	all, err := s.svc.RiskDecisions(s.ctx, model.DecisionFilter{TransactionUUID: tx.UUID}, false)
+	s.Require().NoError(err)
+	s.Len(all, 2)
+}
+
+func (s *ServiceTest) TestPay_ReviewRejectedOrExpired() {
+	s.withRisk(risk.Config{
+		Methods:   map[string]risk.AmountRule{"CARD": {ReviewOver: 100}},
+		ReviewTTL: provider.Duration{Duration: time.Hour},
+	})
+
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.Require().NoError(err)
+	rejected, _, err := s.svc.RejectPayment(s.ctx, tx.UUID, "alice", "stolen card")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionRejected, rejected.Status)
+	s.Equal(model.RiskRejectedCode, rejected.FailureCode)
+	s.Equal("rejected on review: stolen card", rejected.FailureMessage)
+	s.Len(s.notified, 1)
+
+	tx, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.Require().NoError(err)
+	s.now = s.now.Add(time.Hour)
+	_, _, err = s.svc.ApprovePayment(s.ctx, tx.UUID, "alice", "")
+	s.ErrorIs(err, model.ErrWrongStatus)
+	expired, err := s.repo.Get(s.ctx, tx.UUID)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, expired.Status)
+	s.Equal("payment was not reviewed in time", expired.FailureMessage)
+
+	// An order that gave up waiting voids the payment before it is decided.
+	tx, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.Require().NoError(err)
+	voided, err := s.svc.Void(s.ctx, tx.UUID, "payment timed out")
+	s.Require().NoError(err)
+	s.Equal(model.TransactionExpired, voided.Status)
+	s.Equal("payment timed out", voided.FailureMessage)
+	_, _, err = s.svc.ApprovePayment(s.ctx, tx.UUID, "alice", "")
+	s.ErrorIs(err, model.ErrWrongStatus)
+}
+
+func (s *ServiceTest) TestAuthorize_ReviewRejects() {
+	s.withRisk(risk.Config{Methods: map[string]risk.AmountRule{"CARD": {ReviewOver: 100}}})
+
+	_, err := s.svc.Authorize(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 150))
+	s.ErrorIs(err, model.ErrRiskRejected)
+	s.ErrorContains(err, "an authorization cannot wait for a review")
+}
+
+func (s *ServiceTest) TestTransactions() {
+	start := s.now
+	paid, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+	s.now = s.now.Add(time.Hour)
+	req := payRequest(paymentpb.PaymentMethod_SBP, 200)
+	req.OrderUuid = "order-2"
+	pending, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+
+	all, err := s.svc.Transactions(s.ctx, model.TransactionFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(all, 2)
+	s.Equal(paid.UUID, all[0].UUID)
+
+	later, err := s.svc.Transactions(s.ctx, model.TransactionFilter{CreatedFrom: start.Add(time.Minute)})
+	s.Require().NoError(err)
+	s.Require().Len(later, 1)
+	s.Equal(pending.UUID, later[0].UUID)
+
+	byStatus, err := s.svc.Transactions(s.ctx, model.TransactionFilter{
+		CreatedTo: s.now.Add(time.Minute),
+		Statuses:  []model.TransactionStatus{model.TransactionPaid, model.TransactionRefunded},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byStatus, 1)
+	s.Equal(paid.UUID, byStatus[0].UUID)
+}
+
+func (s *ServiceTest) TestPay_Idempotent() {
+	tx, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+
+	again, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 100))
+	s.Require().NoError(err)
+	s.Equal(tx.UUID, again.UUID)
+
+	var conflict *model.PaymentConflictError
+	_, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_CARD, 120))
+	s.ErrorIs(err, model.ErrAlreadyPaid)
+	s.Require().ErrorAs(err, &conflict)
+	s.Equal(tx.UUID, conflict.Existing.UUID)
+	s.Equal("paid 100.00, not 120.00", conflict.Detail)
+	_, err = s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 100))
+	s.ErrorContains(err, "paid with CARD, not SBP")
+
+	// Once refunded the order can be paid again, and the new payment is
+	// repeated by its key.
+	_, err = s.svc.Refund(s.ctx, tx.UUID, "cancelled")
+	s.Require().NoError(err)
+	keyed := payRequest(paymentpb.PaymentMethod_CARD, 100)
+	keyed.IdempotencyKey = "attempt-2"
+	second, err := s.svc.Pay(s.ctx, keyed)
+	s.Require().NoError(err)
+	s.NotEqual(tx.UUID, second.UUID)
+	s.Equal("attempt-2", second.IdempotencyKey)
+
+	again, err = s.svc.Pay(s.ctx, keyed)
+	s.Require().NoError(err)
+	s.Equal(second.UUID, again.UUID)
+	keyed.IdempotencyKey = "attempt-3"
+	_, err = s.svc.Pay(s.ctx, keyed)
+	s.ErrorContains(err, `paid under idempotency key "attempt-2"`)
+
+	txs, err := s.repo.ListByOrder(s.ctx, "order-1")
+	s.Require().NoError(err)
+	s.Len(txs, 2)
+}
+
+func (s *ServiceTest) TestPay_IdempotentAfterFailure() {
+	req := payRequest(paymentpb.PaymentMethod_SBP, 150)
+	req.IdempotencyKey = "attempt-1"
+	tx, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+	_, err = s.svc.Complete(s.ctx, Callback{Provider: "sbp", Reference: tx.ProviderRef})
+	s.Require().NoError(err)
+
+	// The failed attempt is still what its key returns ...
+	again, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+	s.Equal(tx.UUID, again.UUID)
+	s.Equal(model.TransactionFailed, again.Status)
+
+	// ... while a new attempt, by another key or without one, pays again.
+	req.IdempotencyKey = "attempt-2"
+	retry, err := s.svc.Pay(s.ctx, req)
+	s.Require().NoError(err)
+	s.NotEqual(tx.UUID, retry.UUID)
+	s.Equal(model.TransactionPending, retry.Status)
+	unkeyed, err := s.svc.Pay(s.ctx, payRequest(paymentpb.PaymentMethod_SBP, 150))
+	s.Require().NoError(err)
+	s.Equal(retry.UUID, unkeyed.UUID)
+}
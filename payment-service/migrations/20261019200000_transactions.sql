@@ -0,0 +1,71 @@
+-- +goose Up
+CREATE TABLE transactions (
+    id TEXT PRIMARY KEY,
+    order_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL DEFAULT '',
+    method TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    captured_amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    provider TEXT NOT NULL,
+    provider_ref TEXT NOT NULL DEFAULT '',
+    capture_ref TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    void_reason TEXT NOT NULL DEFAULT '',
+    failure_code TEXT NOT NULL DEFAULT '',
+    failure_message TEXT NOT NULL DEFAULT '',
+    refund_id TEXT NOT NULL DEFAULT '',
+    refund_ref TEXT NOT NULL DEFAULT '',
+    plan_months INT,
+    plan_annual_rate NUMERIC(6, 3),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX transactions_order_idx ON transactions (order_id, created_at);
+CREATE INDEX transactions_user_idx ON transactions (user_id, created_at);
+CREATE INDEX transactions_created_idx ON transactions (created_at);
+CREATE INDEX transactions_provider_ref_idx ON transactions (provider, provider_ref);
+CREATE INDEX transactions_expiring_idx ON transactions (expires_at)
+    WHERE status IN ('AUTHORIZED', 'PENDING', 'REVIEW');
+
+CREATE TABLE installments (
+    transaction_id TEXT NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+    number INT NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    principal NUMERIC(12, 2) NOT NULL,
+    interest NUMERIC(12, 2) NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    status TEXT NOT NULL,
+    paid_at TIMESTAMPTZ,
+    collect_ref TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    failure_code TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (transaction_id, number)
+);
+
+CREATE INDEX installments_open_idx ON installments (due_date)
+    WHERE status IN ('SCHEDULED', 'OVERDUE');
+
+CREATE TABLE risk_decisions (
+    id TEXT PRIMARY KEY,
+    order_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    method TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    outcome TEXT NOT NULL,
+    reasons JSONB NOT NULL DEFAULT '[]',
+    transaction_id TEXT NOT NULL DEFAULT '',
+    operator TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX risk_decisions_order_idx ON risk_decisions (order_id);
+CREATE INDEX risk_decisions_user_idx ON risk_decisions (user_id);
+CREATE INDEX risk_decisions_transaction_idx ON risk_decisions (transaction_id);
+
+-- +goose Down
+DROP TABLE risk_decisions;
+DROP TABLE installments;
+DROP TABLE transactions;
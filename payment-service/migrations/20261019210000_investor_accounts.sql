@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE investor_accounts (
+    user_id TEXT PRIMARY KEY,
+    balance NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    held NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    per_order_limit NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    monthly_limit NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE investor_entries (
+    seq BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    id TEXT NOT NULL UNIQUE,
+    user_id TEXT NOT NULL REFERENCES investor_accounts (user_id),
+    type TEXT NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL,
+    order_id TEXT NOT NULL DEFAULT '',
+    reference TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    balance NUMERIC(14, 2) NOT NULL,
+    available NUMERIC(14, 2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX investor_entries_user_idx ON investor_entries (user_id, created_at);
+CREATE INDEX investor_entries_reference_idx ON investor_entries (reference);
+
+-- +goose Down
+DROP TABLE investor_entries;
+DROP TABLE investor_accounts;
@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"payment-service/internal/provider"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// InvestorAccountStore keeps the investor accounts of the investor fund and
+// their statements in Postgres.
+type InvestorAccountStore struct {
+	pool *pgxpool.Pool
+}
+
+func NewInvestorAccountStore(pool *pgxpool.Pool) *InvestorAccountStore {
+	return &InvestorAccountStore{pool: pool}
+}
+
+func (s *InvestorAccountStore) CreateAccount(ctx context.Context, a *provider.InvestorAccount) error {
+	tag, err := s.pool.Exec(ctx, `INSERT INTO investor_accounts (user_id, balance, held, per_order_limit, monthly_limit, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (user_id) DO NOTHING`,
+		a.UserUUID, a.Balance, a.Held, a.PerOrderLimit, a.MonthlyLimit, a.CreatedAt, a.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return provider.ErrAccountExists
+	}
+	return nil
+}
+
+func (s *InvestorAccountStore) Account(ctx context.Context, userID string) (*provider.InvestorAccount, error) {
+	var a provider.InvestorAccount
+	err := s.pool.QueryRow(ctx, `SELECT user_id, balance, held, per_order_limit, monthly_limit, created_at, updated_at FROM investor_accounts WHERE user_id = $1`, userID).
+		Scan(&a.UserUUID, &a.Balance, &a.Held, &a.PerOrderLimit, &a.MonthlyLimit, &a.CreatedAt, &a.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, provider.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (s *InvestorAccountStore) SetLimits(ctx context.Context, a *provider.InvestorAccount) error {
+	tag, err := s.pool.Exec(ctx, `UPDATE investor_accounts SET per_order_limit = $2, monthly_limit = $3, updated_at = $4 WHERE user_id = $1`,
+		a.UserUUID, a.PerOrderLimit, a.MonthlyLimit, a.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return provider.ErrAccountNotFound
+	}
+	return nil
+}
+
+func (s *InvestorAccountStore) Record(ctx context.Context, a *provider.InvestorAccount, e provider.StatementEntry) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE investor_accounts SET balance = $2, held = $3, updated_at = $4 WHERE user_id = $1`,
+		a.UserUUID, a.Balance, a.Held, a.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return provider.ErrAccountNotFound
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO investor_entries (id, user_id, type, amount, order_id, reference, description, balance, available, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		e.ID, a.UserUUID, e.Type, e.Amount, e.OrderUUID, e.Reference, e.Description, e.Balance, e.Available, e.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+const entryColumns = `id, type, amount, order_id, reference, description, balance, available, created_at`
+
+func (s *InvestorAccountStore) Entries(ctx context.Context, userID string, from, to time.Time) ([]provider.StatementEntry, error) {
+	rows, err := s.pool.Query(ctx, `SELECT `+entryColumns+` FROM investor_entries
+		WHERE user_id = $1 AND ($2::timestamptz IS NULL OR created_at >= $2) AND ($3::timestamptz IS NULL OR created_at < $3)
+		ORDER BY created_at, seq`,
+		userID, nullTime(from), nullTime(to))
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, scanEntry)
+}
+
+func (s *InvestorAccountStore) Hold(ctx context.Context, userID, ref string) (*provider.StatementEntry, error) {
+	rows, err := s.pool.Query(ctx, `SELECT `+entryColumns+` FROM investor_entries
+		WHERE user_id = $1 AND reference = $2 AND type = $3
+		AND NOT EXISTS (SELECT 1 FROM investor_entries WHERE user_id = $1 AND reference = $2 AND type = $4)`,
+		userID, ref, provider.EntryHold, provider.EntryRelease)
+	if err != nil {
+		return nil, err
+	}
+	e, err := pgx.CollectOneRow(rows, scanEntry)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, provider.ErrHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func scanEntry(row pgx.CollectableRow) (provider.StatementEntry, error) {
+	var e provider.StatementEntry
+	err := row.Scan(&e.ID, &e.Type, &e.Amount, &e.OrderUUID, &e.Reference, &e.Description, &e.Balance, &e.Available, &e.CreatedAt)
+	return e, err
+}
@@ -0,0 +1,286 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"payment-service/grpc/paymentpb"
+	"payment-service/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresRepo keeps the transactions, their installment plans and the risk
+// decisions in Postgres, see the migrations directory for the schema.
+type PostgresRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresRepo(pool *pgxpool.Pool) *PostgresRepo {
+	return &PostgresRepo{pool: pool}
+}
+
+const transactionColumns = `id, order_id, user_id, idempotency_key, method, amount, captured_amount, provider, provider_ref, capture_ref, status, expires_at, void_reason, failure_code, failure_message, refund_id, refund_ref, plan_months, plan_annual_rate, created_at, updated_at`
+
+func (r *PostgresRepo) Create(ctx context.Context, tx *model.Transaction) error {
+	dbtx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback(ctx)
+
+	months, rate := planColumns(tx.Plan)
+	_, err = dbtx.Exec(ctx, `INSERT INTO transactions (`+transactionColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)`,
+		tx.UUID, tx.OrderUUID, tx.UserUUID, tx.IdempotencyKey, tx.Method.String(), tx.Amount, tx.CapturedAmount,
+		tx.Provider, tx.ProviderRef, tx.CaptureRef, tx.Status, nullTime(tx.ExpiresAt), tx.VoidReason,
+		tx.FailureCode, tx.FailureMessage, tx.RefundUUID, tx.RefundRef, months, rate, tx.CreatedAt, tx.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if err := saveInstallments(ctx, dbtx, tx); err != nil {
+		return err
+	}
+	return dbtx.Commit(ctx)
+}
+
+func (r *PostgresRepo) Get(ctx context.Context, uuid string) (*model.Transaction, error) {
+	txs, err := r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE id = $1`, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return txs[0], nil
+}
+
+// Update saves tx and replaces its installment plan.
+func (r *PostgresRepo) Update(ctx context.Context, tx *model.Transaction) error {
+	dbtx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbtx.Rollback(ctx)
+
+	months, rate := planColumns(tx.Plan)
+	tag, err := dbtx.Exec(ctx, `UPDATE transactions SET idempotency_key = $2, method = $3, amount = $4, captured_amount = $5, provider = $6, provider_ref = $7, capture_ref = $8, status = $9, expires_at = $10, void_reason = $11, failure_code = $12, failure_message = $13, refund_id = $14, refund_ref = $15, plan_months = $16, plan_annual_rate = $17, updated_at = $18 WHERE id = $1`,
+		tx.UUID, tx.IdempotencyKey, tx.Method.String(), tx.Amount, tx.CapturedAmount, tx.Provider, tx.ProviderRef,
+		tx.CaptureRef, tx.Status, nullTime(tx.ExpiresAt), tx.VoidReason, tx.FailureCode, tx.FailureMessage,
+		tx.RefundUUID, tx.RefundRef, months, rate, tx.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	if _, err := dbtx.Exec(ctx, `DELETE FROM installments WHERE transaction_id = $1`, tx.UUID); err != nil {
+		return err
+	}
+	if err := saveInstallments(ctx, dbtx, tx); err != nil {
+		return err
+	}
+	return dbtx.Commit(ctx)
+}
+
+func (r *PostgresRepo) GetByProviderRef(ctx context.Context, provider, ref string) (*model.Transaction, error) {
+	txs, err := r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE provider = $1 AND provider_ref = $2 LIMIT 1`, provider, ref)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return txs[0], nil
+}
+
+func (r *PostgresRepo) ListExpiring(ctx context.Context, t time.Time) ([]*model.Transaction, error) {
+	return r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE status IN ($1, $2, $3) AND expires_at <= $4`,
+		model.TransactionAuthorized, model.TransactionPending, model.TransactionReview, t)
+}
+
+func (r *PostgresRepo) ListInstallmentsDue(ctx context.Context, t time.Time) ([]*model.Transaction, error) {
+	return r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE status = $1 AND id IN (SELECT transaction_id FROM installments WHERE status IN ($2, $3) AND due_date <= $4)`,
+		model.TransactionPaid, model.InstallmentScheduled, model.InstallmentOverdue, t)
+}
+
+func (r *PostgresRepo) List(ctx context.Context, f model.TransactionFilter) ([]*model.Transaction, error) {
+	statuses := make([]string, 0, len(f.Statuses))
+	for _, s := range f.Statuses {
+		statuses = append(statuses, string(s))
+	}
+	return r.query(ctx, `SELECT `+transactionColumns+` FROM transactions
+		WHERE ($1::timestamptz IS NULL OR created_at >= $1) AND ($2::timestamptz IS NULL OR created_at < $2) AND (cardinality($3::text[]) = 0 OR status = ANY($3))
+		ORDER BY created_at, id`,
+		nullTime(f.CreatedFrom), nullTime(f.CreatedTo), statuses)
+}
+
+func (r *PostgresRepo) ListByUser(ctx context.Context, userUUID string) ([]*model.Transaction, error) {
+	return r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE user_id = $1 ORDER BY created_at, id`, userUUID)
+}
+
+func (r *PostgresRepo) ListByOrder(ctx context.Context, orderUUID string) ([]*model.Transaction, error) {
+	return r.query(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE order_id = $1 ORDER BY created_at, id`, orderUUID)
+}
+
+// riskReason is how a model.RiskReason is stored in risk_decisions.reasons.
+type riskReason struct {
+	Rule    string            `json:"rule"`
+	Outcome model.RiskOutcome `json:"outcome"`
+	Message string            `json:"message"`
+}
+
+func (r *PostgresRepo) SaveDecision(ctx context.Context, d *model.RiskDecision) error {
+	reasons := make([]riskReason, 0, len(d.Reasons))
+	for _, v := range d.Reasons {
+		reasons = append(reasons, riskReason(v))
+	}
+	data, err := json.Marshal(reasons)
+	if err != nil {
+		return err
+	}
+	_, err = r.pool.Exec(ctx, `INSERT INTO risk_decisions (id, order_id, user_id, method, amount, outcome, reasons, transaction_id, operator, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		d.UUID, d.OrderUUID, d.UserUUID, d.Method.String(), d.Amount, d.Outcome, data, d.TransactionUUID, d.Operator, d.CreatedAt)
+	return err
+}
+
+func (r *PostgresRepo) ListDecisions(ctx context.Context, f model.DecisionFilter) ([]*model.RiskDecision, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, order_id, user_id, method, amount, outcome, reasons, transaction_id, operator, created_at FROM risk_decisions
+		WHERE ($1 = '' OR order_id = $1) AND ($2 = '' OR user_id = $2) AND ($3 = '' OR transaction_id = $3) AND ($4 = '' OR outcome = $4)
+		ORDER BY created_at, id`,
+		f.OrderUUID, f.UserUUID, f.TransactionUUID, string(f.Outcome))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var found []*model.RiskDecision
+	for rows.Next() {
+		var (
+			d       model.RiskDecision
+			method  string
+			reasons []byte
+		)
+		if err := rows.Scan(&d.UUID, &d.OrderUUID, &d.UserUUID, &method, &d.Amount, &d.Outcome, &reasons, &d.TransactionUUID, &d.Operator, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		d.Method = paymentpb.PaymentMethod(paymentpb.PaymentMethod_value[method])
+		var stored []riskReason
+		if err := json.Unmarshal(reasons, &stored); err != nil {
+			return nil, err
+		}
+		for _, v := range stored {
+			d.Reasons = append(d.Reasons, model.RiskReason(v))
+		}
+		found = append(found, &d)
+	}
+	return found, rows.Err()
+}
+
+// query returns the transactions the query selects, with their installment
+// plans, in the order it selects them.
+func (r *PostgresRepo) query(ctx context.Context, sql string, args ...any) ([]*model.Transaction, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := pgx.CollectRows(rows, scanTransaction)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadInstallments(ctx, txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func scanTransaction(row pgx.CollectableRow) (*model.Transaction, error) {
+	var (
+		tx        model.Transaction
+		method    string
+		expiresAt *time.Time
+		months    *int
+		rate      *float64
+	)
+	err := row.Scan(&tx.UUID, &tx.OrderUUID, &tx.UserUUID, &tx.IdempotencyKey, &method, &tx.Amount, &tx.CapturedAmount,
+		&tx.Provider, &tx.ProviderRef, &tx.CaptureRef, &tx.Status, &expiresAt, &tx.VoidReason, &tx.FailureCode,
+		&tx.FailureMessage, &tx.RefundUUID, &tx.RefundRef, &months, &rate, &tx.CreatedAt, &tx.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	tx.Method = paymentpb.PaymentMethod(paymentpb.PaymentMethod_value[method])
+	if expiresAt != nil {
+		tx.ExpiresAt = *expiresAt
+	}
+	if months != nil {
+		tx.Plan = &model.InstallmentPlan{Months: *months}
+		if rate != nil {
+			tx.Plan.AnnualRate = *rate
+		}
+	}
+	return &tx, nil
+}
+
+// loadInstallments fills in the installments of the txs that have a plan.
+func (r *PostgresRepo) loadInstallments(ctx context.Context, txs []*model.Transaction) error {
+	plans := make(map[string]*model.InstallmentPlan)
+	var ids []string
+	for _, tx := range txs {
+		if tx.Plan != nil {
+			plans[tx.UUID] = tx.Plan
+			ids = append(ids, tx.UUID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	rows, err := r.pool.Query(ctx, `SELECT transaction_id, number, due_date, principal, interest, amount, status, paid_at, collect_ref, attempts, failure_code FROM installments WHERE transaction_id = ANY($1) ORDER BY transaction_id, number`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			id     string
+			i      model.Installment
+			paidAt *time.Time
+		)
+		if err := rows.Scan(&id, &i.Number, &i.DueDate, &i.Principal, &i.Interest, &i.Amount, &i.Status, &paidAt, &i.CollectRef, &i.Attempts, &i.FailureCode); err != nil {
+			return err
+		}
+		if paidAt != nil {
+			i.PaidAt = *paidAt
+		}
+		plans[id].Installments = append(plans[id].Installments, i)
+	}
+	return rows.Err()
+}
+
+func saveInstallments(ctx context.Context, dbtx pgx.Tx, tx *model.Transaction) error {
+	if tx.Plan == nil {
+		return nil
+	}
+	for _, i := range tx.Plan.Installments {
+		_, err := dbtx.Exec(ctx, `INSERT INTO installments (transaction_id, number, due_date, principal, interest, amount, status, paid_at, collect_ref, attempts, failure_code) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			tx.UUID, i.Number, i.DueDate, i.Principal, i.Interest, i.Amount, i.Status, nullTime(i.PaidAt), i.CollectRef, i.Attempts, i.FailureCode)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func planColumns(p *model.InstallmentPlan) (*int, *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Months, &p.AnnualRate
+}
+
+// nullTime stores the zero time as NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+	"payment-service/internal/model"
+	"sort"
+	"sync"
+	"time"
+)
+
+type TransactionRepo interface {
+	Create(ctx context.Context, tx *model.Transaction) error
+	// Get returns model.ErrNotFound for an unknown transaction.
+	Get(ctx context.Context, uuid string) (*model.Transaction, error)
+	Update(ctx context.Context, tx *model.Transaction) error
+	// GetByProviderRef finds a transaction by the reference its provider
+	// gave it; model.ErrNotFound if there is none.
+	GetByProviderRef(ctx context.Context, provider, ref string) (*model.Transaction, error)
+	// ListExpiring returns the authorizations, pending payments and
+	// payments in review that expire at or before t.
+	ListExpiring(ctx context.Context, t time.Time) ([]*model.Transaction, error)
+	// ListInstallmentsDue returns the transactions with unpaid installments
+	// due at or before t.
+	ListInstallmentsDue(ctx context.Context, t time.Time) ([]*model.Transaction, error)
+	// List returns the transactions that match f, oldest first.
+	List(ctx context.Context, f model.TransactionFilter) ([]*model.Transaction, error)
+	// ListByUser returns the transactions of the user, oldest first.
+	ListByUser(ctx context.Context, userUUID string) ([]*model.Transaction, error)
+	// ListByOrder returns the transactions of the order, oldest first.
+	ListByOrder(ctx context.Context, orderUUID string) ([]*model.Transaction, error)
+
+	SaveDecision(ctx context.Context, d *model.RiskDecision) error
+	// ListDecisions returns the risk decisions that match f, oldest first.
+	ListDecisions(ctx context.Context, f model.DecisionFilter) ([]*model.RiskDecision, error)
+}
+
+// MemoryRepo keeps the transactions in memory; they are lost on restart.
+type MemoryRepo struct {
+	mu           sync.RWMutex
+	transactions map[string]model.Transaction
+	decisions    []model.RiskDecision
+}
+
+func NewMemoryRepo() *MemoryRepo {
+	return &MemoryRepo{
+		transactions: make(map[string]model.Transaction),
+	}
+}
+
+func (r *MemoryRepo) Create(ctx context.Context, tx *model.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transactions[tx.UUID] = clone(tx)
+	return nil
+}
+
+func (r *MemoryRepo) Get(ctx context.Context, uuid string) (*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tx, ok := r.transactions[uuid]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	tx = clone(&tx)
+	return &tx, nil
+}
+
+func (r *MemoryRepo) Update(ctx context.Context, tx *model.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.transactions[tx.UUID]; !ok {
+		return model.ErrNotFound
+	}
+	r.transactions[tx.UUID] = clone(tx)
+	return nil
+}
+
+func (r *MemoryRepo) ListExpiring(ctx context.Context, t time.Time) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var expiring []*model.Transaction
+	for _, tx := range r.transactions {
+		waiting := tx.Status == model.TransactionAuthorized || !tx.Status.Final()
+		if waiting && !tx.ExpiresAt.IsZero() && !tx.ExpiresAt.After(t) {
+			tx = clone(&tx)
+			expiring = append(expiring, &tx)
+		}
+	}
+	return expiring, nil
+}
+
+func (r *MemoryRepo) GetByProviderRef(ctx context.Context, provider, ref string) (*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, tx := range r.transactions {
+		if tx.Provider == provider && tx.ProviderRef == ref {
+			tx = clone(&tx)
+			return &tx, nil
+		}
+	}
+	return nil, model.ErrNotFound
+}
+
+func (r *MemoryRepo) ListInstallmentsDue(ctx context.Context, t time.Time) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var due []*model.Transaction
+	for _, tx := range r.transactions {
+		if tx.Plan == nil || tx.Status != model.TransactionPaid {
+			continue
+		}
+		if next := tx.Plan.Next(); next != nil && !next.DueDate.After(t) {
+			tx = clone(&tx)
+			due = append(due, &tx)
+		}
+	}
+	return due, nil
+}
+
+func (r *MemoryRepo) List(ctx context.Context, f model.TransactionFilter) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var txs []*model.Transaction
+	for _, tx := range r.transactions {
+		if f.Match(&tx) {
+			tx = clone(&tx)
+			txs = append(txs, &tx)
+		}
+	}
+	sortByCreation(txs)
+	return txs, nil
+}
+
+func (r *MemoryRepo) ListByUser(ctx context.Context, userUUID string) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var txs []*model.Transaction
+	for _, tx := range r.transactions {
+		if tx.UserUUID == userUUID {
+			tx = clone(&tx)
+			txs = append(txs, &tx)
+		}
+	}
+	sortByCreation(txs)
+	return txs, nil
+}
+
+func (r *MemoryRepo) ListByOrder(ctx context.Context, orderUUID string) ([]*model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var txs []*model.Transaction
+	for _, tx := range r.transactions {
+		if tx.OrderUUID == orderUUID {
+			tx = clone(&tx)
+			txs = append(txs, &tx)
+		}
+	}
+	sortByCreation(txs)
+	return txs, nil
+}
+
+func (r *MemoryRepo) SaveDecision(ctx context.Context, d *model.RiskDecision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := *d
+	c.Reasons = append([]model.RiskReason(nil), d.Reasons...)
+	r.decisions = append(r.decisions, c)
+	return nil
+}
+
+func (r *MemoryRepo) ListDecisions(ctx context.Context, f model.DecisionFilter) ([]*model.RiskDecision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var found []*model.RiskDecision
+	for _, d := range r.decisions {
+		if f.Match(&d) {
+			d.Reasons = append([]model.RiskReason(nil), d.Reasons...)
+			found = append(found, &d)
+		}
+	}
+	return found, nil
+}
+
+// sortByCreation sorts txs oldest first; transactions created at the same
+// time are ordered by UUID so that pages are stable.
+func sortByCreation(txs []*model.Transaction) {
+	sort.Slice(txs, func(i, j int) bool {
+		if !txs[i].CreatedAt.Equal(txs[j].CreatedAt) {
+			return txs[i].CreatedAt.Before(txs[j].CreatedAt)
+		}
+		return txs[i].UUID < txs[j].UUID
+	})
+}
+
+// clone copies tx so that the stored transaction does not share the
+// installment plan with the caller's.
+func clone(tx *model.Transaction) model.Transaction {
+	c := *tx
+	c.Plan = tx.Plan.Clone()
+	return c
+}